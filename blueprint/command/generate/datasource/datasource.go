@@ -12,6 +12,10 @@ var cmd = &cobra.Command{
 	Use:   "datasource",
 	Short: "Use for terraform datasource scaffolding generation",
 	RunE: func(cmd *cobra.Command, _ []string) error {
+		if generate.ServiceName == "" {
+			return fmt.Errorf("required flag(s) \"service-name\" not set")
+		}
+
 		gen := generator.New(
 			generate.ServiceName,
 			generate.DatasourceName,
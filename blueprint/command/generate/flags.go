@@ -6,6 +6,7 @@ var (
 	PathToRepo   string
 	ServiceName  string
 	SkipComments bool
+	ProtoService string
 
 	ResourceName   string
 	DatasourceName string
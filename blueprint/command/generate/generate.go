@@ -25,8 +25,6 @@ func init() {
 	cmd.PersistentFlags().StringVar(&ServiceName, "service-name", "", "set name for the service of generated resource.")
 	cmd.PersistentFlags().BoolVar(&Override, "force", false, "set if you want to override existing files.")
 	cmd.PersistentFlags().BoolVar(&SkipComments, "skip-comments", false, "set if you want to generate file without any tips for developers.")
-
-	_ = cmd.MarkPersistentFlagRequired("service-name")
 }
 
 func AddSubCommand(sub *cobra.Command) {
@@ -12,6 +12,10 @@ var cmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Use for terraform resource scaffolding generation",
 	RunE: func(cmd *cobra.Command, _ []string) error {
+		if generate.ProtoService == "" && (generate.ServiceName == "" || generate.ResourceName == "") {
+			return fmt.Errorf("flags \"service-name\" and \"name\" are required unless \"proto\" is set")
+		}
+
 		gen := generator.New(
 			generate.ServiceName,
 			generate.ResourceName,
@@ -19,6 +23,7 @@ var cmd = &cobra.Command{
 			generator.WithTemplateName(generate.Template),
 			generator.WithOverrideFiles(generate.Override),
 			generator.WithSkipComments(generate.SkipComments),
+			generator.WithProtoService(generate.ProtoService),
 		)
 
 		if err := gen.Generate(cmd.Context(), cmd.OutOrStdout()); err != nil {
@@ -32,7 +37,7 @@ var cmd = &cobra.Command{
 
 func init() {
 	cmd.Flags().StringVar(&generate.ResourceName, "name", "", "set name for generated resource")
-	_ = cmd.MarkFlagRequired("name")
+	cmd.Flags().StringVar(&generate.ProtoService, "proto", "", "generate resource from protobuf service (example: yandex.cloud.vpc.v1.NetworkService).")
 
 	generate.AddSubCommand(cmd)
 }
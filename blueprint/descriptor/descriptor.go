@@ -0,0 +1,225 @@
+package descriptor
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	// Register yandex.cloud.api.operation and yandex.cloud.required options,
+	// so they are parsed from descriptors of the loaded files.
+	_ "github.com/yandex-cloud/go-genproto/yandex/cloud"
+	_ "github.com/yandex-cloud/go-genproto/yandex/cloud/api"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	GenprotoModule = "github.com/yandex-cloud/go-genproto"
+	SDKModule      = "github.com/yandex-cloud/go-sdk"
+)
+
+// ModuleDir - get directory of the module source used by the provider
+func ModuleDir(pathToRepo, module string) (string, error) {
+	cmd := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", module)
+	cmd.Dir = pathToRepo
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("find directory of module (%s): %w", module, err)
+	}
+
+	dir := strings.TrimSpace(string(out))
+	if dir == "" {
+		return "", fmt.Errorf("module (%s) is not downloaded, run go mod download", module)
+	}
+	return dir, nil
+}
+
+// Loader - loads descriptors of protobuf files from the sources of go-genproto.
+// Blueprint doesn't link all the services, so descriptors are read from the generated .pb.go files.
+type Loader struct {
+	dir   string
+	files *protoregistry.Files
+}
+
+func NewLoader(genprotoDir string) *Loader {
+	return &Loader{
+		dir:   genprotoDir,
+		files: &protoregistry.Files{},
+	}
+}
+
+// Service - find service by full name (example: yandex.cloud.vpc.v1.NetworkService)
+func (l *Loader) Service(fullName string) (protoreflect.ServiceDescriptor, error) {
+	name := protoreflect.FullName(fullName)
+	if !name.IsValid() || name.Parent() == "" {
+		return nil, fmt.Errorf("invalid service name (%s)", fullName)
+	}
+
+	dir := filepath.Join(l.dir, filepath.FromSlash(strings.ReplaceAll(string(name.Parent()), ".", "/")))
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read package of service (%s): %w", fullName, err)
+	}
+
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".pb.go") || strings.HasSuffix(e.Name(), "_grpc.pb.go") {
+			continue
+		}
+		if _, err := l.loadGoFile(filepath.Join(dir, e.Name())); err != nil {
+			return nil, err
+		}
+	}
+
+	d, err := l.FindDescriptorByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("service (%s) is not found: %w", fullName, err)
+	}
+
+	service, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a service", fullName)
+	}
+	return service, nil
+}
+
+// FindFileByPath - implements protodesc.Resolver
+func (l *Loader) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	if fd, err := l.files.FindFileByPath(path); err == nil {
+		return fd, nil
+	}
+	return protoregistry.GlobalFiles.FindFileByPath(path)
+}
+
+// FindDescriptorByName - implements protodesc.Resolver
+func (l *Loader) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	if d, err := l.files.FindDescriptorByName(name); err == nil {
+		return d, nil
+	}
+	return protoregistry.GlobalFiles.FindDescriptorByName(name)
+}
+
+func (l *Loader) loadFile(path string) (protoreflect.FileDescriptor, error) {
+	if fd, err := l.FindFileByPath(path); err == nil {
+		return fd, nil
+	}
+	return l.loadGoFile(filepath.Join(l.dir, filepath.FromSlash(strings.TrimSuffix(path, ".proto")+".pb.go")))
+}
+
+func (l *Loader) loadGoFile(goFile string) (protoreflect.FileDescriptor, error) {
+	raw, err := rawDescriptor(goFile)
+	if err != nil {
+		return nil, err
+	}
+
+	fdProto := &descriptorpb.FileDescriptorProto{}
+	if err := proto.Unmarshal(raw, fdProto); err != nil {
+		return nil, fmt.Errorf("unmarshal descriptor from (%s): %w", goFile, err)
+	}
+
+	if fd, err := l.FindFileByPath(fdProto.GetName()); err == nil {
+		return fd, nil
+	}
+
+	for _, dep := range fdProto.GetDependency() {
+		// Files which are not in go-genproto (e.g. google/api/annotations.proto) are left unresolved,
+		// generator doesn't need them.
+		if _, err := l.loadFile(dep); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+	}
+
+	fd, err := protodesc.FileOptions{AllowUnresolvable: true}.New(fdProto, l)
+	if err != nil {
+		return nil, fmt.Errorf("build descriptor of (%s): %w", fdProto.GetName(), err)
+	}
+	if err := l.files.RegisterFile(fd); err != nil {
+		return nil, fmt.Errorf("register descriptor of (%s): %w", fdProto.GetName(), err)
+	}
+
+	return fd, nil
+}
+
+// rawDescriptor - evaluate the file_..._rawDesc constant of the generated .pb.go file
+func rawDescriptor(goFile string) ([]byte, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), goFile, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, fmt.Errorf("parse (%s): %w", goFile, err)
+	}
+
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || (gen.Tok != token.CONST && gen.Tok != token.VAR) {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			for i, name := range value.Names {
+				if !strings.HasSuffix(name.Name, "_rawDesc") || i >= len(value.Values) {
+					continue
+				}
+
+				raw, err := evalBytes(value.Values[i])
+				if err != nil {
+					return nil, fmt.Errorf("evaluate %s in (%s): %w", name.Name, goFile, err)
+				}
+				return raw, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("raw descriptor is not found in (%s)", goFile)
+}
+
+// evalBytes - evaluate concatenation of string literals or []byte{...} literal
+func evalBytes(expr ast.Expr) ([]byte, error) {
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return evalBytes(e.X)
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			return nil, fmt.Errorf("unexpected literal %s", e.Value)
+		}
+		s, err := strconv.Unquote(e.Value)
+		return []byte(s), err
+	case *ast.BinaryExpr:
+		if e.Op != token.ADD {
+			return nil, fmt.Errorf("unexpected operator %s", e.Op)
+		}
+		x, err := evalBytes(e.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := evalBytes(e.Y)
+		if err != nil {
+			return nil, err
+		}
+		return append(x, y...), nil
+	case *ast.CompositeLit:
+		raw := make([]byte, 0, len(e.Elts))
+		for _, elt := range e.Elts {
+			lit, ok := elt.(*ast.BasicLit)
+			if !ok || lit.Kind != token.INT {
+				return nil, fmt.Errorf("unexpected element of byte slice")
+			}
+			b, err := strconv.ParseUint(lit.Value, 0, 8)
+			if err != nil {
+				return nil, err
+			}
+			raw = append(raw, byte(b))
+		}
+		return raw, nil
+	default:
+		return nil, fmt.Errorf("unexpected expression %T", expr)
+	}
+}
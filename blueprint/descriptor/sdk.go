@@ -0,0 +1,176 @@
+package descriptor
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+type sdkType struct {
+	dir  string
+	name string
+}
+
+type sdkMethod struct {
+	receiver sdkType
+	name     string
+}
+
+// ClientAccessor - find chain of go-sdk methods returning client of the service (example: VPC().Network())
+//
+// goImportPath is the go-genproto package of the service and serviceName is its name (example: NetworkService).
+func ClientAccessor(sdkDir, goImportPath, serviceName string) (string, error) {
+	var (
+		client  *sdkType
+		methods = make(map[sdkType]sdkMethod)
+	)
+
+	err := filepath.WalkDir(sdkDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			// Clients are generated into gen/, the SDK methods returning them are in the root package
+			if gen := filepath.Join(sdkDir, "gen"); p != sdkDir && p != gen && !strings.HasPrefix(p, gen+string(filepath.Separator)) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(p, ".go") || strings.HasSuffix(p, "_test.go") {
+			return nil
+		}
+
+		f, err := parser.ParseFile(token.NewFileSet(), p, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return fmt.Errorf("parse (%s): %w", p, err)
+		}
+
+		dir := filepath.Dir(p)
+		if client == nil && imports(f)[goImportPath] && hasType(f, serviceName+"Client") {
+			client = &sdkType{dir: dir, name: serviceName + "Client"}
+		}
+		indexMethods(sdkDir, dir, f, methods)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if client == nil {
+		return "", fmt.Errorf("client of %s is not found in go-sdk", serviceName)
+	}
+
+	var chain []string
+	for current := *client; ; {
+		method, ok := methods[current]
+		if !ok {
+			return "", fmt.Errorf("go-sdk method returning %s is not found", current.name)
+		}
+
+		chain = append([]string{method.name + "()"}, chain...)
+		if method.receiver == (sdkType{dir: sdkDir, name: "SDK"}) {
+			return strings.Join(chain, "."), nil
+		}
+		if len(chain) > 5 {
+			return "", fmt.Errorf("go-sdk methods returning %s are too deep", client.name)
+		}
+		current = method.receiver
+	}
+}
+
+func imports(f *ast.File) map[string]bool {
+	paths := make(map[string]bool)
+	for _, spec := range f.Imports {
+		p, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		paths[p] = true
+	}
+	return paths
+}
+
+func pointerType(expr ast.Expr) (string, bool) {
+	star, ok := expr.(*ast.StarExpr)
+	if !ok {
+		return "", false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	if !ok {
+		return "", false
+	}
+	return ident.Name, true
+}
+
+func hasType(f *ast.File, name string) bool {
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			if spec.(*ast.TypeSpec).Name.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// indexMethods - collect methods without arguments returning a pointer to go-sdk type
+func indexMethods(sdkDir, dir string, f *ast.File, methods map[sdkType]sdkMethod) {
+	aliases := make(map[string]string)
+	for _, spec := range f.Imports {
+		p, _ := strconv.Unquote(spec.Path.Value)
+		if !strings.HasPrefix(p, SDKModule+"/") {
+			continue
+		}
+		alias := path.Base(p)
+		if spec.Name != nil {
+			alias = spec.Name.Name
+		}
+		aliases[alias] = strings.TrimPrefix(p, SDKModule+"/")
+	}
+
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 || fn.Type.Params.NumFields() != 0 ||
+			fn.Type.Results.NumFields() != 1 || !fn.Name.IsExported() {
+			continue
+		}
+
+		receiver, ok := pointerType(fn.Recv.List[0].Type)
+		if !ok {
+			continue
+		}
+
+		result, ok := fn.Type.Results.List[0].Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+
+		var returned sdkType
+		switch t := result.X.(type) {
+		case *ast.Ident:
+			returned = sdkType{dir: dir, name: t.Name}
+		case *ast.SelectorExpr:
+			pkg, ok := t.X.(*ast.Ident)
+			if !ok || aliases[pkg.Name] == "" {
+				continue
+			}
+			returned = sdkType{dir: filepath.Join(sdkDir, filepath.FromSlash(aliases[pkg.Name])), name: t.Sel.Name}
+		default:
+			continue
+		}
+
+		if _, exists := methods[returned]; !exists {
+			methods[returned] = sdkMethod{receiver: sdkType{dir: dir, name: receiver}, name: fn.Name.Name}
+		}
+	}
+}
@@ -42,11 +42,19 @@ func WithSkipComments(skip bool) Opts {
 	}
 }
 
+// WithProtoService - generate resource from protobuf service (example: yandex.cloud.vpc.v1.NetworkService)
+func WithProtoService(name string) Opts {
+	return func(generator *Generator) {
+		generator.protoService = name
+	}
+}
+
 type Generator struct {
 	tplType      string
 	tplName      string
 	resourceName string
 	serviceName  string
+	protoService string
 	tplVars      any
 	override     bool
 	skipComments bool
@@ -66,7 +74,11 @@ func New(serviceName, resourceName string, opts ...Opts) *Generator {
 	return def
 }
 
-func (g *Generator) Generate(_ context.Context, output io.Writer) error {
+func (g *Generator) Generate(ctx context.Context, output io.Writer) error {
+	if g.protoService != "" {
+		return g.generateFromProto(ctx, output)
+	}
+
 	fmt.Fprintf(
 		output,
 		"Start generating %s from template: %s for service: %s entity: %s ... \n",
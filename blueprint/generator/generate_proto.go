@@ -0,0 +1,128 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/blueprint/command/generate"
+	"github.com/yandex-cloud/terraform-provider-yandex/blueprint/descriptor"
+	"github.com/yandex-cloud/terraform-provider-yandex/blueprint/filesystem"
+	"github.com/yandex-cloud/terraform-provider-yandex/blueprint/templates"
+)
+
+const protoTemplatesDir = "templates/resource/proto"
+
+// protoFiles - templates of resource generated from protobuf service and their paths in the repository
+var protoFiles = []struct {
+	template string
+	output   string
+}{
+	{template: "model.go", output: "yandex-framework/services/%[1]s/model.go"},
+	{template: "schema.go", output: "yandex-framework/services/%[1]s/schema.go"},
+	{template: "expand.go", output: "yandex-framework/services/%[1]s/expand.go"},
+	{template: "flatten.go", output: "yandex-framework/services/%[1]s/flatten.go"},
+	{template: "api.go", output: "yandex-framework/services/%[1]s/api.go"},
+	{template: "resource.go", output: "yandex-framework/services/%[1]s/resource.go"},
+	{template: "datasource.go", output: "yandex-framework/services/%[1]s/datasource.go"},
+	{template: "resource_test.go", output: "yandex-framework/services/%[1]s/resource_test.go"},
+	{template: "r.md", output: "templates/%[1]s/r_%[1]s.md"},
+	{template: "d.md", output: "templates/%[1]s/d_%[1]s.md"},
+	{template: "r.tf", output: "examples/%[1]s/r_%[1]s_1.tf"},
+	{template: "d.tf", output: "examples/%[1]s/d_%[1]s_1.tf"},
+	{template: "import.sh", output: "examples/%[1]s/import.sh"},
+}
+
+func (g *Generator) generateFromProto(_ context.Context, output io.Writer) error {
+	_, _ = fmt.Fprintf(output, "Start generating resource from protobuf service: %s ... \n", g.protoService)
+
+	vars, err := g.protoVariables(generate.PathToRepo)
+	if err != nil {
+		return err
+	}
+
+	contents := make(map[string]io.Reader, len(protoFiles))
+	for _, f := range protoFiles {
+		content, err := generateProtoFile(f.template, vars)
+		if err != nil {
+			return err
+		}
+
+		outputPath := path.Join(generate.PathToRepo, fmt.Sprintf(f.output, vars.PackageName))
+		if _, err := os.Stat(outputPath); err == nil && !g.override {
+			return fmt.Errorf("file with path (%s) already exists. Use force flag or delete exists file", outputPath)
+		}
+		contents[outputPath] = content
+	}
+
+	for _, f := range protoFiles {
+		outputPath := path.Join(generate.PathToRepo, fmt.Sprintf(f.output, vars.PackageName))
+		if err := filesystem.WriteContent(outputPath, g.override, contents[outputPath]); err != nil {
+			return fmt.Errorf("write generated template to file (%s): %w", outputPath, err)
+		}
+		_, _ = fmt.Fprintf(output, "File sucessfully generated and placed by path: %s \n", outputPath)
+	}
+
+	for _, skipped := range vars.Skipped {
+		_, _ = fmt.Fprintf(output, "Field is skipped, add it manually: %s \n", skipped)
+	}
+	return nil
+}
+
+// protoVariables - load descriptor of the service from go-genproto used by the provider and map it to template variables
+func (g *Generator) protoVariables(pathToRepo string) (*protoResourceVars, error) {
+	genprotoDir, err := descriptor.ModuleDir(pathToRepo, descriptor.GenprotoModule)
+	if err != nil {
+		return nil, err
+	}
+	sdkDir, err := descriptor.ModuleDir(pathToRepo, descriptor.SDKModule)
+	if err != nil {
+		return nil, err
+	}
+
+	service, err := descriptor.NewLoader(genprotoDir).Service(g.protoService)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceName, resourceName := protoNames(service)
+	if g.serviceName != "" {
+		serviceName = g.serviceName
+	}
+	if g.resourceName != "" {
+		resourceName = g.resourceName
+	}
+
+	goImport, _ := goPackage(service.ParentFile())
+	sdkPath, err := descriptor.ClientAccessor(sdkDir, goImport, string(service.Name()))
+	if err != nil {
+		return nil, err
+	}
+
+	vars, err := protoResourceVarsFor(service, serviceName, resourceName, sdkPath, g.skipComments)
+	if err != nil {
+		return nil, err
+	}
+	vars.Subcategory = docsSubcategory(pathToRepo, serviceName)
+	return vars, nil
+}
+
+func generateProtoFile(name string, vars *protoResourceVars) (io.Reader, error) {
+	content, err := templates.GenerateFile(fs, path.Join(protoTemplatesDir, name+".tmpl"), vars)
+	if err != nil {
+		return nil, fmt.Errorf("generate template (%s) : %w", name, err)
+	}
+
+	if !strings.HasSuffix(name, ".go") {
+		return content, nil
+	}
+
+	formatted, err := templates.Format(content)
+	if err != nil {
+		return nil, fmt.Errorf("generate template (%s) : %w", name, err)
+	}
+	return formatted, nil
+}
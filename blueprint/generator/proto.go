@@ -0,0 +1,677 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/api"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+)
+
+// protoField - attribute of generated resource mapped from field of protobuf messages
+type protoField struct {
+	Name        string
+	GoName      string
+	ModelType   string
+	SchemaType  string
+	ElemType    string
+	Description string
+
+	Required        bool
+	Optional        bool
+	Computed        bool
+	RequiresReplace bool
+	EnumValues      []string
+	Object          *protoObject
+
+	// Create, Update - expressions of request field values, empty if the field isn't in the request
+	Create string
+	Update string
+	// Flatten - expression of model value, empty if the field isn't in the resource message
+	Flatten string
+	// Example - value of the attribute in example configuration
+	Example string
+}
+
+// protoObject - nested message mapped to single nested attribute with pkg/adapters/protobuf
+type protoObject struct {
+	GoType string
+	Fields []*protoField
+}
+
+type protoResourceVars struct {
+	PackageName  string
+	ServiceName  string
+	ResourceName string
+	Subcategory  string
+	ProtoService string
+	ProtoImport  string
+	ProtoAlias   string
+	SDKPath      string
+
+	Entity        string
+	EntityVar     string
+	EntityTitle   string
+	IDName        string
+	IDGoName      string
+	ResourceHasID bool
+
+	CreateRequest  string
+	CreateMetadata string
+	GetRequest     string
+	UpdateRequest  string
+	DeleteRequest  string
+	HasUpdateMask  bool
+
+	Fields  []*protoField
+	Skipped []string
+
+	TipIncluded bool
+}
+
+// HasObjects - resource has nested attributes filled with pkg/adapters/protobuf
+func (v *protoResourceVars) HasObjects() bool {
+	for _, f := range v.Fields {
+		if f.Object != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// HasUpdate - resource has attributes updated in place
+func (v *protoResourceVars) HasUpdate() bool {
+	for _, f := range v.Fields {
+		if f.Update != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Has - resource has attributes of the schema type (example: Map)
+func (v *protoResourceVars) Has(schemaType string) bool {
+	for _, f := range v.Fields {
+		if f.SchemaType == schemaType {
+			return true
+		}
+	}
+	return false
+}
+
+// HasField - resource has attribute with the name
+func (v *protoResourceVars) HasField(name string) bool {
+	for _, f := range v.Fields {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateOnly - attributes which are set on create and aren't returned by API, they can't be imported
+func (v *protoResourceVars) CreateOnly() []string {
+	var names []string
+	for _, f := range v.Fields {
+		if f.Create != "" && f.Flatten == "" {
+			names = append(names, f.Name)
+		}
+	}
+	return names
+}
+
+// ExampleLines - attributes of example configuration aligned as terraform fmt does
+func (v *protoResourceVars) ExampleLines() []string {
+	return v.exampleLines("")
+}
+
+// TestLines - attributes of test configuration, name is a formatting verb of the test name
+func (v *protoResourceVars) TestLines() []string {
+	return v.exampleLines(`"%[1]s"`)
+}
+
+func (v *protoResourceVars) exampleLines(name string) []string {
+	var (
+		fields []*protoField
+		width  int
+	)
+	for _, f := range v.Fields {
+		if f.Example != "" {
+			fields = append(fields, f)
+			width = max(width, len(f.Name))
+		}
+	}
+
+	lines := make([]string, 0, len(fields))
+	for _, f := range fields {
+		value := f.Example
+		if f.Name == "name" && name != "" {
+			value = name
+		}
+		lines = append(lines, fmt.Sprintf("%-*s = %s", width, f.Name, value))
+	}
+	return lines
+}
+
+// PlanType - type of plan modifiers of the attribute (example: planmodifier.String)
+func (f *protoField) PlanType() string {
+	if f.Object != nil {
+		return "Object"
+	}
+	return f.SchemaType
+}
+
+// PlanPackage - package of the framework plan modifiers of the attribute (example: stringplanmodifier)
+func (f *protoField) PlanPackage() string {
+	return strings.ToLower(f.PlanType()) + "planmodifier"
+}
+
+// AttrType - type of the attribute in object types (example: types.StringType)
+func (f *protoField) AttrType() string {
+	return f.ModelType + "Type"
+}
+
+// AttrTypesVar - name of the variable with types of nested object attributes
+func (f *protoField) AttrTypesVar() string {
+	return strings.ToLower(f.GoName[:1]) + f.GoName[1:] + "AttrTypes"
+}
+
+// protoNames - default service and resource names of generated resource (example: vpc, network)
+func protoNames(service protoreflect.ServiceDescriptor) (string, string) {
+	var parts []string
+	for _, p := range strings.Split(string(service.ParentFile().Package()), ".") {
+		if p == "yandex" || p == "cloud" || isVersion(p) {
+			continue
+		}
+		parts = append(parts, p)
+	}
+	serviceName := strings.Join(parts, "_")
+
+	resource := toSnakeCase(strings.TrimSuffix(string(service.Name()), "Service"))
+	resource = strings.TrimPrefix(resource, serviceName+"_")
+	return serviceName, resource
+}
+
+func protoResourceVarsFor(service protoreflect.ServiceDescriptor, serviceName, resource, sdkPath string, skipComments bool) (*protoResourceVars, error) {
+	methods := service.Methods()
+	get, create, del := methods.ByName("Get"), methods.ByName("Create"), methods.ByName("Delete")
+	if get == nil || create == nil || del == nil {
+		return nil, fmt.Errorf("service (%s) must have Get, Create and Delete methods", service.FullName())
+	}
+
+	goImport, goAlias := goPackage(service.ParentFile())
+	vars := &protoResourceVars{
+		PackageName:   serviceName + "_" + resource,
+		ServiceName:   serviceName,
+		ResourceName:  resource,
+		ProtoService:  string(service.FullName()),
+		ProtoImport:   goImport,
+		ProtoAlias:    goAlias,
+		SDKPath:       sdkPath,
+		Entity:        goIdent(get.Output()),
+		EntityTitle:   strings.ReplaceAll(resource, "_", " "),
+		ResourceHasID: get.Output().Fields().ByName("id") != nil,
+		TipIncluded:   !skipComments,
+	}
+	vars.EntityVar = strings.ToLower(vars.Entity[:1]) + vars.Entity[1:]
+
+	idField := idFieldOf(get.Input())
+	if idField == nil {
+		return nil, fmt.Errorf("%s has no ID field", get.Input().FullName())
+	}
+	vars.IDName = string(idField.Name())
+	vars.IDGoName = goCamelCase(vars.IDName)
+	vars.GetRequest = goAlias + "." + goIdent(get.Input())
+	vars.CreateRequest = goAlias + "." + goIdent(create.Input())
+	vars.DeleteRequest = goAlias + "." + goIdent(del.Input())
+
+	metadata, err := operationMetadata(create)
+	if err != nil {
+		return nil, err
+	}
+	if metadata.Fields().ByName(idField.Name()) == nil {
+		return nil, fmt.Errorf("%s has no %s field", metadata.FullName(), idField.Name())
+	}
+	vars.CreateMetadata = goAlias + "." + goIdent(metadata)
+
+	var updateFields protoreflect.FieldDescriptors
+	if update := methods.ByName("Update"); update != nil {
+		vars.UpdateRequest = goAlias + "." + goIdent(update.Input())
+		vars.HasUpdateMask = update.Input().Fields().ByName("update_mask") != nil
+		updateFields = update.Input().Fields()
+	}
+
+	resourceFields, createFields := get.Output().Fields(), create.Input().Fields()
+	names := make(map[protoreflect.Name]bool)
+	for i := 0; i < resourceFields.Len(); i++ {
+		names[resourceFields.Get(i).Name()] = true
+	}
+	for i := 0; i < createFields.Len(); i++ {
+		names[createFields.Get(i).Name()] = true
+	}
+	delete(names, "id")
+	delete(names, idField.Name())
+
+	var sorted []string
+	for name := range names {
+		sorted = append(sorted, string(name))
+	}
+	sort.Strings(sorted)
+
+	for _, name := range sorted {
+		rf := resourceFields.ByName(protoreflect.Name(name))
+		cf := createFields.ByName(protoreflect.Name(name))
+		var uf protoreflect.FieldDescriptor
+		if updateFields != nil {
+			uf = updateFields.ByName(protoreflect.Name(name))
+		}
+
+		field, reason := vars.field(rf, cf, uf)
+		if reason != "" {
+			vars.Skipped = append(vars.Skipped, fmt.Sprintf("%s: %s", name, reason))
+			continue
+		}
+		vars.Fields = append(vars.Fields, field)
+	}
+
+	return vars, nil
+}
+
+// field - map fields of resource message, create and update requests with the same name to the attribute
+func (v *protoResourceVars) field(rf, cf, uf protoreflect.FieldDescriptor) (*protoField, string) {
+	fd := cf
+	if fd == nil {
+		fd = rf
+	}
+	for _, other := range []protoreflect.FieldDescriptor{rf, uf} {
+		if other != nil && !sameType(fd, other) {
+			return nil, "types of the field differ in requests and resource"
+		}
+	}
+
+	m, reason := v.mapType(fd)
+	if reason != "" {
+		return nil, reason
+	}
+
+	f := &protoField{
+		Name:        string(fd.Name()),
+		GoName:      goCamelCase(string(fd.Name())),
+		ModelType:   m.modelType,
+		SchemaType:  m.schemaType,
+		ElemType:    m.elemType,
+		EnumValues:  m.enumValues,
+		Object:      m.object,
+		Description: description(string(fd.Name()), v.EntityTitle),
+	}
+
+	if cf != nil {
+		if m.expand == "" {
+			return nil, "type isn't supported in requests"
+		}
+		f.Create = fmt.Sprintf(m.expand, "plan."+f.GoName)
+		f.Required = proto.GetExtension(cf.Options(), cloud.E_Required).(bool)
+		f.Optional = !f.Required
+		f.Computed = f.Optional && rf != nil
+		f.RequiresReplace = uf == nil
+		if f.Name == "folder_id" {
+			// Folder of provider configuration is used by default
+			f.Create = "folderID"
+			f.Required, f.Optional, f.Computed = false, true, true
+		}
+		f.Example = example(f, v.EntityTitle)
+	} else {
+		// Fields which are only in update request (e.g. status) are changed after creation
+		f.Computed = true
+		f.Optional = uf != nil
+	}
+
+	if uf != nil {
+		f.Update = fmt.Sprintf(m.expand, "plan."+f.GoName)
+	}
+	if rf != nil {
+		f.Flatten = fmt.Sprintf(m.flatten, "res.Get"+f.GoName+"()")
+	}
+	return f, ""
+}
+
+type mappedType struct {
+	modelType  string
+	schemaType string
+	elemType   string
+	enumValues []string
+	object     *protoObject
+	// expand, flatten - format of expression converting value of the model or the protobuf field
+	expand  string
+	flatten string
+}
+
+func (v *protoResourceVars) mapType(fd protoreflect.FieldDescriptor) (mappedType, string) {
+	if oneof := fd.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+		return mappedType{}, fmt.Sprintf("field of oneof %s isn't supported", oneof.Name())
+	}
+
+	if fd.IsMap() {
+		if fd.MapKey().Kind() != protoreflect.StringKind || fd.MapValue().Kind() != protoreflect.StringKind {
+			return mappedType{}, "only map<string, string> is supported"
+		}
+		return mappedType{
+			modelType: "types.Map", schemaType: "Map", elemType: "types.StringType",
+			expand: "expandStringMap(ctx, %s, &diags)", flatten: "flattenStringMap(ctx, %s, &diags)",
+		}, ""
+	}
+
+	if fd.IsList() {
+		if fd.Kind() != protoreflect.StringKind {
+			return mappedType{}, "only repeated string is supported"
+		}
+		return mappedType{
+			modelType: "types.List", schemaType: "List", elemType: "types.StringType",
+			expand: "expandStringList(ctx, %s, &diags)", flatten: "flattenStringList(ctx, %s, &diags)",
+		}, ""
+	}
+
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		enum := fd.Enum()
+		if enum.IsPlaceholder() || enum.ParentFile().Package() != fd.ParentFile().Package() {
+			return mappedType{}, fmt.Sprintf("enum %s of other package isn't supported", enum.FullName())
+		}
+		enumType := v.ProtoAlias + "." + goIdent(enum)
+		m := mappedType{
+			modelType: "types.String", schemaType: "String",
+			expand:  fmt.Sprintf("%s(%s_value[%%s.ValueString()])", enumType, enumType),
+			flatten: "types.StringValue(%s.String())",
+		}
+		for i := 0; i < enum.Values().Len(); i++ {
+			if name := string(enum.Values().Get(i).Name()); !strings.HasSuffix(name, "UNSPECIFIED") {
+				m.enumValues = append(m.enumValues, name)
+			}
+		}
+		return m, ""
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return v.mapMessage(fd)
+	}
+
+	m, ok := scalarType(fd.Kind())
+	if !ok {
+		return mappedType{}, fmt.Sprintf("%s isn't supported", fd.Kind())
+	}
+	return m, ""
+}
+
+func scalarType(kind protoreflect.Kind) (mappedType, bool) {
+	switch kind {
+	case protoreflect.StringKind:
+		return mappedType{modelType: "types.String", schemaType: "String", expand: "%s.ValueString()", flatten: "types.StringValue(%s)"}, true
+	case protoreflect.BoolKind:
+		return mappedType{modelType: "types.Bool", schemaType: "Bool", expand: "%s.ValueBool()", flatten: "types.BoolValue(%s)"}, true
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return mappedType{modelType: "types.Int64", schemaType: "Int64", expand: "%s.ValueInt64()", flatten: "types.Int64Value(%s)"}, true
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return mappedType{modelType: "types.Int64", schemaType: "Int64", expand: "int32(%s.ValueInt64())", flatten: "types.Int64Value(int64(%s))"}, true
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return mappedType{modelType: "types.Int64", schemaType: "Int64", expand: "uint32(%s.ValueInt64())", flatten: "types.Int64Value(int64(%s))"}, true
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return mappedType{modelType: "types.Int64", schemaType: "Int64", expand: "uint64(%s.ValueInt64())", flatten: "types.Int64Value(int64(%s))"}, true
+	case protoreflect.DoubleKind:
+		return mappedType{modelType: "types.Float64", schemaType: "Float64", expand: "%s.ValueFloat64()", flatten: "types.Float64Value(%s)"}, true
+	case protoreflect.FloatKind:
+		return mappedType{modelType: "types.Float64", schemaType: "Float64", expand: "float32(%s.ValueFloat64())", flatten: "types.Float64Value(float64(%s))"}, true
+	}
+	return mappedType{}, false
+}
+
+func (v *protoResourceVars) mapMessage(fd protoreflect.FieldDescriptor) (mappedType, string) {
+	msg := fd.Message()
+	switch msg.FullName() {
+	case "google.protobuf.Timestamp":
+		// Timestamps are set by API, so they aren't expanded
+		return mappedType{modelType: "types.String", schemaType: "String", flatten: "types.StringValue(timestamp.Get(%s))"}, ""
+	case "google.protobuf.Int64Value":
+		return mappedType{modelType: "types.Int64", schemaType: "Int64", expand: "wrappers.Int64FromTF(%s)", flatten: "wrappers.Int64ToTF(%s)"}, ""
+	case "google.protobuf.BoolValue":
+		return mappedType{modelType: "types.Bool", schemaType: "Bool", expand: "wrappers.BoolFromTF(%s)", flatten: "wrappers.BoolToTF(%s)"}, ""
+	}
+
+	if msg.IsPlaceholder() || msg.ParentFile().Package() != fd.ParentFile().Package() {
+		return mappedType{}, fmt.Sprintf("message %s of other package isn't supported", msg.FullName())
+	}
+
+	object := &protoObject{GoType: v.ProtoAlias + "." + goIdent(msg)}
+	for i := 0; i < msg.Fields().Len(); i++ {
+		nested := msg.Fields().Get(i)
+		m, ok := adapterType(nested)
+		if !ok {
+			return mappedType{}, fmt.Sprintf("field %s of nested message isn't supported", nested.Name())
+		}
+		object.Fields = append(object.Fields, &protoField{
+			Name:        string(nested.Name()),
+			GoName:      goCamelCase(string(nested.Name())),
+			ModelType:   m.modelType,
+			SchemaType:  m.schemaType,
+			Description: description(string(nested.Name()), strings.ReplaceAll(toSnakeCase(string(fd.Name())), "_", " ")),
+		})
+	}
+	if len(object.Fields) == 0 {
+		return mappedType{}, "empty nested message isn't supported"
+	}
+
+	name := goCamelCase(string(fd.Name()))
+	return mappedType{
+		modelType: "types.Object", schemaType: "SingleNested", object: object,
+		expand:  "expand" + name + "(ctx, %s, &diags)",
+		flatten: "flatten" + name + "(ctx, %s, &diags)",
+	}, ""
+}
+
+// adapterType - type of nested field which is filled and extracted by pkg/adapters/protobuf in the same way
+func adapterType(fd protoreflect.FieldDescriptor) (mappedType, bool) {
+	if fd.IsList() || fd.IsMap() || fd.ContainingOneof() != nil && !fd.ContainingOneof().IsSynthetic() {
+		return mappedType{}, false
+	}
+
+	switch fd.Kind() {
+	case protoreflect.StringKind, protoreflect.BoolKind, protoreflect.Int64Kind, protoreflect.Int32Kind, protoreflect.DoubleKind:
+		return scalarType(fd.Kind())
+	case protoreflect.MessageKind:
+		switch fd.Message().FullName() {
+		case "google.protobuf.StringValue":
+			return scalarType(protoreflect.StringKind)
+		case "google.protobuf.BoolValue":
+			return scalarType(protoreflect.BoolKind)
+		case "google.protobuf.Int64Value", "google.protobuf.Int32Value":
+			return scalarType(protoreflect.Int64Kind)
+		case "google.protobuf.DoubleValue":
+			return scalarType(protoreflect.DoubleKind)
+		}
+	}
+	return mappedType{}, false
+}
+
+func sameType(a, b protoreflect.FieldDescriptor) bool {
+	if a.Kind() != b.Kind() || a.Cardinality() != b.Cardinality() || a.IsMap() != b.IsMap() {
+		return false
+	}
+	if a.IsMap() {
+		// Map entries are generated per message, so compare types of keys and values
+		return sameType(a.MapKey(), b.MapKey()) && sameType(a.MapValue(), b.MapValue())
+	}
+	switch a.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return a.Message().FullName() == b.Message().FullName()
+	case protoreflect.EnumKind:
+		return a.Enum().FullName() == b.Enum().FullName()
+	}
+	return true
+}
+
+// idFieldOf - ID field of Get request (example: network_id)
+func idFieldOf(get protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	for i := 0; i < get.Fields().Len(); i++ {
+		f := get.Fields().Get(i)
+		if f.Kind() == protoreflect.StringKind && !f.IsList() && strings.HasSuffix(string(f.Name()), "_id") {
+			return f
+		}
+	}
+	return nil
+}
+
+// operationMetadata - metadata message of the operation returned by the method
+func operationMetadata(method protoreflect.MethodDescriptor) (protoreflect.MessageDescriptor, error) {
+	op, ok := proto.GetExtension(method.Options(), api.E_Operation).(*api.Operation)
+	if !ok || op == nil || op.GetMetadata() == "" {
+		return nil, fmt.Errorf("method %s doesn't return operation with metadata", method.FullName())
+	}
+
+	name := protoreflect.FullName(op.GetMetadata())
+	if !strings.Contains(op.GetMetadata(), ".") {
+		name = method.ParentFile().Package().Append(protoreflect.Name(op.GetMetadata()))
+	}
+
+	file := method.ParentFile()
+	if d := file.Messages().ByName(name.Name()); d != nil && d.FullName() == name {
+		return d, nil
+	}
+	for i := 0; i < file.Imports().Len(); i++ {
+		if d := file.Imports().Get(i).Messages().ByName(name.Name()); d != nil && d.FullName() == name {
+			return d, nil
+		}
+	}
+	return nil, fmt.Errorf("metadata %s of method %s is not found", name, method.FullName())
+}
+
+// goPackage - import path and package name of the generated go code of the file
+func goPackage(file protoreflect.FileDescriptor) (string, string) {
+	goPkg := file.Options().(*descriptorpb.FileOptions).GetGoPackage()
+	importPath, alias, ok := strings.Cut(goPkg, ";")
+	if !ok {
+		alias = path.Base(importPath)
+	}
+	return importPath, alias
+}
+
+// goIdent - go name of the message or enum generated by protoc-gen-go (example: Network_Status)
+func goIdent(d protoreflect.Descriptor) string {
+	return goCamelCase(strings.TrimPrefix(string(d.FullName()), string(d.ParentFile().Package())+"."))
+}
+
+// goCamelCase - copy of the field name conversion of protoc-gen-go (example: v4_cidr_blocks -> V4CidrBlocks)
+func goCamelCase(s string) string {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '.' && i+1 < len(s) && isASCIILower(s[i+1]):
+		case c == '.':
+			b = append(b, '_')
+		case c == '_' && (i == 0 || s[i-1] == '.'):
+			b = append(b, 'X')
+		case c == '_' && i+1 < len(s) && isASCIILower(s[i+1]):
+		case isASCIIDigit(c):
+			b = append(b, c)
+		default:
+			if isASCIILower(c) {
+				c -= 'a' - 'A'
+			}
+			b = append(b, c)
+			for ; i+1 < len(s) && isASCIILower(s[i+1]); i++ {
+				b = append(b, s[i+1])
+			}
+		}
+	}
+	return string(b)
+}
+
+func isASCIILower(c byte) bool {
+	return 'a' <= c && c <= 'z'
+}
+
+func isASCIIDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+var versionRegexp = regexp.MustCompile(`^v\d+((alpha|beta)\d*)?$`)
+
+func isVersion(s string) bool {
+	return versionRegexp.MatchString(s)
+}
+
+// toSnakeCase - example: DnsZone -> dns_zone
+func toSnakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 && (!unicode.IsUpper(rune(s[i-1])) || i+1 < len(s) && unicode.IsLower(rune(s[i+1]))) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// description - go expression of the attribute description
+func description(name, entity string) string {
+	if _, ok := common.ResourceDescriptions[name]; ok {
+		return fmt.Sprintf("common.ResourceDescriptions[%q]", name)
+	}
+	words := strings.ReplaceAll(name, "_", " ")
+	return strconv.Quote(fmt.Sprintf("The %s of the %s.", words, entity))
+}
+
+// example - value of required attribute in example configuration
+func example(f *protoField, entity string) string {
+	if f.Name == "name" {
+		return `"my-` + strings.ReplaceAll(entity, " ", "-") + `"`
+	}
+	if !f.Required {
+		return ""
+	}
+	if len(f.EnumValues) > 0 {
+		return strconv.Quote(f.EnumValues[0])
+	}
+	switch f.SchemaType {
+	case "String":
+		return `"<` + f.Name + `>"`
+	case "Bool":
+		return "true"
+	case "Int64", "Float64":
+		return "1"
+	case "List":
+		return `["<` + f.Name + `>"]`
+	case "Map":
+		return `{ key = "value" }`
+	}
+	return "{}"
+}
+
+// docsSubcategory - subcategory of documentation of other resources of the service
+func docsSubcategory(pathToRepo, serviceName string) string {
+	files, _ := filepath.Glob(filepath.Join(pathToRepo, "templates", serviceName+"_*", "*.md"))
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			continue
+		}
+		if m := subcategoryRegexp.FindSubmatch(content); m != nil {
+			return string(m[1])
+		}
+	}
+	return serviceName
+}
+
+var subcategoryRegexp = regexp.MustCompile(`(?m)^subcategory: "(.*)"$`)
@@ -0,0 +1,106 @@
+package generator
+
+import (
+	"go/parser"
+	"go/token"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/blueprint/descriptor"
+)
+
+func Test_goCamelCase(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "case: snake case", in: "network_id", want: "NetworkId"},
+		{name: "case: single word", in: "labels", want: "Labels"},
+		{name: "case: digit after underscore", in: "ipv4_cidr_blocks", want: "Ipv4CidrBlocks"},
+	}
+	for _, tt := range tests {
+
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, goCamelCase(tt.in))
+		})
+	}
+}
+
+func Test_toSnakeCase(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "case: camel case", in: "DnsZone", want: "dns_zone"},
+		{name: "case: single word", in: "Network", want: "network"},
+		{name: "case: abbreviation", in: "SymmetricKey", want: "symmetric_key"},
+	}
+	for _, tt := range tests {
+
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, toSnakeCase(tt.in))
+		})
+	}
+}
+
+func Test_protoResourceVarsFor(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	genprotoDir, err := descriptor.ModuleDir("../..", descriptor.GenprotoModule)
+	require.NoError(t, err)
+	service, err := descriptor.NewLoader(genprotoDir).Service("yandex.cloud.vpc.v1.NetworkService")
+	require.NoError(t, err)
+
+	// Act
+	serviceName, resourceName := protoNames(service)
+	vars, err := protoResourceVarsFor(service, serviceName, resourceName, "VPC().Network()", false)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "vpc_network", vars.PackageName)
+	assert.Equal(t, "Network", vars.Entity)
+	assert.Equal(t, "network_id", vars.IDName)
+	assert.Equal(t, "vpc.CreateNetworkMetadata", vars.CreateMetadata)
+	assert.True(t, vars.HasUpdateMask)
+	assert.Empty(t, vars.Skipped)
+
+	fields := make(map[string]*protoField)
+	for _, f := range vars.Fields {
+		fields[f.Name] = f
+	}
+	require.Contains(t, fields, "folder_id")
+	assert.True(t, fields["folder_id"].RequiresReplace)
+	require.Contains(t, fields, "labels")
+	assert.Equal(t, "Map", fields["labels"].SchemaType)
+	assert.NotEmpty(t, fields["labels"].Update)
+	require.Contains(t, fields, "created_at")
+	assert.True(t, fields["created_at"].Computed)
+	assert.Empty(t, fields["created_at"].Create)
+
+	for _, f := range protoFiles {
+		content, err := generateProtoFile(f.template, vars)
+		require.NoError(t, err, f.template)
+
+		if strings.HasSuffix(f.template, ".go") {
+			src, err := io.ReadAll(content)
+			require.NoError(t, err)
+			_, err = parser.ParseFile(token.NewFileSet(), f.template, src, 0)
+			assert.NoError(t, err, f.template)
+		}
+	}
+}
@@ -3,10 +3,13 @@ package templates
 import (
 	"bytes"
 	"fmt"
-	"go/format"
 	"io"
 	"io/fs"
+	"path"
+	"strings"
 	"text/template"
+
+	"golang.org/x/tools/imports"
 )
 
 // IsExist - search template in embedded file system
@@ -39,14 +42,35 @@ func Generate(fileSystem fs.FS, tplType, name string, vars any) (io.Reader, erro
 	return output, nil
 }
 
-// Format - call go fmt for generated code
+// GenerateFile - execute template by its path in embedded file system with given vars
+// Templates of markdown files use [[ ]] delimiters, because documentation templates use {{ }} themselves.
+func GenerateFile(fileSystem fs.FS, tplPath string, vars any) (io.Reader, error) {
+	tpl := template.New(path.Base(tplPath))
+	if strings.HasSuffix(tplPath, ".md.tmpl") {
+		tpl = tpl.Delims("[[", "]]")
+	}
+
+	parsed, err := tpl.ParseFS(fileSystem, tplPath)
+	if err != nil {
+		return nil, fmt.Errorf("find template (%s): %w", tplPath, err)
+	}
+	output := bytes.NewBuffer(make([]byte, 0, 2048))
+
+	if err := parsed.Execute(output, vars); err != nil {
+		return nil, fmt.Errorf("execute template (%s): %w", tplPath, err)
+	}
+
+	return output, nil
+}
+
+// Format - call goimports for generated code, unused imports of the template are removed
 func Format(input io.Reader) (io.Reader, error) {
 	rawSrc := bytes.NewBuffer(make([]byte, 0, 2048))
 	if _, err := io.Copy(rawSrc, input); err != nil {
 		return nil, err
 	}
 
-	formatted, err := format.Source(rawSrc.Bytes())
+	formatted, err := imports.Process("", rawSrc.Bytes(), nil)
 	if err != nil {
 		return nil, fmt.Errorf("format generated source: %w", err)
 	}
@@ -61,4 +61,8 @@ var Descriptions = map[string]string{
 	"profile": "Profile name to use in the shared credentials file. Default value is `default`.",
 
 	"organization_id": "The ID of the [Cloud Organization](https://yandex.cloud/docs/organization/quickstart) to operate under.",
+
+	"default_labels": "A set of key/value label pairs which are assigned to every resource that supports `labels`. Labels specified in a resource take precedence over the default ones.\n" +
+		"Default labels are sent to the API together with `labels` of a resource and are tracked in the `effective_labels` attribute, so `labels` keeps the configured value only.",
+	"iam_online_validation": "Verify at plan time that roles of IAM binding and member resources exist and that `serviceAccount:` members refer to existing service accounts. Every role and service account is requested once per run. Disabled by default.",
 }
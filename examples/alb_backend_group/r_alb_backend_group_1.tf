@@ -6,7 +6,7 @@ resource "yandex_alb_backend_group" "my_alb_bg" {
 
   session_affinity {
     connection {
-      source_ip = "127.0.0.1"
+      source_ip = true
     }
   }
 
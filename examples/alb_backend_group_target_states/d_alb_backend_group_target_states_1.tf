@@ -0,0 +1,14 @@
+//
+// Get health status of targets in an ALB Backend Group.
+//
+data "yandex_alb_backend_group_target_states" "my_bg_states" {
+  load_balancer_id = "my-alb-id"
+  backend_group_id = "my-backend-group-id"
+}
+
+output "unhealthy_targets" {
+  value = [
+    for t in data.yandex_alb_backend_group_target_states.my_bg_states.target_states : t.ip_address
+    if anytrue([for z in t.zone_status : z.status != "HEALTHY"])
+  ]
+}
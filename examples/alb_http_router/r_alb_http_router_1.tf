@@ -3,7 +3,7 @@
 //
 resource "yandex_alb_http_router" "tf-router" {
   name = "my-http-router"
-  labels {
+  labels = {
     tf-label    = "tf-label-value"
     empty-label = "s"
   }
@@ -0,0 +1,38 @@
+//
+// Canary release: move traffic from "blue" to "green" backend in three steps.
+//
+resource "yandex_alb_backend_group" "bg" {
+  name = "my-backend-group"
+
+  http_backend {
+    name             = "blue"
+    weight           = 100
+    port             = 8080
+    target_group_ids = [yandex_alb_target_group.blue.id]
+  }
+
+  http_backend {
+    name             = "green"
+    weight           = 0
+    port             = 8080
+    target_group_ids = [yandex_alb_target_group.green.id]
+  }
+
+  lifecycle {
+    ignore_changes = [http_backend[0].weight, http_backend[1].weight]
+  }
+}
+
+resource "yandex_alb_traffic_shift" "canary" {
+  backend_group_id      = yandex_alb_backend_group.bg.id
+  load_balancer_id      = yandex_alb_load_balancer.lb.id
+  from_backend          = "blue"
+  to_backend            = "green"
+  steps                 = [10, 50, 100]
+  step_interval         = "5m"
+  max_unhealthy_targets = 0
+
+  triggers = {
+    green_version = var.green_version
+  }
+}
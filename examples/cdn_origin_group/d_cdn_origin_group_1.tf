@@ -2,7 +2,7 @@
 // Get information about existing CDN Origin Group
 //
 data "yandex_cdn_origin_group" "my_group" {
-  origin_group_id = "some_instance_id"
+  origin_group_id = 1234567890
 }
 
 output "origin_group_name" {
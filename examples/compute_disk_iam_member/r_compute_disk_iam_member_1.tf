@@ -0,0 +1,8 @@
+//
+// Grant a role to a single member on an existing resource.
+//
+resource "yandex_compute_disk_iam_member" "member" {
+  disk_id = "your-disk-id"
+  role    = "viewer"
+  member  = "userAccount:some_user_id"
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_compute_disk_placement_group_iam_member.<resource Name> "<resource Id>,<resource Role>,<subject Id>"
+terraform import yandex_compute_disk_placement_group_iam_member.member "your-disk-placement-group-id,viewer,userAccount:some_user_id"
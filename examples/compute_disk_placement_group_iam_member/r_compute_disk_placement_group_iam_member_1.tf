@@ -0,0 +1,8 @@
+//
+// Grant a role to a single member on an existing resource.
+//
+resource "yandex_compute_disk_placement_group_iam_member" "member" {
+  disk_placement_group_id = "your-disk-placement-group-id"
+  role                    = "viewer"
+  member                  = "userAccount:some_user_id"
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_compute_disk_placement_group_iam_policy.<resource Name> "<resource Id>"
+terraform import yandex_compute_disk_placement_group_iam_policy.policy "your-disk-placement-group-id"
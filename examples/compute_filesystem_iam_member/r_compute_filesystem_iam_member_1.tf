@@ -0,0 +1,8 @@
+//
+// Grant a role to a single member on an existing resource.
+//
+resource "yandex_compute_filesystem_iam_member" "member" {
+  filesystem_id = "your-filesystem-id"
+  role          = "viewer"
+  member        = "userAccount:some_user_id"
+}
@@ -0,0 +1,8 @@
+//
+// Grant a role to a single member on an existing resource.
+//
+resource "yandex_compute_gpu_cluster_iam_member" "member" {
+  gpu_cluster_id = "your-gpu-cluster-id"
+  role           = "viewer"
+  member         = "userAccount:some_user_id"
+}
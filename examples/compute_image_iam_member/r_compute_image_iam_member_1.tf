@@ -0,0 +1,8 @@
+//
+// Grant a role to a single member on an existing resource.
+//
+resource "yandex_compute_image_iam_member" "member" {
+  image_id = "your-image-id"
+  role     = "viewer"
+  member   = "userAccount:some_user_id"
+}
@@ -0,0 +1,14 @@
+//
+// Replace all access bindings of an existing resource with a policy.
+//
+data "yandex_iam_policy" "policy" {
+  binding {
+    role    = "viewer"
+    members = ["userAccount:some_user_id"]
+  }
+}
+
+resource "yandex_compute_image_iam_policy" "policy" {
+  image_id    = "your-image-id"
+  policy_data = data.yandex_iam_policy.policy.policy_data
+}
@@ -27,7 +27,7 @@ resource "yandex_compute_instance" "vm1" {
 }
 
 resource "yandex_compute_instance_iam_binding" "editor" {
-  image_id = data.yandex_compute_instance.vm1.id
+  instance_id = yandex_compute_instance.vm1.id
 
   role = "editor"
 
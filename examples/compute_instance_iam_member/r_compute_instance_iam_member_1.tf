@@ -0,0 +1,8 @@
+//
+// Grant a role to a single member on an existing resource.
+//
+resource "yandex_compute_instance_iam_member" "member" {
+  instance_id = "your-instance-id"
+  role        = "viewer"
+  member      = "userAccount:some_user_id"
+}
@@ -0,0 +1,8 @@
+//
+// Grant a role to a single member on an existing resource.
+//
+resource "yandex_compute_placement_group_iam_member" "member" {
+  placement_group_id = "your-placement-group-id"
+  role               = "viewer"
+  member             = "userAccount:some_user_id"
+}
@@ -0,0 +1,8 @@
+//
+// Grant a role to a single member on an existing resource.
+//
+resource "yandex_compute_snapshot_iam_member" "member" {
+  snapshot_id = "your-snapshot-id"
+  role        = "viewer"
+  member      = "userAccount:some_user_id"
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_compute_snapshot_iam_policy.<resource Name> "<resource Id>"
+terraform import yandex_compute_snapshot_iam_policy.policy "your-snapshot-id"
@@ -0,0 +1,14 @@
+//
+// Replace all access bindings of an existing resource with a policy.
+//
+data "yandex_iam_policy" "policy" {
+  binding {
+    role    = "viewer"
+    members = ["userAccount:some_user_id"]
+  }
+}
+
+resource "yandex_compute_snapshot_iam_policy" "policy" {
+  snapshot_id = "your-snapshot-id"
+  policy_data = data.yandex_iam_policy.policy.policy_data
+}
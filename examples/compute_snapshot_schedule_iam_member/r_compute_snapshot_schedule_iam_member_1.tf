@@ -0,0 +1,8 @@
+//
+// Grant a role to a single member on an existing resource.
+//
+resource "yandex_compute_snapshot_schedule_iam_member" "member" {
+  snapshot_schedule_id = "your-snapshot-schedule-id"
+  role                 = "viewer"
+  member               = "userAccount:some_user_id"
+}
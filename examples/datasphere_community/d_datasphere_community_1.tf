@@ -2,5 +2,5 @@
 // Get information about existing Datasphere Community.
 //
 data "yandex_datasphere_community" "my_datasphere_community" {
-  community_id = "community-id"
+  id = "community-id"
 }
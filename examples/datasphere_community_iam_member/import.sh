@@ -0,0 +1,2 @@
+# terraform import yandex_datasphere_community_iam_member.<resource Name> "<resource Id>,<resource Role>,<subject Id>"
+terraform import yandex_datasphere_community_iam_member.member "your-community-id,datasphere.communities.developer,userAccount:some_user_id"
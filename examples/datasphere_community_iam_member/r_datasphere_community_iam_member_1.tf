@@ -0,0 +1,8 @@
+//
+// Grant a role to a single member on an existing resource.
+//
+resource "yandex_datasphere_community_iam_member" "member" {
+  community_id = "your-community-id"
+  role         = "datasphere.communities.developer"
+  member       = "userAccount:some_user_id"
+}
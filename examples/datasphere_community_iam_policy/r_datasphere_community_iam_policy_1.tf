@@ -0,0 +1,14 @@
+//
+// Replace all access bindings of an existing resource with a policy.
+//
+data "yandex_iam_policy" "policy" {
+  binding {
+    role    = "datasphere.communities.developer"
+    members = ["userAccount:some_user_id"]
+  }
+}
+
+resource "yandex_datasphere_community_iam_policy" "policy" {
+  community_id = "your-community-id"
+  policy_data  = data.yandex_iam_policy.policy.policy_data
+}
@@ -2,5 +2,5 @@
 // Get information about existing Datasphere Project.
 //
 data "yandex_datasphere_project" "my_datasphere_project" {
-  project_id = "project-id"
+  id = "project-id"
 }
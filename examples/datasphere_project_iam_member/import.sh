@@ -0,0 +1,2 @@
+# terraform import yandex_datasphere_project_iam_member.<resource Name> "<resource Id>,<resource Role>,<subject Id>"
+terraform import yandex_datasphere_project_iam_member.member "your-project-id,datasphere.community-projects.developer,userAccount:some_user_id"
@@ -0,0 +1,8 @@
+//
+// Grant a role to a single member on an existing resource.
+//
+resource "yandex_datasphere_project_iam_member" "member" {
+  project_id = "your-project-id"
+  role       = "datasphere.community-projects.developer"
+  member     = "userAccount:some_user_id"
+}
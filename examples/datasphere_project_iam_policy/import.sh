@@ -0,0 +1,2 @@
+# terraform import yandex_datasphere_project_iam_policy.<resource Name> "<resource Id>"
+terraform import yandex_datasphere_project_iam_policy.policy "your-project-id"
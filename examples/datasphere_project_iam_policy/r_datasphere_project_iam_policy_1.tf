@@ -0,0 +1,14 @@
+//
+// Replace all access bindings of an existing resource with a policy.
+//
+data "yandex_iam_policy" "policy" {
+  binding {
+    role    = "datasphere.community-projects.developer"
+    members = ["userAccount:some_user_id"]
+  }
+}
+
+resource "yandex_datasphere_project_iam_policy" "policy" {
+  project_id  = "your-project-id"
+  policy_data = data.yandex_iam_policy.policy.policy_data
+}
@@ -0,0 +1,11 @@
+//
+// Export an existing DNS Zone to a zone file.
+//
+data "yandex_dns_zone_file" "export" {
+  dns_zone_id = "yandex_dns_zone_id"
+}
+
+resource "local_file" "zone" {
+  filename = "${path.module}/example.com.zone"
+  content  = data.yandex_dns_zone_file.export.zone_file
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_dns_zone_records.<resource Name> <zone_id>
+terraform import yandex_dns_zone_records.records dns9m**********tducf
@@ -0,0 +1,12 @@
+//
+// Manage all records of the zone with a BIND zone file.
+//
+resource "yandex_dns_zone" "zone1" {
+  name = "my-public-zone"
+  zone = "example.com."
+}
+
+resource "yandex_dns_zone_records" "records" {
+  zone_id   = yandex_dns_zone.zone1.id
+  zone_file = file("${path.module}/example.com.zone")
+}
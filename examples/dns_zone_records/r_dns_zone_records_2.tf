@@ -0,0 +1,20 @@
+//
+// Manage all records of the zone with a record list.
+//
+resource "yandex_dns_zone_records" "records" {
+  zone_id = yandex_dns_zone.zone1.id
+
+  record {
+    name = "www"
+    type = "A"
+    ttl  = 300
+    data = ["192.0.2.10", "192.0.2.11"]
+  }
+
+  record {
+    name = "@"
+    type = "MX"
+    ttl  = 600
+    data = ["10 mx1.example.com."]
+  }
+}
@@ -10,4 +10,5 @@ resource "yandex_gitlab_instance" "my_gitlab_instance" {
   domain                    = "gitlab-terraform.gitlab.yandexcloud.net"
   subnet_id                 = yandex_vpc_subnet.a.id
   backup_retain_period_days = 7
+  approval_rules_id         = "NONE"
 }
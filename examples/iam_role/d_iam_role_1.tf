@@ -2,11 +2,5 @@
 // Get information about existing IAM Role.
 //
 data "yandex_iam_role" "admin" {
-  binding {
-    role = "admin"
-
-    members = [
-      "userAccount:user_id_1"
-    ]
-  }
+  role_id = "admin"
 }
@@ -0,0 +1,2 @@
+# terraform import yandex_iam_service_account_key_rotation.<resource Name> <ID of a service account key>
+terraform import yandex_iam_service_account_key_rotation.sa-auth-key aje8n**********bclpb
@@ -0,0 +1,15 @@
+//
+// Rotate IAM Service Account Keys monthly and publish the newest one into Lockbox.
+//
+resource "yandex_iam_service_account_key_rotation" "sa-auth-key" {
+  service_account_id = "aje5a**********qspd3"
+  description        = "rotated key for service account"
+  rotation_period    = "720h"
+  overlap_period     = "72h"
+  keep_keys          = 1
+
+  output_to_lockbox {
+    secret_id             = "e6q8f**********5c2np"
+    entry_for_private_key = "private_key"
+  }
+}
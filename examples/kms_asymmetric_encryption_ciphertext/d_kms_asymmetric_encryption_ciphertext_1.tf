@@ -0,0 +1,21 @@
+//
+// Encrypt a token locally with the public key of a KMS asymmetric encryption key.
+//
+variable "token" {
+  type      = string
+  sensitive = true
+}
+
+resource "yandex_kms_asymmetric_encryption_key" "key-a" {
+  name                 = "example-asymmetric-encryption-key"
+  encryption_algorithm = "RSA_2048_ENC_OAEP_SHA_256"
+}
+
+data "yandex_kms_asymmetric_encryption_ciphertext" "token" {
+  key_id    = yandex_kms_asymmetric_encryption_key.key-a.id
+  plaintext = var.token
+}
+
+output "encrypted_token" {
+  value = data.yandex_kms_asymmetric_encryption_ciphertext.token.ciphertext
+}
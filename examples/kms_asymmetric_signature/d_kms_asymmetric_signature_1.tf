@@ -0,0 +1,16 @@
+//
+// Sign a configuration artifact with a KMS asymmetric signature key.
+//
+resource "yandex_kms_asymmetric_signature_key" "key-a" {
+  name                = "example-asymmetric-signature-key"
+  signature_algorithm = "RSA_2048_SIGN_PSS_SHA_256"
+}
+
+data "yandex_kms_asymmetric_signature" "config" {
+  key_id = yandex_kms_asymmetric_signature_key.key-a.id
+  digest = filebase64sha256("${path.module}/config.yaml")
+}
+
+output "config_signature" {
+  value = data.yandex_kms_asymmetric_signature.config.signature
+}
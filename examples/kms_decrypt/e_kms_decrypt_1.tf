@@ -0,0 +1,14 @@
+//
+// Decrypt a ciphertext produced by a KMS symmetric key without storing the plaintext in the state.
+//
+ephemeral "yandex_kms_decrypt" "db_password" {
+  key_id      = "abj7u**********j38cd"
+  ciphertext  = file("${path.module}/db_password.enc.b64")
+  aad_context = "db"
+}
+
+provider "postgresql" {
+  host     = "c-c9q0m**********8ogl.rw.mdb.yandexcloud.net"
+  username = "admin"
+  password = ephemeral.yandex_kms_decrypt.db_password.plaintext
+}
@@ -0,0 +1,11 @@
+//
+// Get health status of targets attached to a Network Load Balancer.
+//
+data "yandex_lb_network_load_balancer_target_states" "my_nlb_states" {
+  network_load_balancer_id = "my-network-load-balancer-id"
+  target_group_id          = "my-target-group-id"
+}
+
+output "nlb_healthy" {
+  value = data.yandex_lb_network_load_balancer_target_states.my_nlb_states.healthy
+}
@@ -0,0 +1,23 @@
+//
+// Regenerate a Lockbox password every month.
+//
+resource "yandex_lockbox_secret" "db_password" {
+  name = "db password"
+
+  password_payload_specification {
+    password_key = "password"
+    length       = 32
+  }
+}
+
+resource "yandex_lockbox_secret_rotation" "db_password" {
+  secret_id       = yandex_lockbox_secret.db_password.id
+  rotation_period = "720h"
+  max_versions    = 2
+}
+
+// Services always get the newest version.
+data "yandex_lockbox_secret_version" "db_password" {
+  secret_id  = yandex_lockbox_secret.db_password.id
+  version_id = yandex_lockbox_secret_rotation.db_password.current_version_id
+}
@@ -0,0 +1,20 @@
+//
+// Add a new version generated by a command every week.
+//
+resource "yandex_lockbox_secret" "api_token" {
+  name = "api token"
+}
+
+resource "yandex_lockbox_secret_rotation" "api_token" {
+  secret_id       = yandex_lockbox_secret.api_token.id
+  rotation_period = "168h"
+  max_versions    = 3
+
+  entries {
+    key = "token"
+    command {
+      path = "issue_token.sh"
+      args = ["--ttl", "336h"]
+    }
+  }
+}
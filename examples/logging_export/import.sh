@@ -0,0 +1,2 @@
+# terraform import yandex_logging_export.<resource Name> <resource Id>
+terraform import yandex_logging_export.export1 ...
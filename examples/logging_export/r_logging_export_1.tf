@@ -0,0 +1,14 @@
+//
+// Export error log entries of the Logging Group to the Logging Sink.
+//
+resource "yandex_logging_export" "export1" {
+  name      = "errors-archive"
+  folder_id = data.yandex_resourcemanager_folder.test_folder.id
+  group_id  = yandex_logging_group.group1.id
+  sink_id   = yandex_logging_sink.sink1.id
+
+  params {
+    levels = ["ERROR", "FATAL"]
+    filter = "json_payload.request_id != \"\""
+  }
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_logging_sink.<resource Name> <resource Id>
+terraform import yandex_logging_sink.sink1 ...
@@ -0,0 +1,13 @@
+//
+// Create a new Logging Sink which writes log entries to Object Storage.
+//
+resource "yandex_logging_sink" "sink1" {
+  name               = "archive-sink"
+  folder_id          = data.yandex_resourcemanager_folder.test_folder.id
+  service_account_id = yandex_iam_service_account.logs_writer.id
+
+  s3 {
+    bucket = yandex_storage_bucket.logs.bucket
+    prefix = "logs/"
+  }
+}
@@ -11,13 +11,12 @@ resource "yandex_mdb_clickhouse_cluster" "foo" {
   environment = "PRESTABLE"
   network_id  = yandex_vpc_network.foo.id
 
-  resources {
-    resource_preset_id = "s2.micro"
-    disk_type_id       = "network-ssd"
-    disk_size          = 32
-  }
-
-  config {
+  clickhouse {
+    resources {
+      resource_preset_id = "s2.micro"
+      disk_type_id       = "network-ssd"
+      disk_size          = 32
+    }
   }
 
   host {
@@ -1,7 +1,6 @@
 data "yandex_mdb_clickhouse_user" "foo" {
   cluster_id = "some_cluster_id"
   name       = "username"
-  password   = "your_password"
 }
 
 output "permissions" {
@@ -2,16 +2,45 @@
 // Create a new MDB Greenplum database resource group.
 //
 resource "yandex_mdb_greenplum_resource_group" "my_resource_group" {
-  cluster_id     = yandex_mdb_greenplum_cluster.my_cluster.id
-  name           = "alice"
-  password       = "password"
-  resource_group = "default_group"
+  cluster_id          = yandex_mdb_greenplum_cluster.my_cluster.id
+  name                = "my_group"
+  concurrency         = 15
+  cpu_rate_limit      = 25
+  memory_limit        = 35
+  memory_shared_quota = 45
+  memory_spill_ratio  = 55
 }
 
 resource "yandex_mdb_greenplum_cluster" "my_cluster" {
-  name        = "test"
-  environment = "PRESTABLE"
-  network_id  = yandex_vpc_network.foo.id
+  name               = "test"
+  environment        = "PRESTABLE"
+  network_id         = yandex_vpc_network.foo.id
+  zone               = "ru-central1-d"
+  subnet_id          = yandex_vpc_subnet.foo.id
+  assign_public_ip   = false
+  version            = "6.25"
+  master_host_count  = 2
+  segment_host_count = 2
+  segment_in_host    = 1
+
+  master_subcluster {
+    resources {
+      resource_preset_id = "s2.micro"
+      disk_size          = 24
+      disk_type_id       = "network-ssd"
+    }
+  }
+
+  segment_subcluster {
+    resources {
+      resource_preset_id = "s2.micro"
+      disk_size          = 24
+      disk_type_id       = "network-ssd"
+    }
+  }
+
+  user_name     = "admin_user"
+  user_password = "your_super_secret_password"
 }
 
 // Auxiliary resources
@@ -9,9 +9,35 @@ resource "yandex_mdb_greenplum_user" "my_user" {
 }
 
 resource "yandex_mdb_greenplum_cluster" "my_cluster" {
-  name        = "test"
-  environment = "PRESTABLE"
-  network_id  = yandex_vpc_network.foo.id
+  name               = "test"
+  environment        = "PRESTABLE"
+  network_id         = yandex_vpc_network.foo.id
+  zone               = "ru-central1-d"
+  subnet_id          = yandex_vpc_subnet.foo.id
+  assign_public_ip   = false
+  version            = "6.25"
+  master_host_count  = 2
+  segment_host_count = 2
+  segment_in_host    = 1
+
+  master_subcluster {
+    resources {
+      resource_preset_id = "s2.micro"
+      disk_size          = 24
+      disk_type_id       = "network-ssd"
+    }
+  }
+
+  segment_subcluster {
+    resources {
+      resource_preset_id = "s2.micro"
+      disk_size          = 24
+      disk_type_id       = "network-ssd"
+    }
+  }
+
+  user_name     = "admin_user"
+  user_password = "your_super_secret_password"
 }
 
 // Auxiliary resources
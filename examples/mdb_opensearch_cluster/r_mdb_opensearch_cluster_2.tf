@@ -74,6 +74,7 @@ resource "yandex_mdb_opensearch_cluster" "my_cluster" {
 
   auth_settings = {
     saml = {
+      enabled                   = true
       idp_entity_id             = "urn:dev.auth0.example.com"
       idp_metadata_file_content = "<EntityDescriptor entityID=\"https://test_identity_provider.example.com\"></EntityDescriptor>"
       sp_entity_id              = "https://test.example.com",
@@ -22,15 +22,17 @@ resource "yandex_mdb_postgresql_cluster_v2" "my_v2_cluster" {
     }
   }
 
-  maintenance_window {
+  maintenance_window = {
     type = "WEEKLY"
     day  = "SAT"
     hour = 12
   }
 
-  host {
-    zone      = "ru-central1-d"
-    subnet_id = yandex_vpc_subnet.foo.id
+  hosts = {
+    "host" = {
+      zone      = "ru-central1-d"
+      subnet_id = yandex_vpc_subnet.foo.id
+    }
   }
 }
 
@@ -0,0 +1,35 @@
+//
+// Move a cluster managed by yandex_mdb_postgresql_cluster.
+//
+moved {
+  from = yandex_mdb_postgresql_cluster.my_cluster
+  to   = yandex_mdb_postgresql_cluster_v2.my_cluster
+}
+
+resource "yandex_mdb_postgresql_cluster_v2" "my_cluster" {
+  name        = "test"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.foo.id
+
+  config {
+    version = 15
+    resources {
+      resource_preset_id = "s2.micro"
+      disk_type_id       = "network-ssd"
+      disk_size          = 16
+    }
+  }
+
+  hosts = {
+    // The host had `name = "na"` in yandex_mdb_postgresql_cluster.
+    "na" = {
+      zone      = "ru-central1-a"
+      subnet_id = yandex_vpc_subnet.foo.id
+    }
+    // The host had no name, so it is keyed by the first label of its FQDN.
+    "rc1b-fs1ta9ekt2qbhj1b" = {
+      zone      = "ru-central1-b"
+      subnet_id = yandex_vpc_subnet.bar.id
+    }
+  }
+}
@@ -0,0 +1,31 @@
+//
+// Move a cluster managed by yandex_mdb_redis_cluster.
+//
+moved {
+  from = yandex_mdb_redis_cluster.my_cluster
+  to   = yandex_mdb_redis_cluster_v2.my_cluster
+}
+
+resource "yandex_mdb_redis_cluster_v2" "my_cluster" {
+  name        = "test"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.foo.id
+
+  config = {
+    password = "your_password"
+    version  = "7.2-valkey"
+  }
+
+  resources = {
+    resource_preset_id = "hm1.nano"
+    disk_size          = 16
+  }
+
+  hosts = {
+    // Hosts are keyed by the first label of their FQDN.
+    "rc1a-7lb2f1ttfp1d0n2o" = {
+      zone      = "ru-central1-a"
+      subnet_id = yandex_vpc_subnet.foo.id
+    }
+  }
+}
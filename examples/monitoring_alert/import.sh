@@ -0,0 +1,2 @@
+# terraform import yandex_monitoring_alert.<resource Name> <resource Id>
+terraform import yandex_monitoring_alert.cpu-high ...
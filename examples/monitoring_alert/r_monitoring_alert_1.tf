@@ -0,0 +1,36 @@
+//
+// Create a new Monitoring Alert.
+//
+resource "yandex_monitoring_alert" "cpu-high" {
+  name        = "cpu-high"
+  description = "CPU usage of the service is too high"
+  window      = "5m"
+
+  annotations = {
+    summary = "CPU usage is {{pointValue}}%"
+  }
+
+  threshold {
+    query      = "\"cpu_usage\"{service=\"compute\", resource_id=\"{{instance}}\"}"
+    evaluation = "AVG"
+    predicate  = "GT"
+    warn       = 70
+    alarm      = 90
+  }
+
+  parametrization {
+    parameters {
+      id = "instance"
+      label_values {
+        label_key = "resource_id"
+        selectors = "service=\"compute\""
+      }
+    }
+  }
+
+  channel {
+    channel_id            = yandex_monitoring_notification_channel.oncall.id
+    notify_about_statuses = ["ALARM", "OK"]
+    repeat_period         = "1h"
+  }
+}
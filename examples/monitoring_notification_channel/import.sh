@@ -0,0 +1,2 @@
+# terraform import yandex_monitoring_notification_channel.<resource Name> <resource Id>
+terraform import yandex_monitoring_notification_channel.oncall ...
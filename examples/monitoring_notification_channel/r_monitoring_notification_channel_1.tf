@@ -0,0 +1,22 @@
+//
+// Create a new Monitoring Notification Channel.
+//
+resource "yandex_monitoring_notification_channel" "oncall" {
+  name        = "oncall"
+  description = "On-call engineers"
+
+  email {
+    recipients = ["oncall@example.com"]
+  }
+}
+
+resource "yandex_monitoring_notification_channel" "incidents" {
+  name = "incidents"
+
+  webhook {
+    url = "https://hooks.example.com/monitoring"
+    headers = {
+      Authorization = "Bearer ${var.webhook_token}"
+    }
+  }
+}
@@ -0,0 +1,18 @@
+//
+// Check that only the break-glass account has the admin role anywhere in the organization.
+//
+data "yandex_organizationmanager_access_report" "admins" {
+  organization_id = "some_organization_id"
+  roles           = ["admin", "resource-manager.admin"]
+  resource_types  = ["service_account", "kms_symmetric_key"]
+}
+
+check "no_unexpected_admins" {
+  assert {
+    condition = alltrue([
+      for b in data.yandex_organizationmanager_access_report.admins.bindings :
+      b.subject == "userAccount:break_glass_user_id"
+    ])
+    error_message = "Unexpected admin bindings found in the organization."
+  }
+}
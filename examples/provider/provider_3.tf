@@ -0,0 +1,20 @@
+//
+// Configure default labels for all resources
+//
+provider "yandex" {
+  folder_id = "folder_id_here"
+
+  default_labels = {
+    team = "platform"
+    env  = "production"
+  }
+}
+
+resource "yandex_vpc_network" "default" {
+  name = "network"
+
+  // The network gets labels `team`, `env` and `app`.
+  labels = {
+    app = "web"
+  }
+}
@@ -0,0 +1,11 @@
+//
+// Create a Cloud protected from accidental deletion.
+//
+resource "yandex_resourcemanager_cloud" "production" {
+  organization_id = "my_organization_id"
+  name            = "production"
+
+  deletion_protection   = true
+  check_empty_on_delete = true
+  delete_after          = "72h"
+}
@@ -0,0 +1,11 @@
+//
+// Create a Folder protected from accidental deletion.
+//
+resource "yandex_resourcemanager_folder" "production" {
+  cloud_id = "my_cloud_id"
+  name     = "production"
+
+  deletion_protection   = true
+  check_empty_on_delete = true
+  delete_after          = "72h"
+}
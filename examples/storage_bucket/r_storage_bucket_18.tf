@@ -125,7 +125,7 @@ resource "yandex_storage_bucket" "all_settings" {
     list = true
   }
 
-  https = {
+  https {
     certificate_id = "<certificate_id>"
   }
 
@@ -0,0 +1,2 @@
+# terraform import yandex_storage_bucket_iam_member.<resource Name> "<resource Id>,<resource Role>,<subject Id>"
+terraform import yandex_storage_bucket_iam_member.member "your-bucket-name,storage.admin,userAccount:some_user_id"
@@ -0,0 +1,8 @@
+//
+// Grant a role to a single member on an existing resource.
+//
+resource "yandex_storage_bucket_iam_member" "member" {
+  bucket = "your-bucket-name"
+  role   = "storage.admin"
+  member = "userAccount:some_user_id"
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_storage_bucket_iam_policy.<resource Name> "<resource Id>"
+terraform import yandex_storage_bucket_iam_policy.policy "your-bucket-name"
@@ -0,0 +1,14 @@
+//
+// Replace all access bindings of an existing resource with a policy.
+//
+data "yandex_iam_policy" "policy" {
+  binding {
+    role    = "storage.admin"
+    members = ["userAccount:some_user_id"]
+  }
+}
+
+resource "yandex_storage_bucket_iam_policy" "policy" {
+  bucket      = "your-bucket-name"
+  policy_data = data.yandex_iam_policy.policy.policy_data
+}
@@ -5,9 +5,9 @@ resource "yandex_ydb_topic" "my_topic" {
   database_endpoint = yandex_ydb_database_serverless.database_name.ydb_full_endpoint
   name              = "topic-test"
 
-  supported_codecs    = ["raw", "gzip"]
-  partitions_count    = 1
-  retention_period_ms = 2000000
+  supported_codecs       = ["raw", "gzip"]
+  partitions_count       = 1
+  retention_period_hours = 12
   consumer {
     name                          = "consumer-name"
     supported_codecs              = ["raw", "gzip"]
@@ -3,7 +3,13 @@ package main
 import (
 	"github.com/bflad/tfproviderlint/xpasses/XR005"
 	"github.com/bflad/tfproviderlint/xpasses/XS001"
+	"github.com/yandex-cloud/terraform-provider-yandex/lint/pkg/checks/XR009"
+	"github.com/yandex-cloud/terraform-provider-yandex/lint/pkg/checks/XR010"
+	"github.com/yandex-cloud/terraform-provider-yandex/lint/pkg/checks/XR011"
 	"github.com/yandex-cloud/terraform-provider-yandex/lint/pkg/checks/XS003"
+	"github.com/yandex-cloud/terraform-provider-yandex/lint/pkg/checks/XS004"
+	"github.com/yandex-cloud/terraform-provider-yandex/lint/pkg/checks/XS005"
+	"github.com/yandex-cloud/terraform-provider-yandex/lint/pkg/checks/XS006"
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/multichecker"
 )
@@ -12,6 +18,12 @@ var checks = []*analysis.Analyzer{
 	XR005.Analyzer,
 	XS001.Analyzer,
 	XS003.Analyzer,
+	XS004.Analyzer,
+	XS005.Analyzer,
+	XS006.Analyzer,
+	XR009.Analyzer,
+	XR010.Analyzer,
+	XR011.Analyzer,
 }
 
 func main() {
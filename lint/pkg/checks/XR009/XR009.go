@@ -0,0 +1,171 @@
+// Package XR009 defines an Analyzer that checks for
+// resources which don't declare Timeouts.
+package XR009
+
+import (
+	"go/ast"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/lint/pkg/checks/internal/lintutil"
+)
+
+const doc = `Check for resources that Timeouts are declared.
+
+The XR009 analyzer reports SDK resources without Timeouts field and framework 
+resources which schemas don't declare timeouts with terraform-plugin-framework-timeouts 
+(the Schema method or functions it calls in the package should use timeouts.Block 
+or timeouts.Attributes).`
+
+const analyzerName = "XR009"
+
+// Analyzer defines the resource timeouts analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name:             analyzerName,
+	Doc:              doc,
+	Run:              run,
+	RunDespiteErrors: true,
+}
+
+var timeoutsFuncs = map[string]struct{}{
+	"Block":         {},
+	"BlockAll":      {},
+	"Attributes":    {},
+	"AttributesAll": {},
+}
+
+// run performs the analysis on the provided package.
+func run(pass *analysis.Pass) (interface{}, error) {
+	for _, file := range pass.Files {
+		if lintutil.IsTestFile(pass.Fset, file) {
+			continue
+		}
+		lintutil.SDKResources(file, func(lit *ast.CompositeLit, fields map[string]ast.Expr) {
+			if _, ok := fields["Timeouts"]; ok || lintutil.Ignored(pass.Fset, file, analyzerName, lit) {
+				return
+			}
+			pass.Reportf(lit.Pos(), "%s: resource should declare Timeouts", analyzerName)
+		})
+	}
+
+	declaring := timeoutsDeclaringFuncs(pass.Files)
+	for _, file := range pass.Files {
+		if lintutil.IsTestFile(pass.Fset, file) {
+			continue
+		}
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			if _, ok := lintutil.FrameworkResourceType(file, fn); !ok || declaring[funcKey(fn)] {
+				continue
+			}
+			if lintutil.Ignored(pass.Fset, file, analyzerName, fn) {
+				continue
+			}
+			pass.Reportf(fn.Pos(), "%s: resource schema should declare timeouts", analyzerName)
+		}
+	}
+
+	return nil, nil
+}
+
+// timeoutsDeclaringFuncs returns functions of the package which use timeouts schema directly
+// or by calling other functions of the package. Methods called not on the receiver
+// are identified by their names only.
+func timeoutsDeclaringFuncs(files []*ast.File) map[string]bool {
+	var (
+		declaring = make(map[string]bool)
+		callees   = make(map[string][]string)
+	)
+
+	for _, file := range files {
+		timeoutsName := lintutil.ImportName(file, lintutil.FrameworkTimeoutsPath)
+		imported := make(map[string]bool)
+		for _, spec := range file.Imports {
+			if spec.Name != nil {
+				imported[spec.Name.Name] = true
+			}
+		}
+
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			key, recv := funcKey(fn), receiverName(fn)
+
+			ast.Inspect(fn.Body, func(node ast.Node) bool {
+				call, ok := node.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				switch f := call.Fun.(type) {
+				case *ast.Ident:
+					callees[key] = append(callees[key], f.Name)
+				case *ast.SelectorExpr:
+					if name, ok := lintutil.Selector(f, timeoutsName); ok {
+						if _, ok := timeoutsFuncs[name]; ok {
+							declaring[key] = true
+						}
+						return true
+					}
+					x, ok := f.X.(*ast.Ident)
+					switch {
+					case ok && recv != "" && x.Name == recv:
+						callees[key] = append(callees[key], lintutil.ReceiverType(fn)+"."+f.Sel.Name)
+					case !ok || !imported[x.Name]:
+						callees[key] = append(callees[key], "*."+f.Sel.Name)
+					}
+				}
+				return true
+			})
+		}
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for key, names := range callees {
+			if declaring[key] {
+				continue
+			}
+			for _, name := range names {
+				if isDeclaring(declaring, name) {
+					declaring[key] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	return declaring
+}
+
+func isDeclaring(declaring map[string]bool, name string) bool {
+	if !strings.HasPrefix(name, "*.") {
+		return declaring[name]
+	}
+	for key, ok := range declaring {
+		if ok && strings.HasSuffix(key, name[1:]) {
+			return true
+		}
+	}
+	return false
+}
+
+func funcKey(fn *ast.FuncDecl) string {
+	if fn.Recv != nil {
+		return lintutil.ReceiverType(fn) + "." + fn.Name.Name
+	}
+	return fn.Name.Name
+}
+
+func receiverName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) != 1 || len(fn.Recv.List[0].Names) != 1 {
+		return ""
+	}
+	return fn.Recv.List[0].Names[0].Name
+}
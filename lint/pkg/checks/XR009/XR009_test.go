@@ -0,0 +1,13 @@
+package XR009_test
+
+import (
+	"testing"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/lint/pkg/checks/XR009"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestXR009Analyzer(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, XR009.Analyzer, "a")
+}
@@ -0,0 +1,68 @@
+package a
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type withBlock struct{}
+
+func (r *withBlock) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true}),
+		},
+	}
+}
+
+type withSchemaFunc struct{}
+
+func (r *withSchemaFunc) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceSchema(ctx)
+}
+
+func resourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{Create: true}),
+		},
+	}
+}
+
+type withoutTimeouts struct{}
+
+func (r *withoutTimeouts) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) { // want "resource schema should declare timeouts"
+	resp.Schema = schema.Schema{}
+}
+
+var _ = &sdkschema.Resource{
+	CreateContext: nil,
+	Timeouts:      &sdkschema.ResourceTimeout{},
+}
+
+var _ = &sdkschema.Resource{ // want "resource should declare Timeouts"
+	Create: nil,
+}
+
+// Data sources and nested resources don't declare Create
+var _ = &sdkschema.Resource{
+	Read: nil,
+}
+
+type withSchemaMethod struct{}
+
+func (r *withSchemaMethod) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = r.schema(ctx)
+}
+
+func (r *withSchemaMethod) schema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
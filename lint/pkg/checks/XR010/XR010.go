@@ -0,0 +1,68 @@
+// Package XR010 defines an Analyzer that checks for
+// resources which can't be imported.
+package XR010
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/lint/pkg/checks/internal/lintutil"
+)
+
+const doc = `Check for resources that import is implemented.
+
+The XR010 analyzer reports SDK resources without Importer field and framework 
+resources which don't implement resource.ResourceWithImportState 
+(there is no ImportState method of the resource type in the package).`
+
+const analyzerName = "XR010"
+
+// Analyzer defines the resource importer analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name:             analyzerName,
+	Doc:              doc,
+	Run:              run,
+	RunDespiteErrors: true,
+}
+
+// run performs the analysis on the provided package.
+func run(pass *analysis.Pass) (interface{}, error) {
+	importers := make(map[string]bool)
+	for _, file := range pass.Files {
+		if lintutil.IsTestFile(pass.Fset, file) {
+			continue
+		}
+		lintutil.SDKResources(file, func(lit *ast.CompositeLit, fields map[string]ast.Expr) {
+			if _, ok := fields["Importer"]; ok || lintutil.Ignored(pass.Fset, file, analyzerName, lit) {
+				return
+			}
+			pass.Reportf(lit.Pos(), "%s: resource should declare Importer", analyzerName)
+		})
+
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Name.Name == "ImportState" {
+				importers[lintutil.ReceiverType(fn)] = true
+			}
+		}
+	}
+
+	for _, file := range pass.Files {
+		if lintutil.IsTestFile(pass.Fset, file) {
+			continue
+		}
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			resourceType, ok := lintutil.FrameworkResourceType(file, fn)
+			if !ok || importers[resourceType] || lintutil.Ignored(pass.Fset, file, analyzerName, fn) {
+				continue
+			}
+			pass.Reportf(fn.Pos(), "%s: resource %s should implement ImportState", analyzerName, resourceType)
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,13 @@
+package XR010_test
+
+import (
+	"testing"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/lint/pkg/checks/XR010"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestXR010Analyzer(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, XR010.Analyzer, "a")
+}
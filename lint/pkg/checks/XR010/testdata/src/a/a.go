@@ -0,0 +1,45 @@
+package a
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type importable struct{}
+
+func (r *importable) Schema(_ context.Context, _ resource.SchemaRequest, _ *resource.SchemaResponse) {
+}
+
+func (r *importable) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type notImportable struct{}
+
+func (r *notImportable) Schema(_ context.Context, _ resource.SchemaRequest, _ *resource.SchemaResponse) { // want "resource notImportable should implement ImportState"
+}
+
+type notImportableIgnored struct{}
+
+// lintignore:XR010
+func (r *notImportableIgnored) Schema(_ context.Context, _ resource.SchemaRequest, _ *resource.SchemaResponse) {
+}
+
+var _ = &sdkschema.Resource{
+	CreateContext: nil,
+	Importer: &sdkschema.ResourceImporter{
+		StateContext: sdkschema.ImportStatePassthroughContext,
+	},
+}
+
+var _ = &sdkschema.Resource{ // want "resource should declare Importer"
+	CreateContext: nil,
+}
+
+// Data sources and nested resources don't declare Create
+var _ = &sdkschema.Resource{
+	ReadContext: nil,
+}
@@ -0,0 +1,215 @@
+// Package XR011 defines an Analyzer that checks for
+// framework resources and data sources which are not registered in the provider.
+package XR011
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/lint/pkg/checks/internal/lintutil"
+)
+
+const doc = `Check for framework resources and data sources that they are registered in the provider.
+
+The XR011 analyzer reports exported constructors without arguments returning 
+resource.Resource or datasource.DataSource, which are not referred from 
+yandex-framework/provider/provider.go or from the lists of resources 
+it appends (e.g. yandex_gen.GetProviderResources()...).`
+
+const analyzerName = "XR011"
+
+// Analyzer defines the resource registration analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name:             analyzerName,
+	Doc:              doc,
+	Run:              run,
+	RunDespiteErrors: true,
+}
+
+const providerFile = "provider/provider.go"
+
+// run performs the analysis on the provided package.
+func run(pass *analysis.Pass) (interface{}, error) {
+	var constructors []*ast.FuncDecl
+	files := make(map[*ast.FuncDecl]*ast.File)
+	for _, file := range pass.Files {
+		if lintutil.IsTestFile(pass.Fset, file) {
+			continue
+		}
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && isConstructor(file, fn) {
+				constructors = append(constructors, fn)
+				files[fn] = file
+			}
+		}
+	}
+	if len(constructors) == 0 {
+		return nil, nil
+	}
+
+	pkgDir := filepath.Dir(pass.Fset.Position(constructors[0].Pos()).Filename)
+	provider, ok := findProvider(pkgDir)
+	if !ok {
+		// Package is not a part of the provider
+		return nil, nil
+	}
+
+	m := newImportMapper(pkgDir, pass.Pkg.Path())
+	registered := make(map[string]bool)
+	if err := m.collect(filepath.Dir(provider), registered, make(map[string]bool)); err != nil {
+		return nil, err
+	}
+
+	for _, fn := range constructors {
+		if registered[pass.Pkg.Path()+"."+fn.Name.Name] || lintutil.Ignored(pass.Fset, files[fn], analyzerName, fn) {
+			continue
+		}
+		pass.Reportf(fn.Pos(), "%s: %s should be registered in %s", analyzerName, fn.Name.Name, providerFile)
+	}
+
+	return nil, nil
+}
+
+// isConstructor reports whether fn is exported func() resource.Resource or func() datasource.DataSource.
+func isConstructor(file *ast.File, fn *ast.FuncDecl) bool {
+	if fn.Recv != nil || !fn.Name.IsExported() || fn.Type.Params.NumFields() != 0 || fn.Type.Results.NumFields() != 1 {
+		return false
+	}
+
+	result := fn.Type.Results.List[0].Type
+	if name, ok := lintutil.Selector(result, lintutil.ImportName(file, lintutil.FrameworkResourcePath)); ok && name == "Resource" {
+		return true
+	}
+	name, ok := lintutil.Selector(result, lintutil.ImportName(file, lintutil.FrameworkDataSourcePath))
+	return ok && name == "DataSource"
+}
+
+// findProvider looks for provider/provider.go in the parent directories of the package.
+func findProvider(dir string) (string, bool) {
+	for {
+		candidate := filepath.Join(dir, filepath.FromSlash(providerFile))
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate, true
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+// importMapper maps import paths of the module to directories and back.
+type importMapper struct {
+	root   string
+	prefix string
+}
+
+func newImportMapper(pkgDir, pkgPath string) *importMapper {
+	dir := strings.Split(filepath.ToSlash(pkgDir), "/")
+	elems := strings.Split(pkgPath, "/")
+	for len(dir) > 0 && len(elems) > 0 && dir[len(dir)-1] == elems[len(elems)-1] {
+		dir, elems = dir[:len(dir)-1], elems[:len(elems)-1]
+	}
+	return &importMapper{
+		root:   filepath.FromSlash(strings.Join(dir, "/")),
+		prefix: strings.Join(elems, "/"),
+	}
+}
+
+func (m *importMapper) importPath(dir string) string {
+	rel, err := filepath.Rel(m.root, dir)
+	if err != nil {
+		return ""
+	}
+	if m.prefix == "" {
+		return filepath.ToSlash(rel)
+	}
+	return m.prefix + "/" + filepath.ToSlash(rel)
+}
+
+func (m *importMapper) dir(importPath string) (string, bool) {
+	rel := importPath
+	if m.prefix != "" {
+		if !strings.HasPrefix(importPath, m.prefix+"/") {
+			return "", false
+		}
+		rel = strings.TrimPrefix(importPath, m.prefix+"/")
+	}
+	return filepath.Join(m.root, filepath.FromSlash(rel)), true
+}
+
+// collect adds references to functions in the package directory to registered,
+// and follows lists of resources appended from other packages of the module.
+func (m *importMapper) collect(dir string, registered, visited map[string]bool) error {
+	if visited[dir] {
+		return nil
+	}
+	visited[dir] = true
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	pkgPath := m.importPath(dir)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(token.NewFileSet(), filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+
+		imports := make(map[string]string)
+		for _, spec := range file.Imports {
+			p, _ := strconv.Unquote(spec.Path.Value)
+			imports[lintutil.ImportName(file, p)] = p
+		}
+
+		var appended []string
+		ast.Inspect(file, func(node ast.Node) bool {
+			switch n := node.(type) {
+			case *ast.SelectorExpr:
+				if x, ok := n.X.(*ast.Ident); ok && imports[x.Name] != "" {
+					registered[imports[x.Name]+"."+n.Sel.Name] = true
+				}
+			case *ast.Ident:
+				registered[pkgPath+"."+n.Name] = true
+			case *ast.CallExpr:
+				if !n.Ellipsis.IsValid() || len(n.Args) == 0 {
+					return true
+				}
+				list, ok := n.Args[len(n.Args)-1].(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				if sel, ok := list.Fun.(*ast.SelectorExpr); ok {
+					if x, ok := sel.X.(*ast.Ident); ok && imports[x.Name] != "" {
+						appended = append(appended, imports[x.Name])
+					}
+				}
+			}
+			return true
+		})
+
+		for _, p := range appended {
+			if appendedDir, ok := m.dir(p); ok {
+				if err := m.collect(appendedDir, registered, visited); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package XR011_test
+
+import (
+	"testing"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/lint/pkg/checks/XR011"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestXR011Analyzer(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, XR011.Analyzer, "yandex-framework/services/a", "yandex-framework/services/b")
+}
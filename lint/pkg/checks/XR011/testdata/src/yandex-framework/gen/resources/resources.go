@@ -0,0 +1,13 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"yandex-framework/services/b"
+)
+
+func GetProviderResources() []func() resource.Resource {
+	return []func() resource.Resource{
+		b.NewResource,
+	}
+}
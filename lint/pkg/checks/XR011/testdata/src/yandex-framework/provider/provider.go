@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"yandex-framework/gen/resources"
+	"yandex-framework/services/a"
+)
+
+type Provider struct{}
+
+func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
+	return append([]func() resource.Resource{
+		a.NewResource,
+	}, resources.GetProviderResources()...)
+}
+
+func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		func() datasource.DataSource {
+			return a.NewDataSource()
+		},
+	}
+}
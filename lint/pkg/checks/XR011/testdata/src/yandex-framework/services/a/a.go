@@ -0,0 +1,23 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewResource() resource.Resource {
+	return nil
+}
+
+func NewDataSource() datasource.DataSource {
+	return nil
+}
+
+func NewIAMBinding() resource.Resource { // want "NewIAMBinding should be registered in provider/provider.go"
+	return nil
+}
+
+// Constructors with arguments are wrapped in the provider
+func NewResourceWithType(_ string) resource.Resource {
+	return nil
+}
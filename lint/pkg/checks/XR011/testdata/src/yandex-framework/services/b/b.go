@@ -0,0 +1,19 @@
+package b
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewResource() resource.Resource {
+	return nil
+}
+
+func NewDataSource() datasource.DataSource { // want "NewDataSource should be registered in provider/provider.go"
+	return nil
+}
+
+// lintignore:XR011
+func NewDeprecatedResource() resource.Resource {
+	return nil
+}
@@ -0,0 +1,53 @@
+// Package XS004 defines an Analyzer that checks for
+// Schema attributes with secret values which are not Sensitive.
+package XS004
+
+import (
+	"regexp"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/lint/pkg/checks/internal/lintutil"
+)
+
+const doc = `Check for Schema attributes with secret values that Sensitive is configured.
+
+The XS004 analyzer reports string attributes which names contain password, 
+secret or token and which are not marked as Sensitive, so their values 
+would be shown in plan output and logs.`
+
+const analyzerName = "XS004"
+
+// Analyzer defines the sensitive attributes analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name:             analyzerName,
+	Doc:              doc,
+	Run:              run,
+	RunDespiteErrors: true,
+}
+
+var secretName = regexp.MustCompile(`password|secret|token`)
+
+// identifierName matches attributes which refer secrets, but don't contain them (e.g. secret_id).
+var identifierName = regexp.MustCompile(`_(id|ids|name|names)$`)
+
+// run performs the analysis on the provided package.
+func run(pass *analysis.Pass) (interface{}, error) {
+	for _, file := range pass.Files {
+		lintutil.Attributes(file, func(attr *lintutil.Attribute) {
+			if !secretName.MatchString(attr.Name) || identifierName.MatchString(attr.Name) {
+				return
+			}
+			if attr.Type != "StringAttribute" && attr.Type != "TypeString" {
+				return
+			}
+			if lintutil.IsTrue(attr.Fields["Sensitive"]) || lintutil.Ignored(pass.Fset, file, analyzerName, attr.Lit) {
+				return
+			}
+
+			pass.Reportf(attr.Lit.Pos(), "%s: attribute %q should be Sensitive", analyzerName, attr.Name)
+		})
+	}
+
+	return nil, nil
+}
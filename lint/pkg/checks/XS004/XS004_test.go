@@ -0,0 +1,13 @@
+package XS004_test
+
+import (
+	"testing"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/lint/pkg/checks/XS004"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestXS004Analyzer(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, XS004.Analyzer, "a")
+}
@@ -0,0 +1,42 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = map[string]schema.Attribute{
+	"password": schema.StringAttribute{ // want "attribute \"password\" should be Sensitive"
+		Optional: true,
+	},
+	"secret_key": schema.StringAttribute{
+		Optional:  true,
+		Sensitive: true,
+	},
+	"secret_id": schema.StringAttribute{
+		Optional: true,
+	},
+	"generate_password": schema.BoolAttribute{
+		Optional: true,
+	},
+	//lintignore:XS004
+	"password_key": schema.StringAttribute{
+		Optional: true,
+	},
+}
+
+var _ = map[string]*sdkschema.Schema{
+	"iam_token": { // want "attribute \"iam_token\" should be Sensitive"
+		Type:     sdkschema.TypeString,
+		Computed: true,
+	},
+	"admin_password": {
+		Type:      sdkschema.TypeString,
+		Required:  true,
+		Sensitive: true,
+	},
+	"token_ttl": {
+		Type:     sdkschema.TypeInt,
+		Optional: true,
+	},
+}
@@ -0,0 +1,87 @@
+// Package XS005 defines an Analyzer that checks for
+// Schema labels attributes declared in the common way.
+package XS005
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/lint/pkg/checks/internal/lintutil"
+)
+
+const doc = `Check for Schema labels attribute that it is a map of strings with a validator.
+
+The XS005 analyzer reports labels attributes which are not maps of strings, 
+and labels attributes that can be set in configuration but have no validator 
+of label keys and values.`
+
+const analyzerName = "XS005"
+
+// Analyzer defines the labels attribute analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name:             analyzerName,
+	Doc:              doc,
+	Run:              run,
+	RunDespiteErrors: true,
+}
+
+// run performs the analysis on the provided package.
+func run(pass *analysis.Pass) (interface{}, error) {
+	for _, file := range pass.Files {
+		lintutil.Attributes(file, func(attr *lintutil.Attribute) {
+			if attr.Name != "labels" || lintutil.Ignored(pass.Fset, file, analyzerName, attr.Lit) {
+				return
+			}
+
+			if !isStringMap(file, attr) {
+				pass.Reportf(attr.Lit.Pos(), "%s: labels should be a map of strings", analyzerName)
+				return
+			}
+
+			if !attr.ComputedOnly() && !hasValidator(attr) {
+				pass.Reportf(attr.Lit.Pos(), "%s: labels should have a validator", analyzerName)
+			}
+		})
+	}
+
+	return nil, nil
+}
+
+func isStringMap(file *ast.File, attr *lintutil.Attribute) bool {
+	if attr.Framework {
+		elemType, ok := lintutil.Selector(attr.Fields["ElementType"], lintutil.ImportName(file, lintutil.FrameworkTypesPath))
+		return attr.Type == "MapAttribute" && ok && elemType == "StringType"
+	}
+
+	if attr.Type != "TypeMap" {
+		return false
+	}
+	elem, ok := lintutil.CompositeLit(attr.Fields["Elem"])
+	if !ok {
+		return false
+	}
+	elemType, _ := lintutil.Selector(lintutil.Fields(elem)["Type"], lintutil.ImportName(file, lintutil.SDKSchemaPath))
+	return elemType == "TypeString"
+}
+
+func hasValidator(attr *lintutil.Attribute) bool {
+	if attr.Framework {
+		_, ok := attr.Fields["Validators"]
+		return ok
+	}
+
+	validators := []map[string]ast.Expr{attr.Fields}
+	if elem, ok := lintutil.CompositeLit(attr.Fields["Elem"]); ok {
+		validators = append(validators, lintutil.Fields(elem))
+	}
+	for _, fields := range validators {
+		if _, ok := fields["ValidateFunc"]; ok {
+			return true
+		}
+		if _, ok := fields["ValidateDiagFunc"]; ok {
+			return true
+		}
+	}
+	return false
+}
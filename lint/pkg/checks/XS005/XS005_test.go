@@ -0,0 +1,13 @@
+package XS005_test
+
+import (
+	"testing"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/lint/pkg/checks/XS005"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestXS005Analyzer(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, XS005.Analyzer, "a")
+}
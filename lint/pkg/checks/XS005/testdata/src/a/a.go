@@ -0,0 +1,70 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = map[string]schema.Attribute{
+	"labels": schema.MapAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.Map{
+			mapvalidator.SizeAtMost(64),
+		},
+	},
+}
+
+var _ = map[string]schema.Attribute{
+	"labels": schema.MapAttribute{ // want "labels should have a validator"
+		ElementType: types.StringType,
+		Optional:    true,
+	},
+}
+
+var _ = map[string]schema.Attribute{
+	"labels": schema.StringAttribute{ // want "labels should be a map of strings"
+		Optional: true,
+	},
+}
+
+var _ = map[string]dsschema.Attribute{
+	"labels": dsschema.MapAttribute{
+		ElementType: types.StringType,
+		Computed:    true,
+	},
+}
+
+var _ = map[string]*sdkschema.Schema{
+	"labels": {
+		Type:         sdkschema.TypeMap,
+		Optional:     true,
+		Elem:         &sdkschema.Schema{Type: sdkschema.TypeString},
+		ValidateFunc: validateLabels,
+	},
+}
+
+var _ = map[string]*sdkschema.Schema{
+	"labels": { // want "labels should have a validator"
+		Type:     sdkschema.TypeMap,
+		Optional: true,
+		Elem:     &sdkschema.Schema{Type: sdkschema.TypeString},
+	},
+}
+
+var _ = map[string]*sdkschema.Schema{
+	"labels": { // want "labels should be a map of strings"
+		Type:     sdkschema.TypeSet,
+		Optional: true,
+		Elem:     &sdkschema.Schema{Type: sdkschema.TypeString},
+	},
+}
+
+func validateLabels(interface{}, string) ([]string, []error) {
+	return nil, nil
+}
@@ -0,0 +1,46 @@
+// Package XS006 defines an Analyzer that checks for
+// Schema folder_id attributes which are Optional and Computed.
+package XS006
+
+import (
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/lint/pkg/checks/internal/lintutil"
+)
+
+const doc = `Check for Schema folder_id attribute that it is Optional and Computed.
+
+The XS006 analyzer reports folder_id attributes which can be set in configuration, 
+but are not Optional and Computed. The folder of the provider configuration is used 
+when folder_id is not set, so the attribute should be Computed to store it in the state.`
+
+const analyzerName = "XS006"
+
+// Analyzer defines the folder_id attribute analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name:             analyzerName,
+	Doc:              doc,
+	Run:              run,
+	RunDespiteErrors: true,
+}
+
+// run performs the analysis on the provided package.
+func run(pass *analysis.Pass) (interface{}, error) {
+	for _, file := range pass.Files {
+		lintutil.Attributes(file, func(attr *lintutil.Attribute) {
+			if attr.Name != "folder_id" || attr.ComputedOnly() {
+				return
+			}
+			if lintutil.IsTrue(attr.Fields["Optional"]) && lintutil.IsTrue(attr.Fields["Computed"]) {
+				return
+			}
+			if lintutil.Ignored(pass.Fset, file, analyzerName, attr.Lit) {
+				return
+			}
+
+			pass.Reportf(attr.Lit.Pos(), "%s: folder_id should be Optional and Computed", analyzerName)
+		})
+	}
+
+	return nil, nil
+}
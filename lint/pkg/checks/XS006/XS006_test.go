@@ -0,0 +1,13 @@
+package XS006_test
+
+import (
+	"testing"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/lint/pkg/checks/XS006"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestXS006Analyzer(t *testing.T) {
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, XS006.Analyzer, "a")
+}
@@ -0,0 +1,48 @@
+package a
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var _ = map[string]schema.Attribute{
+	"folder_id": schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+	},
+}
+
+var _ = map[string]schema.Attribute{
+	"folder_id": schema.StringAttribute{ // want "folder_id should be Optional and Computed"
+		Required: true,
+	},
+}
+
+var _ = map[string]schema.Attribute{
+	"folder_id": schema.StringAttribute{ // want "folder_id should be Optional and Computed"
+		Optional: true,
+	},
+}
+
+var _ = map[string]*sdkschema.Schema{
+	"folder_id": {
+		Type:     sdkschema.TypeString,
+		Computed: true,
+	},
+}
+
+var _ = map[string]*sdkschema.Schema{
+	"folder_id": { // want "folder_id should be Optional and Computed"
+		Type:     sdkschema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	},
+}
+
+var _ = map[string]*sdkschema.Schema{
+	//lintignore:XS006
+	"folder_id": {
+		Type:     sdkschema.TypeString,
+		Required: true,
+	},
+}
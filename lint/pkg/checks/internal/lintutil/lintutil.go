@@ -0,0 +1,258 @@
+// Package lintutil contains helpers for analyzers of schemas and resources
+// declared with terraform-plugin-framework and terraform-plugin-sdk.
+package lintutil
+
+import (
+	"go/ast"
+	"go/token"
+	"path"
+	"strconv"
+	"strings"
+)
+
+const (
+	FrameworkResourcePath         = "github.com/hashicorp/terraform-plugin-framework/resource"
+	FrameworkDataSourcePath       = "github.com/hashicorp/terraform-plugin-framework/datasource"
+	FrameworkResourceSchemaPath   = "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	FrameworkDataSourceSchemaPath = "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	FrameworkTypesPath            = "github.com/hashicorp/terraform-plugin-framework/types"
+	FrameworkTimeoutsPath         = "github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	SDKSchemaPath                 = "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ImportName returns the name the package with the path is referred by in the file,
+// or empty string if the file doesn't import it.
+func ImportName(file *ast.File, importPath string) string {
+	for _, spec := range file.Imports {
+		p, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || p != importPath {
+			continue
+		}
+		if spec.Name != nil {
+			return spec.Name.Name
+		}
+		return path.Base(p)
+	}
+	return ""
+}
+
+// Selector returns the selected name if expr is a selector of the package (e.g. schema.StringAttribute).
+func Selector(expr ast.Expr, pkgName string) (string, bool) {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok || pkgName == "" {
+		return "", false
+	}
+	ident, ok := sel.X.(*ast.Ident)
+	if !ok || ident.Name != pkgName {
+		return "", false
+	}
+	return sel.Sel.Name, true
+}
+
+// CompositeLit unwraps &T{...} and returns the composite literal.
+func CompositeLit(expr ast.Expr) (*ast.CompositeLit, bool) {
+	if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		expr = unary.X
+	}
+	lit, ok := expr.(*ast.CompositeLit)
+	return lit, ok
+}
+
+// Fields returns values of the keyed fields of the struct literal.
+func Fields(lit *ast.CompositeLit) map[string]ast.Expr {
+	fields := make(map[string]ast.Expr, len(lit.Elts))
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if key, ok := kv.Key.(*ast.Ident); ok {
+			fields[key.Name] = kv.Value
+		}
+	}
+	return fields
+}
+
+// IsTrue reports whether the expression is the true constant.
+func IsTrue(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == "true"
+}
+
+// Attribute is an attribute of framework schema or SDK schema declared in map literal.
+type Attribute struct {
+	Name string
+	Lit  *ast.CompositeLit
+	// Type is the type of framework attribute (e.g. StringAttribute)
+	// or the value of Type field of SDK schema (e.g. TypeString).
+	Type string
+	// Framework is true for attributes of terraform-plugin-framework.
+	Framework bool
+	Fields    map[string]ast.Expr
+}
+
+// ComputedOnly reports whether the attribute can't be set in configuration.
+func (a *Attribute) ComputedOnly() bool {
+	return IsTrue(a.Fields["Computed"]) && !IsTrue(a.Fields["Optional"]) && !IsTrue(a.Fields["Required"])
+}
+
+// Attributes calls fn for every attribute declared in map literals of the file:
+// map[string]schema.Attribute{...} of framework or map[string]*schema.Schema{...} of SDK.
+func Attributes(file *ast.File, fn func(attr *Attribute)) {
+	frameworkNames := []string{
+		ImportName(file, FrameworkResourceSchemaPath),
+		ImportName(file, FrameworkDataSourceSchemaPath),
+	}
+	sdkName := ImportName(file, SDKSchemaPath)
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		lit, ok := node.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		mapType, ok := lit.Type.(*ast.MapType)
+		if !ok {
+			return true
+		}
+
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			key, ok := kv.Key.(*ast.BasicLit)
+			if !ok || key.Kind != token.STRING {
+				continue
+			}
+			name, err := strconv.Unquote(key.Value)
+			if err != nil {
+				continue
+			}
+			value, ok := CompositeLit(kv.Value)
+			if !ok {
+				continue
+			}
+
+			if attr, ok := frameworkAttribute(value, frameworkNames); ok {
+				attr.Name = name
+				fn(attr)
+				continue
+			}
+			if attr, ok := sdkAttribute(mapType, value, sdkName); ok {
+				attr.Name = name
+				fn(attr)
+			}
+		}
+		return true
+	})
+}
+
+func frameworkAttribute(lit *ast.CompositeLit, pkgNames []string) (*Attribute, bool) {
+	for _, pkgName := range pkgNames {
+		typeName, ok := Selector(lit.Type, pkgName)
+		if !ok || !strings.HasSuffix(typeName, "Attribute") {
+			continue
+		}
+		return &Attribute{Lit: lit, Type: typeName, Framework: true, Fields: Fields(lit)}, true
+	}
+	return nil, false
+}
+
+func sdkAttribute(mapType *ast.MapType, lit *ast.CompositeLit, pkgName string) (*Attribute, bool) {
+	litType := lit.Type
+	if litType == nil {
+		// Type of the element is elided: map[string]*schema.Schema{"name": {...}}
+		litType = mapType.Value
+		if star, ok := litType.(*ast.StarExpr); ok {
+			litType = star.X
+		}
+	}
+	if typeName, ok := Selector(litType, pkgName); !ok || typeName != "Schema" {
+		return nil, false
+	}
+
+	attr := &Attribute{Lit: lit, Fields: Fields(lit)}
+	attr.Type, _ = Selector(attr.Fields["Type"], pkgName)
+	return attr, true
+}
+
+// FrameworkResourceType returns the receiver type name if the method is Schema method of framework resource.
+func FrameworkResourceType(file *ast.File, fn *ast.FuncDecl) (string, bool) {
+	if fn.Recv == nil || len(fn.Recv.List) != 1 || fn.Name.Name != "Schema" {
+		return "", false
+	}
+
+	resourceName := ImportName(file, FrameworkResourcePath)
+	for _, param := range fn.Type.Params.List {
+		if name, ok := Selector(param.Type, resourceName); ok && name == "SchemaRequest" {
+			return ReceiverType(fn), true
+		}
+	}
+	return "", false
+}
+
+// ReceiverType returns the name of the method receiver type.
+func ReceiverType(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) != 1 {
+		return ""
+	}
+	expr := fn.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// SDKResources calls fn for every schema.Resource literal of SDK which declares Create function,
+// nested resources and data sources are skipped.
+func SDKResources(file *ast.File, fn func(lit *ast.CompositeLit, fields map[string]ast.Expr)) {
+	sdkName := ImportName(file, SDKSchemaPath)
+	if sdkName == "" {
+		return
+	}
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		lit, ok := node.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		if typeName, ok := Selector(lit.Type, sdkName); !ok || typeName != "Resource" {
+			return true
+		}
+
+		fields := Fields(lit)
+		for _, create := range []string{"Create", "CreateContext", "CreateWithoutTimeout"} {
+			if _, ok := fields[create]; ok {
+				fn(lit, fields)
+				break
+			}
+		}
+		return true
+	})
+}
+
+// Ignored reports whether the node is marked with //lintignore:<analyzer> comment
+// at the end of the line or on the preceding line.
+func Ignored(fset *token.FileSet, file *ast.File, analyzer string, node ast.Node) bool {
+	line := fset.Position(node.Pos()).Line
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			commentLine := fset.Position(comment.Pos()).Line
+			if commentLine != line && commentLine != line-1 {
+				continue
+			}
+			if strings.TrimSpace(strings.TrimPrefix(comment.Text, "//")) == "lintignore:"+analyzer {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsTestFile reports whether the file is a test file, resources declared in tests are not checked.
+func IsTestFile(fset *token.FileSet, file *ast.File) bool {
+	return strings.HasSuffix(fset.Position(file.Pos()).Filename, "_test.go")
+}
@@ -0,0 +1,174 @@
+package accessbinding
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/terraform-provider-yandex/common/mutexkv"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
+)
+
+const (
+	// DefaultBatchWindow is how long the first delta for a resource waits for other deltas to the same resource.
+	DefaultBatchWindow = 200 * time.Millisecond
+
+	// DefaultBatchTimeout limits a single batched UpdateAccessBindings call, since the call outlives
+	// the request of any single resource that contributed to it.
+	DefaultBatchTimeout = 5 * time.Minute
+)
+
+// SendDeltasFunc applies access binding deltas to a resource, e.g. via UpdateAccessBindings.
+type SendDeltasFunc func(ctx context.Context, deltas []*access.AccessBindingDelta) error
+
+// DeltaBatcher coalesces access binding deltas submitted concurrently for the same resource
+// into as few UpdateAccessBindings calls as possible.
+//
+// Deltas are keyed by the updater mutex key, so a batch never races with a read-modify-set
+// of the same resource. When a batched call fails, deltas of each submitter are resent
+// separately, so that every resource gets its own error.
+type DeltaBatcher struct {
+	locker  *mutexkv.MutexKV
+	window  time.Duration
+	maxSize int
+	timeout time.Duration
+	mu      sync.Mutex
+	batches map[string]*deltaBatch
+}
+
+type deltaBatch struct {
+	ctx      context.Context
+	send     SendDeltasFunc
+	requests []*deltaRequest
+}
+
+type deltaRequest struct {
+	deltas []*access.AccessBindingDelta
+	done   chan error
+}
+
+func NewDeltaBatcher(locker *mutexkv.MutexKV, window time.Duration, maxSize int) *DeltaBatcher {
+	return &DeltaBatcher{
+		locker:  locker,
+		window:  window,
+		maxSize: maxSize,
+		timeout: DefaultBatchTimeout,
+		batches: make(map[string]*deltaBatch),
+	}
+}
+
+var defaultDeltaBatcher = NewDeltaBatcher(globallock.GetMutexKV(), DefaultBatchWindow, UpdateAccessBindingsBatchSize)
+
+// Submit queues deltas for the resource identified by key and waits until they are applied.
+// The send function and context values of the first submitter in a batch are used for the whole batch.
+//
+// When ctx is done before the batch is flushed, the deltas are withdrawn from the batch and ctx.Err() is returned.
+// Once the batch is being sent, Submit waits for the result, so a returned error always means the deltas were not applied.
+func (b *DeltaBatcher) Submit(ctx context.Context, key string, deltas []*access.AccessBindingDelta, send SendDeltasFunc) error {
+	req := &deltaRequest{
+		deltas: deltas,
+		done:   make(chan error, 1),
+	}
+
+	b.mu.Lock()
+	batch, ok := b.batches[key]
+	if !ok {
+		batch = &deltaBatch{ctx: context.WithoutCancel(ctx), send: send}
+		b.batches[key] = batch
+		time.AfterFunc(b.window, func() { b.flush(key) })
+	}
+	batch.requests = append(batch.requests, req)
+	b.mu.Unlock()
+
+	select {
+	case err := <-req.done:
+		return err
+	case <-ctx.Done():
+		if b.withdraw(key, req) {
+			return ctx.Err()
+		}
+		return <-req.done
+	}
+}
+
+// withdraw removes req from the pending batch of key. Returns false if the batch is already being sent.
+func (b *DeltaBatcher) withdraw(key string, req *deltaRequest) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	batch, ok := b.batches[key]
+	if !ok {
+		return false
+	}
+	for i, r := range batch.requests {
+		if r == req {
+			batch.requests = append(batch.requests[:i], batch.requests[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (b *DeltaBatcher) flush(key string) {
+	b.mu.Lock()
+	batch := b.batches[key]
+	delete(b.batches, key)
+	b.mu.Unlock()
+
+	if batch == nil {
+		return
+	}
+
+	b.locker.Lock(key)
+	defer b.locker.Unlock(key)
+
+	ctx, cancel := context.WithTimeout(batch.ctx, b.timeout)
+	defer cancel()
+
+	for _, chunk := range chunkDeltaRequests(batch.requests, b.maxSize) {
+		var deltas []*access.AccessBindingDelta
+		for _, r := range chunk {
+			deltas = append(deltas, r.deltas...)
+		}
+
+		log.Printf("[DEBUG] Sending %d batched access binding deltas of %d resources for %q", len(deltas), len(chunk), key)
+		err := batch.send(ctx, deltas)
+		if err != nil && len(chunk) > 1 {
+			log.Printf("[DEBUG] Batched access binding update for %q failed, retrying deltas separately: %s", key, err)
+			for _, r := range chunk {
+				r.done <- batch.send(ctx, r.deltas)
+			}
+			continue
+		}
+
+		for _, r := range chunk {
+			r.done <- err
+		}
+	}
+}
+
+// chunkDeltaRequests splits requests into chunks of at most maxSize deltas without splitting a single request.
+// A request larger than maxSize forms a chunk of its own.
+func chunkDeltaRequests(requests []*deltaRequest, maxSize int) [][]*deltaRequest {
+	var (
+		chunks [][]*deltaRequest
+		chunk  []*deltaRequest
+		size   int
+	)
+
+	for _, r := range requests {
+		if len(chunk) > 0 && size+len(r.deltas) > maxSize {
+			chunks = append(chunks, chunk)
+			chunk, size = nil, 0
+		}
+		chunk = append(chunk, r)
+		size += len(r.deltas)
+	}
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
@@ -0,0 +1,166 @@
+package accessbinding
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/terraform-provider-yandex/common/mutexkv"
+)
+
+type recordingSender struct {
+	mu    sync.Mutex
+	calls [][]string
+	fail  string
+}
+
+func (s *recordingSender) send(_ context.Context, deltas []*access.AccessBindingDelta) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var members []string
+	for _, d := range deltas {
+		members = append(members, canonicalMember(d.AccessBinding))
+	}
+	s.calls = append(s.calls, members)
+
+	for _, m := range members {
+		if m == s.fail {
+			return errors.New("invalid subject " + m)
+		}
+	}
+	return nil
+}
+
+func addDelta(member string) []*access.AccessBindingDelta {
+	return []*access.AccessBindingDelta{
+		{
+			Action:        access.AccessBindingAction_ADD,
+			AccessBinding: roleMemberToAccessBinding("viewer", member),
+		},
+	}
+}
+
+func submitAll(b *DeltaBatcher, key string, members []string, sender *recordingSender) map[string]error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs = map[string]error{}
+	)
+	for _, m := range members {
+		wg.Add(1)
+		go func(m string) {
+			defer wg.Done()
+			err := b.Submit(context.Background(), key, addDelta(m), sender.send)
+			mu.Lock()
+			errs[m] = err
+			mu.Unlock()
+		}(m)
+	}
+	wg.Wait()
+	return errs
+}
+
+func TestDeltaBatcherCoalesces(t *testing.T) {
+	b := NewDeltaBatcher(mutexkv.NewMutexKV(), 50*time.Millisecond, 2)
+	sender := &recordingSender{}
+
+	errs := submitAll(b, "iam-folder-1", []string{"userAccount:a", "userAccount:b", "userAccount:c"}, sender)
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	// Three deltas with a batch size of two are sent in two calls.
+	require.Len(t, sender.calls, 2)
+	require.Len(t, sender.calls[0], 2)
+	require.Len(t, sender.calls[1], 1)
+}
+
+func TestDeltaBatcherAttributesErrors(t *testing.T) {
+	b := NewDeltaBatcher(mutexkv.NewMutexKV(), 50*time.Millisecond, 10)
+	sender := &recordingSender{fail: "userAccount:bad"}
+
+	errs := submitAll(b, "iam-folder-1", []string{"userAccount:a", "userAccount:bad", "userAccount:c"}, sender)
+
+	require.NoError(t, errs["userAccount:a"])
+	require.NoError(t, errs["userAccount:c"])
+	require.ErrorContains(t, errs["userAccount:bad"], "invalid subject")
+	// One failed batched call followed by a call per submitter.
+	require.Len(t, sender.calls, 4)
+}
+
+func TestDeltaBatcherSeparatesKeys(t *testing.T) {
+	b := NewDeltaBatcher(mutexkv.NewMutexKV(), 10*time.Millisecond, 10)
+	sender := &recordingSender{}
+
+	var wg sync.WaitGroup
+	for _, key := range []string{"iam-folder-1", "iam-folder-2"} {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			require.NoError(t, b.Submit(context.Background(), key, addDelta("userAccount:a"), sender.send))
+		}(key)
+	}
+	wg.Wait()
+
+	require.Len(t, sender.calls, 2)
+}
+
+func TestChunkDeltaRequests(t *testing.T) {
+	req := func(n int) *deltaRequest {
+		return &deltaRequest{deltas: make([]*access.AccessBindingDelta, n)}
+	}
+	requests := []*deltaRequest{req(1), req(3), req(1), req(5), req(1)}
+
+	chunks := chunkDeltaRequests(requests, 4)
+
+	var sizes []int
+	for _, c := range chunks {
+		sizes = append(sizes, len(c))
+	}
+	require.Equal(t, []int{2, 1, 1, 1}, sizes)
+}
+
+func TestDeltaBatcherWithdrawsCancelledDeltas(t *testing.T) {
+	b := NewDeltaBatcher(mutexkv.NewMutexKV(), 100*time.Millisecond, 10)
+	sender := &recordingSender{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		require.NoError(t, b.Submit(context.Background(), "iam-folder-1", addDelta("userAccount:a"), sender.send))
+	}()
+
+	err := b.Submit(ctx, "iam-folder-1", addDelta("userAccount:b"), sender.send)
+	require.ErrorIs(t, err, context.Canceled)
+	wg.Wait()
+
+	// The cancelled submitter's delta never reaches the API.
+	require.Equal(t, [][]string{{"userAccount:a"}}, sender.calls)
+}
+
+func TestDeltaBatcherWaitsForFlushingBatch(t *testing.T) {
+	b := NewDeltaBatcher(mutexkv.NewMutexKV(), 10*time.Millisecond, 10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sent := make(chan struct{})
+	send := func(context.Context, []*access.AccessBindingDelta) error {
+		// The submitter gives up while the batch is in flight.
+		cancel()
+		close(sent)
+		return errors.New("permission denied")
+	}
+
+	err := b.Submit(ctx, "iam-folder-1", addDelta("userAccount:a"), send)
+	<-sent
+	// The actual result is returned instead of ctx.Err(), since the delta has been sent.
+	require.ErrorContains(t, err, "permission denied")
+}
@@ -2,4 +2,7 @@ package accessbinding
 
 const (
 	DefaultPageSize = 1000
+
+	// UpdateAccessBindingsBatchSize is the maximum number of deltas sent in a single UpdateAccessBindings call.
+	UpdateAccessBindingsBatchSize = 1000
 )
@@ -5,24 +5,26 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
 	"golang.org/x/exp/maps"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 type bindingResource struct {
 	ResourceUpdater ResourceIamUpdater
+	onlineValidation
 }
 
 func NewIamBinding(updater ResourceIamUpdater) resource.Resource {
-	return &bindingResource{updater}
+	return &bindingResource{ResourceUpdater: updater}
 }
 
 func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -172,19 +174,13 @@ func (r *bindingResource) Metadata(_ context.Context, req resource.MetadataReque
 }
 
 func (r *bindingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.configure(req)
 	r.ResourceUpdater.Configure(ctx, req, resp)
 }
 
 func (r *bindingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 
-	res_alias := strings.Replace(strings.Split(r.ResourceUpdater.GetIdAlias(), "_id")[0], "_", " ", -1)
-
-	caser := cases.Title(language.English)
-	res_name := caser.String(res_alias)
-	if strings.HasPrefix(res_name, "Gpu") {
-		res := strings.Replace(res_name, "Gpu", "GPU", 1)
-		res_name = res
-	}
+	res_name := resourceTitle(r.ResourceUpdater)
 	res_suffix := "yandex_" + r.ResourceUpdater.GetNameSuffix()
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Allows creation and management of a single binding within IAM policy for an existing `" + res_name + "`.",
@@ -192,11 +188,17 @@ func (r *bindingResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			"role": schema.StringAttribute{
 				MarkdownDescription: "The role that should be assigned. Only one " + res_suffix + " can be used per role.",
 				Required:            true,
+				Validators: []validator.String{
+					validate.IamRoleValidator(),
+				},
 			},
 			"members": schema.SetAttribute{
 				MarkdownDescription: "An array of identities that will be granted the privilege in the `role`. Each entry can have one of the following values:\n * **userAccount:{user_id}**: A unique user ID that represents a specific Yandex account.\n * **serviceAccount:{service_account_id}**: A unique service account ID.\n * **federatedUser:{federated_user_id}**: A unique federated user ID.\n * **federatedUser:{federated_user_id}:**: A unique SAML federation user account ID.\n * **group:{group_id}**: A unique group ID.\n * **system:group:federation:{federation_id}:users**: All users in federation.\n * **system:group:organization:{organization_id}:users**: All users in organization.\n * **system:allAuthenticatedUsers**: All authenticated users.\n * **system:allUsers**: All users, including unauthenticated ones.\n\n~> for more information about system groups, see [Cloud Documentation](https://yandex.cloud/docs/iam/concepts/access-control/system-group).\n\n",
 				Required:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validate.IamMemberValidator()),
+				},
 			},
 		},
 	}
@@ -0,0 +1,245 @@
+package accessbinding
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	"golang.org/x/exp/maps"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type memberResource struct {
+	ResourceUpdater ResourceIamUpdater
+	onlineValidation
+}
+
+// NewIamMember returns a non-authoritative resource that grants a single role to a single member.
+// Other members of the role are preserved.
+func NewIamMember(updater ResourceIamUpdater) resource.Resource {
+	return &memberResource{ResourceUpdater: updater}
+}
+
+func (r *memberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.ResourceUpdater.Initialize(ctx, req.Plan, &resp.Diagnostics)
+	member := getResourceIamMember(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.addMember(ctx, member)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Add Resource Policy Member",
+			fmt.Sprintf("An unexpected error occurred while attempting to add resource policy member. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err),
+		)
+		return
+	}
+
+	r.setMemberState(ctx, member, &resp.State, &resp.Diagnostics)
+}
+
+func (r *memberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.ResourceUpdater.Initialize(ctx, req.State, &resp.Diagnostics)
+	member := getResourceIamMember(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.ResourceUpdater.GetResourceIamPolicy(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			tflog.Debug(ctx, fmt.Sprintf("Resource %s is missing or deleted, removing member from state", r.ResourceUpdater.DescribeResource()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource Policies",
+			fmt.Sprintf("An unexpected error occurred while refreshing resource policies. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Retrieved access bindings of %s: %+v", r.ResourceUpdater.DescribeResource(), policy))
+
+	if !rolesToMembersMap(policy.Bindings)[member.RoleId][canonicalMember(member)] {
+		tflog.Debug(ctx, fmt.Sprintf("Member %q with role %q does not exist in access bindings of %s, removing from state",
+			canonicalMember(member), member.RoleId, r.ResourceUpdater.DescribeResource()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.setMemberState(ctx, member, &resp.State, &resp.Diagnostics)
+}
+
+// Update changes only the resource id attribute in place, since `role` and `member` require replacement.
+// The member is moved from the resource in state to the planned one.
+func (r *memberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.ResourceUpdater.Initialize(ctx, req.State, &resp.Diagnostics)
+	member := getResourceIamMember(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.removeMember(ctx, member)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource Policy Member",
+			fmt.Sprintf("An unexpected error occurred while updating resource policy member. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err))
+		return
+	}
+
+	r.ResourceUpdater.Initialize(ctx, req.Plan, &resp.Diagnostics)
+	member = getResourceIamMember(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = r.addMember(ctx, member)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource Policy Member",
+			fmt.Sprintf("An unexpected error occurred while updating resource policy member. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err))
+		return
+	}
+
+	r.setMemberState(ctx, member, &resp.State, &resp.Diagnostics)
+}
+
+func (r *memberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.ResourceUpdater.Initialize(ctx, req.State, &resp.Diagnostics)
+	member := getResourceIamMember(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.removeMember(ctx, member)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource Policy Member",
+			fmt.Sprintf("An unexpected error occurred while deleting resource policy member. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err))
+	}
+}
+
+func (r *memberResource) addMember(ctx context.Context, member *access.AccessBinding) error {
+	return iamPolicyReadModifyUpdate(ctx, r.ResourceUpdater, &PolicyDelta{
+		Deltas: []*access.AccessBindingDelta{
+			{
+				Action:        access.AccessBindingAction_ADD,
+				AccessBinding: member,
+			},
+		},
+	})
+}
+
+func (r *memberResource) removeMember(ctx context.Context, member *access.AccessBinding) error {
+	err := iamPolicyReadModifyUpdate(ctx, r.ResourceUpdater, &PolicyDelta{
+		Deltas: []*access.AccessBindingDelta{
+			{
+				Action:        access.AccessBindingAction_REMOVE,
+				AccessBinding: member,
+			},
+		},
+	})
+	if status.Code(err) == codes.NotFound {
+		tflog.Debug(ctx, fmt.Sprintf("Resource %s is missing or deleted, marking member as deleted", r.ResourceUpdater.DescribeResource()))
+		return nil
+	}
+	return err
+}
+
+func (r *memberResource) setMemberState(ctx context.Context, member *access.AccessBinding, state Settable, diag *diag.Diagnostics) {
+	diag.Append(state.SetAttribute(ctx, path.Root("role"), member.RoleId)...)
+	diag.Append(state.SetAttribute(ctx, path.Root("member"), canonicalMember(member))...)
+	diag.Append(state.SetAttribute(ctx, path.Root(r.ResourceUpdater.GetIdAlias()), r.ResourceUpdater.GetId())...)
+}
+
+func (r *memberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + nameSuffix(r.ResourceUpdater, "member")
+}
+
+func (r *memberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.configure(req)
+	r.ResourceUpdater.Configure(ctx, req, resp)
+}
+
+func (r *memberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Allows creation and management of a single member for a single binding within the IAM policy for an existing `" + resourceTitle(r.ResourceUpdater) + "`.\n\n" +
+			"~> Roles controlled by `yandex_" + r.ResourceUpdater.GetNameSuffix() + "` should not be assigned using `yandex_" + nameSuffix(r.ResourceUpdater, "member") + "`.\n",
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				MarkdownDescription: "The role that should be applied. See [roles catalog](https://yandex.cloud/docs/iam/roles-reference).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.IamRoleValidator(),
+				},
+			},
+			"member": schema.StringAttribute{
+				MarkdownDescription: "The identity that will be granted the privilege that is specified in the `role` field. It can have one of the following values:\n * **userAccount:{user_id}**: A unique user ID that represents a specific Yandex account.\n * **serviceAccount:{service_account_id}**: A unique service account ID.\n * **federatedUser:{federated_user_id}**: A unique federated user ID.\n * **federatedUser:{federated_user_id}:**: A unique SAML federation user account ID.\n * **group:{group_id}**: A unique group ID.\n * **system:group:federation:{federation_id}:users**: All users in federation.\n * **system:group:organization:{organization_id}:users**: All users in organization.\n * **system:allAuthenticatedUsers**: All authenticated users.\n * **system:allUsers**: All users, including unauthenticated ones.\n\n~> for more information about system groups, see [Cloud Documentation](https://yandex.cloud/docs/iam/concepts/access-control/system-group).\n\n",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.IamMemberValidator(),
+				},
+			},
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, r.ResourceUpdater.GetSchemaAttributes())
+}
+
+func (r *memberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.SplitN(req.ID, ",", 3)
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || !memberRegexp.MatchString(idParts[2]) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: {resource_id},{role},{member}. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.ResourceUpdater.GetIdAlias()), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member"), idParts[2])...)
+}
+
+var memberRegexp = regexp.MustCompile(`^[^:]+:.+$`)
+
+func getResourceIamMember(ctx context.Context, state Extractable, diag *diag.Diagnostics) *access.AccessBinding {
+	var role, member types.String
+
+	diag.Append(state.GetAttribute(ctx, path.Root("role"), &role)...)
+	diag.Append(state.GetAttribute(ctx, path.Root("member"), &member)...)
+	if diag.HasError() {
+		return nil
+	}
+
+	return roleMemberToAccessBinding(role.ValueString(), member.ValueString())
+}
@@ -0,0 +1,214 @@
+package accessbinding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/exp/maps"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type policyResource struct {
+	ResourceUpdater ResourceIamUpdater
+}
+
+// NewIamPolicy returns an authoritative resource that replaces all access bindings of the target resource
+// with the bindings from `policy_data`.
+func NewIamPolicy(updater ResourceIamUpdater) resource.Resource {
+	return &policyResource{updater}
+}
+
+func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.ResourceUpdater.Initialize(ctx, req.Plan, &resp.Diagnostics)
+	policyData := getResourceIamPolicyData(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setPolicy(ctx, policyData); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Set Resource Policy",
+			fmt.Sprintf("An unexpected error occurred while attempting to set resource policy. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_data"), policyData)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.ResourceUpdater.GetIdAlias()), r.ResourceUpdater.GetId())...)
+}
+
+func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.ResourceUpdater.Initialize(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.ResourceUpdater.GetResourceIamPolicy(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			tflog.Debug(ctx, fmt.Sprintf("Resource %s is missing or deleted, removing policy from state", r.ResourceUpdater.DescribeResource()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource Policies",
+			fmt.Sprintf("An unexpected error occurred while refreshing resource policies. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Retrieved access bindings of %s: %+v", r.ResourceUpdater.DescribeResource(), policy))
+
+	// Keep the configured document if it describes the same bindings, so that
+	// ordering and formatting differences do not produce a diff.
+	var stateData types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("policy_data"), &stateData)...)
+	if statePolicy, err := unmarshalIamPolicy(stateData.ValueString()); err == nil && policiesEqual(statePolicy, policy) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_data"), marshalIamPolicy(policy))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.ResourceUpdater.GetIdAlias()), r.ResourceUpdater.GetId())...)
+}
+
+func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.ResourceUpdater.Initialize(ctx, req.Plan, &resp.Diagnostics)
+	policyData := getResourceIamPolicyData(ctx, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setPolicy(ctx, policyData); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource Policy",
+			fmt.Sprintf("An unexpected error occurred while updating resource policy. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_data"), policyData)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.ResourceUpdater.GetIdAlias()), r.ResourceUpdater.GetId())...)
+}
+
+func (r *policyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.ResourceUpdater.Initialize(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set an empty policy to delete the attached policy.
+	err := iamPolicyReadModifySet(ctx, r.ResourceUpdater, func(p *Policy) error {
+		p.Bindings = nil
+		return nil
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource Policy",
+			fmt.Sprintf("An unexpected error occurred while deleting resource policy. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err))
+	}
+}
+
+func (r *policyResource) setPolicy(ctx context.Context, policyData string) error {
+	policy, err := unmarshalIamPolicy(policyData)
+	if err != nil {
+		return fmt.Errorf("'policy_data' is not valid for %s: %w", r.ResourceUpdater.DescribeResource(), err)
+	}
+
+	return iamPolicyReadModifySet(ctx, r.ResourceUpdater, func(p *Policy) error {
+		p.Bindings = policy.Bindings
+		return nil
+	})
+}
+
+func (r *policyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + nameSuffix(r.ResourceUpdater, "policy")
+}
+
+func (r *policyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.ResourceUpdater.Configure(ctx, req, resp)
+}
+
+func (r *policyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Allows creation and management of the IAM policy for an existing `" + resourceTitle(r.ResourceUpdater) + "`.\n\n" +
+			"~> `yandex_" + nameSuffix(r.ResourceUpdater, "policy") + "` is authoritative and replaces all access bindings of the resource. It cannot be used together with `yandex_" + r.ResourceUpdater.GetNameSuffix() + "` or `yandex_" + nameSuffix(r.ResourceUpdater, "member") + "`.\n",
+		Attributes: map[string]schema.Attribute{
+			"policy_data": schema.StringAttribute{
+				MarkdownDescription: "The policy data generated by a `yandex_iam_policy` data source.",
+				Required:            true,
+				Validators: []validator.String{
+					policyDataValidator{},
+				},
+			},
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, r.ResourceUpdater.GetSchemaAttributes())
+}
+
+func (r *policyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier with format: {resource_id}. Got empty string",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.ResourceUpdater.GetIdAlias()), req.ID)...)
+}
+
+func getResourceIamPolicyData(ctx context.Context, state Extractable, diag *diag.Diagnostics) string {
+	var policyData types.String
+	diag.Append(state.GetAttribute(ctx, path.Root("policy_data"), &policyData)...)
+	return policyData.ValueString()
+}
+
+func marshalIamPolicy(policy *Policy) string {
+	pdBytes, _ := json.Marshal(&Policy{
+		Bindings: policy.Bindings,
+	})
+
+	return string(pdBytes)
+}
+
+func unmarshalIamPolicy(policyData string) (*Policy, error) {
+	policy := &Policy{}
+	if err := json.Unmarshal([]byte(policyData), policy); err != nil {
+		return nil, fmt.Errorf("Could not unmarshal policy data %s:\n%w", policyData, err)
+	}
+	return policy, nil
+}
+
+type policyDataValidator struct{}
+
+func (v policyDataValidator) Description(_ context.Context) string {
+	return "value must be a policy document generated by the `yandex_iam_policy` data source"
+}
+
+func (v policyDataValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v policyDataValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := unmarshalIamPolicy(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Policy Data", err.Error())
+	}
+}
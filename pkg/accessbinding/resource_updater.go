@@ -102,3 +102,20 @@ func iamPolicyReadModifySet(ctx context.Context, updater ResourceIamUpdater, mod
 
 	return nil
 }
+
+// iamPolicyReadModifyUpdate applies deltas through the provider-wide batcher, so that concurrent
+// `iam_member` changes of the same resource are sent in a single UpdateAccessBindings call.
+func iamPolicyReadModifyUpdate(ctx context.Context, updater ResourceIamUpdater, policyDelta *PolicyDelta) error {
+	tflog.Debug(ctx, fmt.Sprintf("Updating access bindings of %s with %+v", updater.DescribeResource(), policyDelta.Deltas))
+
+	err := defaultDeltaBatcher.Submit(ctx, updater.GetMutexKey(), policyDelta.Deltas, func(ctx context.Context, deltas []*access.AccessBindingDelta) error {
+		return updater.UpdateResourceIamPolicy(ctx, &PolicyDelta{Deltas: deltas})
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating access bindings of %s: %w", updater.DescribeResource(), err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updated access bindings of %s", updater.DescribeResource()))
+
+	return nil
+}
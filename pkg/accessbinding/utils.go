@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
 )
 
 func roleMemberToAccessBinding(role, member string) *access.AccessBinding {
@@ -96,3 +98,40 @@ func CountBatches(size, batchSize int) int {
 	}
 	return iterations
 }
+
+// resourceTitle returns the human-readable name of the resource type derived from the id alias, e.g. `Disk` for `disk_id`.
+func resourceTitle(updater ResourceIamUpdater) string {
+	alias := strings.Replace(strings.Split(updater.GetIdAlias(), "_id")[0], "_", " ", -1)
+
+	caser := cases.Title(language.English)
+	name := caser.String(alias)
+	if strings.HasPrefix(name, "Gpu") {
+		name = strings.Replace(name, "Gpu", "GPU", 1)
+	}
+	return name
+}
+
+// nameSuffix returns the Terraform name suffix of the given IAM resource flavor. Updaters report the `_iam_binding`
+// suffix, so `_iam_member` and `_iam_policy` names are derived from it.
+func nameSuffix(updater ResourceIamUpdater, flavor string) string {
+	return strings.TrimSuffix(updater.GetNameSuffix(), "_iam_binding") + "_iam_" + flavor
+}
+
+// policiesEqual compares policies ignoring order and duplicates of bindings.
+func policiesEqual(p, other *Policy) bool {
+	a, b := rolesToMembersMap(p.Bindings), rolesToMembersMap(other.Bindings)
+	if len(a) != len(b) {
+		return false
+	}
+	for role, members := range a {
+		if len(members) != len(b[role]) {
+			return false
+		}
+		for member := range members {
+			if !b[role][member] {
+				return false
+			}
+		}
+	}
+	return true
+}
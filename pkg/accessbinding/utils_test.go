@@ -0,0 +1,57 @@
+package accessbinding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+)
+
+type suffixUpdater struct {
+	ResourceIamUpdater
+	suffix, alias string
+}
+
+func (u suffixUpdater) GetNameSuffix() string { return u.suffix }
+func (u suffixUpdater) GetIdAlias() string    { return u.alias }
+
+func TestNameSuffix(t *testing.T) {
+	u := suffixUpdater{suffix: "compute_gpu_cluster_iam_binding", alias: "gpu_cluster_id"}
+
+	require.Equal(t, "compute_gpu_cluster_iam_member", nameSuffix(u, "member"))
+	require.Equal(t, "compute_gpu_cluster_iam_policy", nameSuffix(u, "policy"))
+	require.Equal(t, "GPU Cluster", resourceTitle(u))
+}
+
+func TestPoliciesEqual(t *testing.T) {
+	p := &Policy{Bindings: []*access.AccessBinding{
+		roleMemberToAccessBinding("viewer", "userAccount:a"),
+		roleMemberToAccessBinding("editor", "serviceAccount:b"),
+	}}
+	reordered := &Policy{Bindings: []*access.AccessBinding{
+		roleMemberToAccessBinding("editor", "serviceAccount:b"),
+		roleMemberToAccessBinding("viewer", "userAccount:a"),
+		roleMemberToAccessBinding("viewer", "userAccount:a"),
+	}}
+	other := &Policy{Bindings: []*access.AccessBinding{
+		roleMemberToAccessBinding("viewer", "userAccount:a"),
+		roleMemberToAccessBinding("editor", "serviceAccount:c"),
+	}}
+
+	require.True(t, policiesEqual(p, reordered))
+	require.False(t, policiesEqual(p, other))
+	require.False(t, policiesEqual(p, &Policy{}))
+}
+
+func TestIamPolicyDataRoundTrip(t *testing.T) {
+	// Format produced by the yandex_iam_policy data source.
+	data := `{"Bindings":[{"role_id":"viewer","subject":{"id":"a","type":"userAccount"}}]}`
+
+	policy, err := unmarshalIamPolicy(data)
+	require.NoError(t, err)
+	require.Equal(t, "userAccount:a", canonicalMember(policy.Bindings[0]))
+	require.Equal(t, data, marshalIamPolicy(policy))
+
+	_, err = unmarshalIamPolicy("not a policy")
+	require.Error(t, err)
+}
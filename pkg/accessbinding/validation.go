@@ -0,0 +1,82 @@
+package accessbinding
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+// onlineValidation verifies roles and members against the API at plan time
+// if `iam_online_validation` is enabled in the provider.
+type onlineValidation struct {
+	providerConfig *provider_config.Config
+}
+
+func (v *onlineValidation) configure(req resource.ConfigureRequest) {
+	if cfg, ok := req.ProviderData.(*provider_config.Config); ok {
+		v.providerConfig = cfg
+	}
+}
+
+func (v *onlineValidation) enabled() bool {
+	return v.providerConfig != nil && v.providerConfig.SDK != nil && v.providerConfig.ProviderState.IamOnlineValidation.ValueBool()
+}
+
+func (v *onlineValidation) validate(ctx context.Context, role types.String, members []types.String, diags *diag.Diagnostics) {
+	checker := validate.GetIamChecker(v.providerConfig.SDK)
+
+	if !role.IsUnknown() && !role.IsNull() {
+		if err := checker.CheckRole(ctx, role.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root("role"), "Invalid IAM role", err.Error())
+		}
+	}
+
+	for _, member := range members {
+		if member.IsUnknown() || member.IsNull() {
+			continue
+		}
+		if err := checker.CheckMember(ctx, member.ValueString()); err != nil {
+			diags.AddError("Invalid IAM member", err.Error())
+		}
+	}
+}
+
+func (r *memberResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !r.enabled() {
+		return
+	}
+
+	var role, member types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("role"), &role)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("member"), &member)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.validate(ctx, role, []types.String{member}, &resp.Diagnostics)
+}
+
+func (r *bindingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !r.enabled() {
+		return
+	}
+
+	var role types.String
+	var members types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("role"), &role)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("members"), &members)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var memberValues []types.String
+	if !members.IsUnknown() && !members.IsNull() {
+		resp.Diagnostics.Append(members.ElementsAs(ctx, &memberValues, false)...)
+	}
+	r.validate(ctx, role, memberValues, &resp.Diagnostics)
+}
@@ -8,12 +8,15 @@ import (
 
 	"reflect"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -24,7 +27,10 @@ func NewProtobufMapDataAdapter() *ProtobufMapDataAdapter {
 
 type ProtobufMapDataAdapter struct{}
 
-const protoTag = "protobuf"
+const (
+	protoTag      = "protobuf"
+	protoOneofTag = "protobuf_oneof"
+)
 
 var wrapperTypes = []reflect.Type{
 	reflect.TypeOf(&wrapperspb.BoolValue{}),
@@ -67,6 +73,21 @@ var primitiveTypes = []reflect.Kind{
 	reflect.String,
 }
 
+// wrapperNames full names of wrapper messages, they are mapped to primitive attributes
+var wrapperNames = func() map[protoreflect.FullName]struct{} {
+	names := make(map[protoreflect.FullName]struct{}, len(wrapperTypes))
+	for _, t := range wrapperTypes {
+		m := reflect.New(t.Elem()).Interface().(proto.Message)
+		names[m.ProtoReflect().Descriptor().FullName()] = struct{}{}
+	}
+	return names
+}()
+
+// isMessage reports whether the type is a pointer to a nested message, wrappers are not messages for the adapter
+func isMessage(t reflect.Type) bool {
+	return t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct && !slices.Contains(wrapperTypes, t)
+}
+
 var intTypes = []reflect.Kind{
 	reflect.Int,
 	reflect.Int8,
@@ -104,9 +125,21 @@ func FindTag(f reflect.StructField, tag, attrTagName string) (string, bool) {
 // Returns err if all attributes is not mapped to a struct
 // Mapping fields by protobuf tag
 // Mapping attribute type must be compatible with a target field type
+//
+// Nested message is filled from an object or map attribute with the name of the field,
+// if there is no such attribute, fields of the nested message are taken from the same attributes map.
+// Oneof is set to the member which attributes are provided, members are found by protoreflect.
+// Proto maps are filled from map or object attributes.
 func (f *ProtobufMapDataAdapter) Fill(ctx context.Context, target any, attributes map[string]attr.Value, diags *diag.Diagnostics) {
 	unhandledAttrs := maps.Clone(attributes)
 	f.fill(ctx, target, unhandledAttrs, diags)
+	if diags.HasError() {
+		return
+	}
+
+	for key := range unhandledAttrs {
+		diags.AddError("Error protobuf filler", fmt.Sprintf("Attribute %s is not mapped", key))
+	}
 }
 
 func (f *ProtobufMapDataAdapter) fill(ctx context.Context, target any, attributes map[string]attr.Value, diags *diag.Diagnostics) {
@@ -124,12 +157,36 @@ func (f *ProtobufMapDataAdapter) fill(ctx context.Context, target any, attribute
 
 	for i := 0; i < targetReflectVal.NumField(); i++ {
 		field := targetType.Field(i)
+		if oneofName := field.Tag.Get(protoOneofTag); oneofName != "" {
+			f.fillOneof(ctx, target, targetReflectVal.Field(i), oneofName, attributes, diags)
+			if diags.HasError() {
+				return
+			}
+			continue
+		}
+
 		if field.Tag.Get(protoTag) == "" {
 			continue
 		}
 
 		// If pointer to struct
-		if field.Type.Kind() == reflect.Ptr && field.Type.Elem().Kind() == reflect.Struct && !slices.Contains(wrapperTypes, field.Type) {
+		if isMessage(field.Type) {
+			fieldName, _ := FindTag(field, protoTag, "name")
+			if attrVal, ok := attributes[fieldName]; ok {
+				delete(attributes, fieldName)
+				if attrVal.IsNull() || attrVal.IsUnknown() {
+					continue
+				}
+
+				setVal := f.mapToMessage(ctx, field.Type, attrVal, diags)
+				if diags.HasError() {
+					diags.AddError("Error protobuf filler", fmt.Sprintf("Attribute is not mapped for field %s", fieldName))
+					return
+				}
+				targetReflectVal.Field(i).Set(setVal)
+				continue
+			}
+
 			targetNestedField := targetReflectVal.Field(i)
 			if targetNestedField.IsNil() {
 				targetNestedField.Set(reflect.New(field.Type.Elem()))
@@ -170,10 +227,93 @@ func (f *ProtobufMapDataAdapter) fill(ctx context.Context, target any, attribute
 		targetReflectVal.Field(i).Set(setVal)
 
 	}
+}
 
-	for key := range attributes {
-		diags.AddError("Error protobuf filler", fmt.Sprintf("Attribute %s is not mapped", key))
+// fillOneof sets the oneof field of the target message to the member which attributes are provided.
+// Attributes of a message member are its name (object attribute) or names of its fields.
+func (f *ProtobufMapDataAdapter) fillOneof(ctx context.Context, target any, field reflect.Value, oneofName string, attributes map[string]attr.Value, diags *diag.Diagnostics) {
+	msg, ok := target.(proto.Message)
+	if !ok {
+		diags.AddError("Error protobuf filler", fmt.Sprintf("Target with oneof %s must be a protobuf message", oneofName))
+		return
+	}
+
+	m := msg.ProtoReflect()
+	od := m.Descriptor().Oneofs().ByName(protoreflect.Name(oneofName))
+	if od == nil {
+		diags.AddError("Error protobuf filler", fmt.Sprintf("Oneof %s is not found in %s", oneofName, m.Descriptor().FullName()))
+		return
+	}
+
+	var member protoreflect.FieldDescriptor
+	for i := 0; i < od.Fields().Len(); i++ {
+		fd := od.Fields().Get(i)
+		names := memberAttributes(fd)
+		if !hasValues(attributes, names) {
+			// Null attributes of other members are not mapped to anything
+			for _, name := range names {
+				delete(attributes, name)
+			}
+			continue
+		}
+
+		if member != nil {
+			diags.AddError(
+				"Error protobuf filler",
+				fmt.Sprintf("Attributes of %s and %s are set, but only one of oneof %s is allowed", member.Name(), fd.Name(), oneofName),
+			)
+			return
+		}
+		member = fd
+	}
+
+	if member == nil {
+		return
+	}
+
+	// Oneof wrapper is created by protobuf and filled as a regular struct
+	m.Set(member, m.NewField(member))
+	f.fill(ctx, field.Elem().Interface(), attributes, diags)
+}
+
+// memberAttributes returns names of attributes which may set the oneof member
+func memberAttributes(fd protoreflect.FieldDescriptor) []string {
+	names := []string{string(fd.Name())}
+	if md := fd.Message(); md != nil && !fd.IsList() && !fd.IsMap() {
+		if _, ok := wrapperNames[md.FullName()]; !ok {
+			names = append(names, messageAttributes(md)...)
+		}
+	}
+	return names
+}
+
+// messageAttributes returns names of attributes of the message, which nested messages are flattened to
+func messageAttributes(md protoreflect.MessageDescriptor) []string {
+	var names []string
+	for i := 0; i < md.Fields().Len(); i++ {
+		names = append(names, memberAttributes(md.Fields().Get(i))...)
 	}
+	return names
+}
+
+func hasValues(attributes map[string]attr.Value, names []string) bool {
+	for _, name := range names {
+		if v, ok := attributes[name]; ok && !v.IsNull() && !v.IsUnknown() {
+			return true
+		}
+	}
+	return false
+}
+
+// attributeElements returns attributes of object or elements of map attribute
+func attributeElements(attribute attr.Value) (map[string]attr.Value, bool) {
+	switch v := attribute.(type) {
+	case interface{ Attributes() map[string]attr.Value }:
+		return v.Attributes(), true
+	case interface{ Elements() map[string]attr.Value }:
+		return v.Elements(), true
+	}
+	return nil, false
 }
 
 func (f *ProtobufMapDataAdapter) mapAttributeToType(ctx context.Context, t reflect.Type, attribute attr.Value, diags *diag.Diagnostics) reflect.Value {
@@ -194,6 +334,14 @@ func (f *ProtobufMapDataAdapter) mapAttributeToType(ctx context.Context, t refle
 		return f.mapToSlice(ctx, t, attribute, diags)
 	}
 
+	if t.Kind() == reflect.Map {
+		return f.mapToMap(ctx, t, attribute, diags)
+	}
+
+	if isMessage(t) {
+		return f.mapToMessage(ctx, t, attribute, diags)
+	}
+
 	diags.AddError("Error protobuf filler", fmt.Sprintf("%s type is not supported for mapping", t.Name()))
 	return reflect.Value{}
 }
@@ -390,6 +538,69 @@ func (b *ProtobufMapDataAdapter) mapToSlice(ctx context.Context, t reflect.Type,
 	return slice
 }
 
+func (b *ProtobufMapDataAdapter) mapToMap(ctx context.Context, t reflect.Type, attribute attr.Value, diags *diag.Diagnostics) reflect.Value {
+	elements, ok := attributeElements(attribute)
+	if !ok {
+		diags.AddError("Error protobuf filler", fmt.Sprintf("Attribute for %s must be map or object", t))
+		return reflect.Value{}
+	}
+
+	m := reflect.MakeMapWithSize(t, len(elements))
+	for k, v := range elements {
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		key := b.mapToKey(t.Key(), k, diags)
+		val := b.mapAttributeToType(ctx, t.Elem(), v, diags)
+		if diags.HasError() {
+			diags.AddError("Error protobuf filler", fmt.Sprintf("Attribute map element %s is not mapped for %s", k, t))
+			return reflect.Value{}
+		}
+		m.SetMapIndex(key, val)
+	}
+	return m
+}
+
+func (b *ProtobufMapDataAdapter) mapToKey(t reflect.Type, key string, diags *diag.Diagnostics) reflect.Value {
+	var (
+		v   any
+		err error
+	)
+	switch t.Kind() {
+	case reflect.String:
+		v = key
+	case reflect.Bool:
+		v, err = strconv.ParseBool(key)
+	case reflect.Int32, reflect.Int64:
+		v, err = strconv.ParseInt(key, 10, t.Bits())
+	case reflect.Uint32, reflect.Uint64:
+		v, err = strconv.ParseUint(key, 10, t.Bits())
+	default:
+		err = fmt.Errorf("%s type is not supported for map keys", t)
+	}
+	if err != nil {
+		diags.AddError("Error protobuf filler", fmt.Sprintf("Map key %s is not mapped: %s", key, err))
+		return reflect.Value{}
+	}
+	return reflect.ValueOf(v).Convert(t)
+}
+
+func (b *ProtobufMapDataAdapter) mapToMessage(ctx context.Context, t reflect.Type, attribute attr.Value, diags *diag.Diagnostics) reflect.Value {
+	attributes, ok := attributeElements(attribute)
+	if !ok {
+		diags.AddError("Error protobuf filler", fmt.Sprintf("Attribute for %s must be object or map", t.Elem().Name()))
+		return reflect.Value{}
+	}
+
+	msg := reflect.New(t.Elem())
+	b.Fill(ctx, msg.Interface(), attributes, diags)
+	if diags.HasError() {
+		return reflect.Value{}
+	}
+	return msg
+}
+
 /*-------------------------------Extract-------------------------------*/
 
 // Extract attr.Value from a provided struct by tag
@@ -399,6 +610,8 @@ func (b *ProtobufMapDataAdapter) mapToSlice(ctx context.Context, t reflect.Type,
 // For any nil returns NullValue
 // For any primitive value returns explicit Value
 // For slices returns types.TupleValue
+// For proto maps returns types.MapValue, messages inside maps and slices are types.ObjectValue
+// Fields of nested messages and the set oneof member are extracted to the same attributes map
 func (b *ProtobufMapDataAdapter) Extract(ctx context.Context, src any, diags *diag.Diagnostics) map[string]attr.Value {
 
 	if src == nil {
@@ -425,13 +638,24 @@ func (b *ProtobufMapDataAdapter) Extract(ctx context.Context, src any, diags *di
 
 	for i := 0; i < srcType.NumField(); i++ {
 		field := srcType.Field(i)
+		if field.Tag.Get(protoOneofTag) != "" {
+			if srcVal.Field(i).IsNil() {
+				continue
+			}
+
+			maps.Copy(attributes, b.Extract(ctx, srcVal.Field(i).Interface(), diags))
+			if diags.HasError() {
+				return nil
+			}
+			continue
+		}
+
 		fieldName, ok := FindTag(field, protoTag, "name")
 		if !ok {
 			continue
 		}
 
-		if field.Type.Kind() == reflect.Ptr && field.Type.Elem().Kind() == reflect.Struct &&
-			!slices.Contains(wrapperTypes, field.Type) || field.Type.Kind() == reflect.Struct {
+		if isMessage(field.Type) || field.Type.Kind() == reflect.Struct {
 
 			extendedAttributes := b.Extract(ctx, srcVal.Field(i).Interface(), diags)
 			if diags.HasError() {
@@ -457,6 +681,124 @@ func (b *ProtobufMapDataAdapter) Extract(ctx context.Context, src any, diags *di
 	return attributes
 }
 
+// ExtractObject attributes of a provided struct to types.Object with attrTypes
+// src must be a struct or a pointer to a struct, nil pointer is extracted to null object
+//
+// Numbers are converted to types.Int64 or types.Float64 and tuples to types.List by attrTypes,
+// attributes missing in src are null
+// Nested message is extracted to an object if attrTypes has an object attribute with the name of the field
+func (b *ProtobufMapDataAdapter) ExtractObject(ctx context.Context, src any, attrTypes map[string]attr.Type, diags *diag.Diagnostics) types.Object {
+	attributes := b.Extract(ctx, src, diags)
+	if attributes == nil || diags.HasError() {
+		return types.ObjectNull(attrTypes)
+	}
+
+	nested := nestedMessages(src)
+	values := make(map[string]attr.Value, len(attrTypes))
+	for name, t := range attrTypes {
+		if ot, ok := t.(types.ObjectType); ok {
+			if msg, ok := nested[name]; ok {
+				values[name] = b.ExtractObject(ctx, msg.Interface(), ot.AttrTypes, diags)
+				if diags.HasError() {
+					return types.ObjectNull(attrTypes)
+				}
+				continue
+			}
+		}
+
+		values[name] = b.convertAttribute(ctx, attributes[name], t, diags)
+		if diags.HasError() {
+			return types.ObjectNull(attrTypes)
+		}
+	}
+
+	obj, d := types.ObjectValue(attrTypes, values)
+	diags.Append(d...)
+	return obj
+}
+
+// nestedMessages returns nested message fields of the struct by names
+func nestedMessages(src any) map[string]reflect.Value {
+	srcVal := reflect.ValueOf(src)
+	if srcVal.Kind() == reflect.Ptr {
+		srcVal = srcVal.Elem()
+	}
+
+	nested := make(map[string]reflect.Value)
+	for i := 0; i < srcVal.NumField(); i++ {
+		field := srcVal.Type().Field(i)
+		fieldName, ok := FindTag(field, protoTag, "name")
+		if ok && isMessage(field.Type) {
+			nested[fieldName] = srcVal.Field(i)
+		}
+	}
+	return nested
+}
+
+func (b *ProtobufMapDataAdapter) convertAttribute(ctx context.Context, v attr.Value, t attr.Type, diags *diag.Diagnostics) attr.Value {
+	if v == nil || v.IsNull() {
+		null, err := t.ValueFromTerraform(ctx, tftypes.NewValue(t.TerraformType(ctx), nil))
+		if err != nil {
+			diags.AddError("Error protobuf extractor", fmt.Sprintf("Null value of %s is not created: %s", t, err))
+		}
+		return null
+	}
+
+	switch {
+	case t.Equal(types.Int64Type):
+		if n, ok := v.(types.Number); ok {
+			i, _ := n.ValueBigFloat().Int64()
+			return types.Int64Value(i)
+		}
+	case t.Equal(types.Float64Type):
+		if n, ok := v.(types.Number); ok {
+			f, _ := n.ValueBigFloat().Float64()
+			return types.Float64Value(f)
+		}
+	}
+
+	if lt, ok := t.(types.ListType); ok {
+		if tuple, ok := v.(types.Tuple); ok {
+			elements := make([]attr.Value, 0, len(tuple.Elements()))
+			for _, e := range tuple.Elements() {
+				elements = append(elements, b.convertAttribute(ctx, e, lt.ElemType, diags))
+			}
+			list, d := types.ListValue(lt.ElemType, elements)
+			diags.Append(d...)
+			return list
+		}
+	}
+
+	if mt, ok := t.(types.MapType); ok {
+		if m, ok := v.(types.Map); ok {
+			elements := make(map[string]attr.Value, len(m.Elements()))
+			for k, e := range m.Elements() {
+				elements[k] = b.convertAttribute(ctx, e, mt.ElemType, diags)
+			}
+			res, d := types.MapValue(mt.ElemType, elements)
+			diags.Append(d...)
+			return res
+		}
+	}
+
+	if ot, ok := t.(types.ObjectType); ok {
+		if o, ok := v.(types.Object); ok {
+			attributes := make(map[string]attr.Value, len(ot.AttrTypes))
+			for name, at := range ot.AttrTypes {
+				attributes[name] = b.convertAttribute(ctx, o.Attributes()[name], at, diags)
+			}
+			res, d := types.ObjectValue(ot.AttrTypes, attributes)
+			diags.Append(d...)
+			return res
+		}
+	}
+
+	if !v.Type(ctx).Equal(t) {
+		diags.AddError("Error protobuf extractor", fmt.Sprintf("Attribute of type %s can't be converted to %s", v.Type(ctx), t))
+	}
+	return v
+}
+
 func (b *ProtobufMapDataAdapter) getAttributeFromReflectValue(ctx context.Context, srcType reflect.Type, srcVal reflect.Value, diags *diag.Diagnostics) attr.Value {
 
 	if slices.Contains(wrapperTypes, srcType) {
@@ -473,22 +815,20 @@ func (b *ProtobufMapDataAdapter) getAttributeFromReflectValue(ctx context.Contex
 		srcVal = srcVal.Elem().FieldByName("Value")
 	}
 
+	if isMessage(srcType) {
+		return b.getObjectFromReflectValue(ctx, srcType, srcVal, diags)
+	}
+
+	if srcType.Kind() == reflect.Map {
+		return b.getMapFromReflectValue(ctx, srcType, srcVal, diags)
+	}
+
 	if srcType.Kind() == reflect.Slice {
 		if srcVal.IsNil() {
 			return types.TupleNull([]attr.Type{})
 		}
 
 		srcType = srcType.Elem()
-		var attrElemType attr.Type
-		switch srcType.Kind() {
-		case reflect.Bool:
-			attrElemType = types.BoolType
-		case reflect.Int, reflect.Int32, reflect.Int64, reflect.Float64, reflect.Float32:
-			attrElemType = types.NumberType
-		case reflect.String:
-			attrElemType = types.StringType
-		}
-
 		elements := make([]attr.Value, srcVal.Len())
 		elementsType := make([]attr.Type, srcVal.Len())
 		for i := 0; i < srcVal.Len(); i++ {
@@ -497,7 +837,7 @@ func (b *ProtobufMapDataAdapter) getAttributeFromReflectValue(ctx context.Contex
 				return nil
 			}
 			elements[i] = attrElemVal
-			elementsType[i] = attrElemType
+			elementsType[i] = attrElemVal.Type(ctx)
 		}
 
 		res, d := types.TupleValue(elementsType, elements)
@@ -523,3 +863,75 @@ func (b *ProtobufMapDataAdapter) getAttributeFromReflectValue(ctx context.Contex
 		return nil
 	}
 }
+
+// getObjectFromReflectValue returns types.ObjectValue of the message, nil message is a null object
+func (b *ProtobufMapDataAdapter) getObjectFromReflectValue(ctx context.Context, srcType reflect.Type, srcVal reflect.Value, diags *diag.Diagnostics) attr.Value {
+	if srcVal.IsNil() {
+		objType, ok := b.getAttributeType(ctx, srcType, diags).(types.ObjectType)
+		if !ok {
+			return nil
+		}
+		return types.ObjectNull(objType.AttrTypes)
+	}
+
+	attributes := b.Extract(ctx, srcVal.Interface(), diags)
+	if diags.HasError() {
+		return nil
+	}
+
+	attrTypes := make(map[string]attr.Type, len(attributes))
+	for name, v := range attributes {
+		attrTypes[name] = v.Type(ctx)
+	}
+
+	res, d := types.ObjectValue(attrTypes, attributes)
+	if d.HasError() {
+		diags.Append(d...)
+		return nil
+	}
+	return res
+}
+
+// getMapFromReflectValue returns types.MapValue of the proto map, keys are formatted as strings.
+// Elements must have the same type, so messages with repeated fields in map values are not supported.
+func (b *ProtobufMapDataAdapter) getMapFromReflectValue(ctx context.Context, srcType reflect.Type, srcVal reflect.Value, diags *diag.Diagnostics) attr.Value {
+	elemType := b.getAttributeType(ctx, srcType.Elem(), diags)
+	if diags.HasError() {
+		return nil
+	}
+
+	if srcVal.IsNil() {
+		return types.MapNull(elemType)
+	}
+
+	elements := make(map[string]attr.Value, srcVal.Len())
+	iter := srcVal.MapRange()
+	for iter.Next() {
+		v := b.getAttributeFromReflectValue(ctx, srcType.Elem(), iter.Value(), diags)
+		if diags.HasError() {
+			return nil
+		}
+		elements[fmt.Sprint(iter.Key().Interface())] = v
+	}
+
+	res, d := types.MapValue(elemType, elements)
+	if d.HasError() {
+		diags.Append(d...)
+		return nil
+	}
+	return res
+}
+
+// getAttributeType returns type of the attribute extracted from a value of the type
+func (b *ProtobufMapDataAdapter) getAttributeType(ctx context.Context, t reflect.Type, diags *diag.Diagnostics) attr.Type {
+	zero := reflect.Zero(t)
+	if isMessage(t) {
+		zero = reflect.New(t.Elem())
+	}
+
+	v := b.getAttributeFromReflectValue(ctx, t, zero, diags)
+	if v == nil {
+		return nil
+	}
+	return v.Type(ctx)
+}
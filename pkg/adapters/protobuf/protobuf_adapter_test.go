@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -471,3 +472,323 @@ func TestYandexProvider_AdapterProtobufExtract(t *testing.T) {
 		}
 	}
 }
+
+func TestYandexProvider_AdapterProtobufExtractObject(t *testing.T) {
+	t.Parallel()
+	f := NewProtobufMapDataAdapter()
+	ctx := context.Background()
+
+	attrTypes := map[string]attr.Type{
+		"string_field":         types.StringType,
+		"int32_field":          types.Int64Type,
+		"repeated_int64_field": types.ListType{ElemType: types.Int64Type},
+		"int64_wrapper_field":  types.Int64Type,
+		"bool_wrapper_field":   types.BoolType,
+	}
+
+	cases := []struct {
+		testname      string
+		reqVal        *TestMessage
+		expectedVal   types.Object
+		expectedError bool
+	}{
+		{
+			testname: "CheckExtractObject",
+			reqVal: &TestMessage{
+				StringField:        "string_value",
+				Int32Field:         15,
+				RepeatedInt64Field: []int64{3, 4},
+				Int64WrapperField:  wrapperspb.Int64(3),
+			},
+			expectedVal: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"string_field":         types.StringValue("string_value"),
+				"int32_field":          types.Int64Value(15),
+				"repeated_int64_field": types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(3), types.Int64Value(4)}),
+				"int64_wrapper_field":  types.Int64Value(3),
+				"bool_wrapper_field":   types.BoolNull(),
+			}),
+		},
+		{
+			testname:    "CheckExtractNilObject",
+			reqVal:      nil,
+			expectedVal: types.ObjectNull(attrTypes),
+		},
+	}
+
+	for _, c := range cases {
+		diags := diag.Diagnostics{}
+		obj := f.ExtractObject(ctx, c.reqVal, attrTypes, &diags)
+		if c.expectedError != diags.HasError() {
+			t.Errorf("Unexpected extract error status in %s: expected %v, actual %v: %v", c.testname, c.expectedError, diags.HasError(), diags.Errors())
+			continue
+		}
+
+		if !obj.Equal(c.expectedVal) {
+			t.Errorf("Unexpected result in %s: expected %s, actual %s", c.testname, c.expectedVal.String(), obj.String())
+		}
+	}
+}
+
+func TestYandexProvider_AdapterProtobufFillComplex(t *testing.T) {
+	t.Parallel()
+	f := NewProtobufMapDataAdapter()
+	ctx := context.Background()
+
+	nestedTypes := map[string]attr.Type{
+		"string_nested_field": types.StringType,
+		"int32_nested_field":  types.Int64Type,
+	}
+
+	cases := []struct {
+		testname      string
+		reqVal        map[string]attr.Value
+		expectedVal   *ComplexMessage
+		expectedError bool
+	}{
+		// Nested message without an object attribute is filled from the same attributes, so it's always set
+		{
+			testname: "CheckOneofPrimitive",
+			reqVal: map[string]attr.Value{
+				"string_value": types.StringValue("string_value"),
+				"int64_value":  types.Int64Null(),
+			},
+			expectedVal: &ComplexMessage{
+				Value:  &ComplexMessage_StringValue{StringValue: "string_value"},
+				Nested: &TestMessage_NestedMessage{},
+			},
+		},
+		{
+			testname: "CheckOneofMessageFlatten",
+			reqVal: map[string]attr.Value{
+				"min": types.Int64Value(1),
+				"max": types.Int64Value(10),
+			},
+			expectedVal: &ComplexMessage{
+				Value:  &ComplexMessage_RangeValue{RangeValue: &ComplexMessage_Range{Min: 1, Max: 10}},
+				Nested: &TestMessage_NestedMessage{},
+			},
+		},
+		{
+			testname: "CheckOneofMessageObject",
+			reqVal: map[string]attr.Value{
+				"range_value": types.ObjectValueMust(
+					map[string]attr.Type{"min": types.Int64Type, "max": types.Int64Type},
+					map[string]attr.Value{"min": types.Int64Value(2), "max": types.Int64Null()},
+				),
+			},
+			expectedVal: &ComplexMessage{
+				Value:  &ComplexMessage_RangeValue{RangeValue: &ComplexMessage_Range{Min: 2}},
+				Nested: &TestMessage_NestedMessage{},
+			},
+		},
+		{
+			testname: "CheckOneofSeveralMembers",
+			reqVal: map[string]attr.Value{
+				"string_value": types.StringValue("string_value"),
+				"int64_value":  types.Int64Value(1),
+			},
+			expectedError: true,
+		},
+		{
+			testname: "CheckMaps",
+			reqVal: map[string]attr.Value{
+				"string_map": types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key_1": types.StringValue("value_1"),
+					"key_2": types.StringValue("value_2"),
+				}),
+				"int64_map": types.MapValueMust(types.NumberType, map[string]attr.Value{
+					"key": types.NumberValue(big.NewFloat(5)),
+				}),
+				"message_map": types.MapValueMust(types.ObjectType{AttrTypes: nestedTypes}, map[string]attr.Value{
+					"key": types.ObjectValueMust(nestedTypes, map[string]attr.Value{
+						"string_nested_field": types.StringValue("string_value"),
+						"int32_nested_field":  types.Int64Value(3),
+					}),
+				}),
+			},
+			expectedVal: &ComplexMessage{
+				StringMap: map[string]string{"key_1": "value_1", "key_2": "value_2"},
+				Int64Map:  map[string]int64{"key": 5},
+				MessageMap: map[string]*TestMessage_NestedMessage{
+					"key": {StringNestedField: "string_value", Int32NestedField: 3},
+				},
+				Nested: &TestMessage_NestedMessage{},
+			},
+		},
+		{
+			testname: "CheckNestedObject",
+			reqVal: map[string]attr.Value{
+				"nested": types.ObjectValueMust(nestedTypes, map[string]attr.Value{
+					"string_nested_field": types.StringValue("string_value"),
+					"int32_nested_field":  types.Int64Null(),
+				}),
+			},
+			expectedVal: &ComplexMessage{
+				Nested: &TestMessage_NestedMessage{StringNestedField: "string_value"},
+			},
+		},
+		{
+			testname: "CheckNestedFlatten",
+			reqVal: map[string]attr.Value{
+				"string_nested_field": types.StringValue("string_value"),
+			},
+			expectedVal: &ComplexMessage{
+				Nested: &TestMessage_NestedMessage{StringNestedField: "string_value"},
+			},
+		},
+		{
+			testname: "CheckNestedObjectUnknownAttribute",
+			reqVal: map[string]attr.Value{
+				"nested": types.ObjectValueMust(map[string]attr.Type{"unknown": types.StringType}, map[string]attr.Value{
+					"unknown": types.StringValue("string_value"),
+				}),
+			},
+			expectedError: true,
+		},
+		{
+			testname: "CheckMapWrongElementType",
+			reqVal: map[string]attr.Value{
+				"int64_map": types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("string_value"),
+				}),
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		var diags diag.Diagnostics
+		obj := &ComplexMessage{}
+
+		f.Fill(ctx, obj, c.reqVal, &diags)
+		if c.expectedError != diags.HasError() {
+			t.Errorf("Unexpected fill error status in %s: expected %v, actual %v: %v", c.testname, c.expectedError, diags.HasError(), diags.Errors())
+			continue
+		}
+		if !c.expectedError && !proto.Equal(obj, c.expectedVal) {
+			t.Errorf("Unexpected result in %s: expected %v, actual %v", c.testname, c.expectedVal, obj)
+		}
+	}
+}
+
+func TestYandexProvider_AdapterProtobufExtractComplex(t *testing.T) {
+	t.Parallel()
+	f := NewProtobufMapDataAdapter()
+	ctx := context.Background()
+
+	nestedTypes := map[string]attr.Type{
+		"string_nested_field": types.StringType,
+		"int32_nested_field":  types.NumberType,
+	}
+
+	cases := []struct {
+		testname    string
+		reqVal      *ComplexMessage
+		expectedVal map[string]attr.Value
+	}{
+		{
+			testname: "CheckOneofAndMaps",
+			reqVal: &ComplexMessage{
+				Value:     &ComplexMessage_Int64Value{Int64Value: 3},
+				StringMap: map[string]string{"key": "value"},
+				MessageMap: map[string]*TestMessage_NestedMessage{
+					"key": {StringNestedField: "string_value", Int32NestedField: 3},
+				},
+			},
+			expectedVal: map[string]attr.Value{
+				"int64_value": types.NumberValue(big.NewFloat(3)),
+				"string_map": types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("value"),
+				}),
+				"int64_map": types.MapNull(types.NumberType),
+				"message_map": types.MapValueMust(types.ObjectType{AttrTypes: nestedTypes}, map[string]attr.Value{
+					"key": types.ObjectValueMust(nestedTypes, map[string]attr.Value{
+						"string_nested_field": types.StringValue("string_value"),
+						"int32_nested_field":  types.NumberValue(big.NewFloat(3)),
+					}),
+				}),
+			},
+		},
+		{
+			testname: "CheckOneofMessage",
+			reqVal: &ComplexMessage{
+				Value: &ComplexMessage_RangeValue{RangeValue: &ComplexMessage_Range{Min: 1, Max: 2}},
+			},
+			expectedVal: map[string]attr.Value{
+				"min":         types.NumberValue(big.NewFloat(1)),
+				"max":         types.NumberValue(big.NewFloat(2)),
+				"string_map":  types.MapNull(types.StringType),
+				"int64_map":   types.MapNull(types.NumberType),
+				"message_map": types.MapNull(types.ObjectType{AttrTypes: nestedTypes}),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		var diags diag.Diagnostics
+
+		m := f.Extract(ctx, c.reqVal, &diags)
+		if diags.HasError() {
+			t.Errorf("Unexpected extract error in %s: %v", c.testname, diags.Errors())
+			continue
+		}
+
+		if len(m) != len(c.expectedVal) {
+			t.Errorf("Unexpected len result in %s: expected %d attributes, actual %d", c.testname, len(c.expectedVal), len(m))
+		}
+
+		for k, v := range c.expectedVal {
+			if val, ok := m[k]; !ok || !val.Equal(v) {
+				t.Errorf("Unexpected result in %s for field %s: expected %s, actual %v", c.testname, k, v.String(), val)
+			}
+		}
+	}
+}
+
+func TestYandexProvider_AdapterProtobufExtractObjectNested(t *testing.T) {
+	t.Parallel()
+	f := NewProtobufMapDataAdapter()
+	ctx := context.Background()
+
+	nestedTypes := map[string]attr.Type{
+		"string_nested_field": types.StringType,
+		"int32_nested_field":  types.Int64Type,
+	}
+	attrTypes := map[string]attr.Type{
+		"string_value": types.StringType,
+		"int64_map":    types.MapType{ElemType: types.Int64Type},
+		"nested":       types.ObjectType{AttrTypes: nestedTypes},
+		"message_map":  types.MapType{ElemType: types.ObjectType{AttrTypes: nestedTypes}},
+	}
+
+	diags := diag.Diagnostics{}
+	obj := f.ExtractObject(ctx, &ComplexMessage{
+		Value:    &ComplexMessage_StringValue{StringValue: "string_value"},
+		Int64Map: map[string]int64{"key": 5},
+		Nested:   &TestMessage_NestedMessage{StringNestedField: "nested_value", Int32NestedField: 2},
+		MessageMap: map[string]*TestMessage_NestedMessage{
+			"key": {StringNestedField: "map_value"},
+		},
+	}, attrTypes, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected extract error: %v", diags.Errors())
+	}
+
+	expected := types.ObjectValueMust(attrTypes, map[string]attr.Value{
+		"string_value": types.StringValue("string_value"),
+		"int64_map":    types.MapValueMust(types.Int64Type, map[string]attr.Value{"key": types.Int64Value(5)}),
+		"nested": types.ObjectValueMust(nestedTypes, map[string]attr.Value{
+			"string_nested_field": types.StringValue("nested_value"),
+			"int32_nested_field":  types.Int64Value(2),
+		}),
+		"message_map": types.MapValueMust(types.ObjectType{AttrTypes: nestedTypes}, map[string]attr.Value{
+			"key": types.ObjectValueMust(nestedTypes, map[string]attr.Value{
+				"string_nested_field": types.StringValue("map_value"),
+				"int32_nested_field":  types.Int64Value(0),
+			}),
+		}),
+	})
+	if !obj.Equal(expected) {
+		t.Errorf("Unexpected result: expected %s, actual %s", expected.String(), obj.String())
+	}
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.4
+// 	protoc-gen-go v1.36.6
 // 	protoc        v5.29.3
 // source: protobuf_filler/test.proto
 
@@ -219,6 +219,136 @@ func (x *TestMessage) GetNestedMessageField() *TestMessage_NestedMessage {
 	return nil
 }
 
+type ComplexMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*ComplexMessage_StringValue
+	//	*ComplexMessage_Int64Value
+	//	*ComplexMessage_RangeValue
+	Value         isComplexMessage_Value                `protobuf_oneof:"value"`
+	StringMap     map[string]string                     `protobuf:"bytes,4,rep,name=string_map,json=stringMap,proto3" json:"string_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Int64Map      map[string]int64                      `protobuf:"bytes,5,rep,name=int64_map,json=int64Map,proto3" json:"int64_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	MessageMap    map[string]*TestMessage_NestedMessage `protobuf:"bytes,6,rep,name=message_map,json=messageMap,proto3" json:"message_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Nested        *TestMessage_NestedMessage            `protobuf:"bytes,7,opt,name=nested,proto3" json:"nested,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComplexMessage) Reset() {
+	*x = ComplexMessage{}
+	mi := &file_protobuf_filler_test_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComplexMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComplexMessage) ProtoMessage() {}
+
+func (x *ComplexMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_filler_test_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComplexMessage.ProtoReflect.Descriptor instead.
+func (*ComplexMessage) Descriptor() ([]byte, []int) {
+	return file_protobuf_filler_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ComplexMessage) GetValue() isComplexMessage_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *ComplexMessage) GetStringValue() string {
+	if x != nil {
+		if x, ok := x.Value.(*ComplexMessage_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return ""
+}
+
+func (x *ComplexMessage) GetInt64Value() int64 {
+	if x != nil {
+		if x, ok := x.Value.(*ComplexMessage_Int64Value); ok {
+			return x.Int64Value
+		}
+	}
+	return 0
+}
+
+func (x *ComplexMessage) GetRangeValue() *ComplexMessage_Range {
+	if x != nil {
+		if x, ok := x.Value.(*ComplexMessage_RangeValue); ok {
+			return x.RangeValue
+		}
+	}
+	return nil
+}
+
+func (x *ComplexMessage) GetStringMap() map[string]string {
+	if x != nil {
+		return x.StringMap
+	}
+	return nil
+}
+
+func (x *ComplexMessage) GetInt64Map() map[string]int64 {
+	if x != nil {
+		return x.Int64Map
+	}
+	return nil
+}
+
+func (x *ComplexMessage) GetMessageMap() map[string]*TestMessage_NestedMessage {
+	if x != nil {
+		return x.MessageMap
+	}
+	return nil
+}
+
+func (x *ComplexMessage) GetNested() *TestMessage_NestedMessage {
+	if x != nil {
+		return x.Nested
+	}
+	return nil
+}
+
+type isComplexMessage_Value interface {
+	isComplexMessage_Value()
+}
+
+type ComplexMessage_StringValue struct {
+	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type ComplexMessage_Int64Value struct {
+	Int64Value int64 `protobuf:"varint,2,opt,name=int64_value,json=int64Value,proto3,oneof"`
+}
+
+type ComplexMessage_RangeValue struct {
+	RangeValue *ComplexMessage_Range `protobuf:"bytes,3,opt,name=range_value,json=rangeValue,proto3,oneof"`
+}
+
+func (*ComplexMessage_StringValue) isComplexMessage_Value() {}
+
+func (*ComplexMessage_Int64Value) isComplexMessage_Value() {}
+
+func (*ComplexMessage_RangeValue) isComplexMessage_Value() {}
+
 type TestMessage_NestedMessage struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	StringNestedField string                 `protobuf:"bytes,1,opt,name=string_nested_field,json=stringNestedField,proto3" json:"string_nested_field,omitempty"`
@@ -229,7 +359,7 @@ type TestMessage_NestedMessage struct {
 
 func (x *TestMessage_NestedMessage) Reset() {
 	*x = TestMessage_NestedMessage{}
-	mi := &file_protobuf_filler_test_proto_msgTypes[1]
+	mi := &file_protobuf_filler_test_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -241,7 +371,7 @@ func (x *TestMessage_NestedMessage) String() string {
 func (*TestMessage_NestedMessage) ProtoMessage() {}
 
 func (x *TestMessage_NestedMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_filler_test_proto_msgTypes[1]
+	mi := &file_protobuf_filler_test_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -271,78 +401,115 @@ func (x *TestMessage_NestedMessage) GetInt32NestedField() int32 {
 	return 0
 }
 
+type ComplexMessage_Range struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           int64                  `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max           int64                  `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComplexMessage_Range) Reset() {
+	*x = ComplexMessage_Range{}
+	mi := &file_protobuf_filler_test_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComplexMessage_Range) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComplexMessage_Range) ProtoMessage() {}
+
+func (x *ComplexMessage_Range) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_filler_test_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComplexMessage_Range.ProtoReflect.Descriptor instead.
+func (*ComplexMessage_Range) Descriptor() ([]byte, []int) {
+	return file_protobuf_filler_test_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *ComplexMessage_Range) GetMin() int64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *ComplexMessage_Range) GetMax() int64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
 var File_protobuf_filler_test_proto protoreflect.FileDescriptor
 
-var file_protobuf_filler_test_proto_rawDesc = string([]byte{
-	0x0a, 0x1a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x65,
-	0x72, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x1a, 0x1e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77,
-	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x94, 0x07,
-	0x0a, 0x0b, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x13, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x05, 0x52, 0x12, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x74,
-	0x33, 0x32, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x70, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
-	0x07, 0x20, 0x03, 0x28, 0x03, 0x52, 0x12, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x49,
-	0x6e, 0x74, 0x36, 0x34, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x70,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x08, 0x52, 0x11, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x4e, 0x0a, 0x14, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x57, 0x72, 0x61,
-	0x70, 0x70, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x4b, 0x0a, 0x13, 0x69, 0x6e, 0x74,
-	0x33, 0x32, 0x5f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x11, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65,
-	0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x4b, 0x0a, 0x13, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
-	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x11, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x12, 0x48, 0x0a, 0x12, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x77, 0x72, 0x61, 0x70,
-	0x70, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x62, 0x6f, 0x6f,
-	0x6c, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x38, 0x0a,
-	0x0a, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x5f, 0x66, 0x69, 0x6c,
-	0x6c, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x6e,
-	0x75, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x5c, 0x0a, 0x14, 0x6e, 0x65, 0x73, 0x74, 0x65,
-	0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x52, 0x12, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x1a, 0x6d, 0x0a, 0x0d, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x65, 0x73, 0x74, 0x65,
-	0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
-	0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x10, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x2a, 0x3e, 0x0a, 0x08, 0x45, 0x6e, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x49, 0x52, 0x53, 0x54, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45,
-	0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x5f, 0x56, 0x41, 0x4c,
-	0x55, 0x45, 0x10, 0x02, 0x42, 0x12, 0x5a, 0x10, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-})
+const file_protobuf_filler_test_proto_rawDesc = "" +
+	"\n" +
+	"\x1aprotobuf_filler/test.proto\x12\x0fprotobuf_filler\x1a\x1egoogle/protobuf/wrappers.proto\"\x94\a\n" +
+	"\vTestMessage\x12!\n" +
+	"\fstring_field\x18\x01 \x01(\tR\vstringField\x12\x1f\n" +
+	"\vint32_field\x18\x02 \x01(\x05R\n" +
+	"int32Field\x12\x1f\n" +
+	"\vint64_field\x18\x03 \x01(\x03R\n" +
+	"int64Field\x12\x1d\n" +
+	"\n" +
+	"bool_field\x18\x04 \x01(\bR\tboolField\x122\n" +
+	"\x15repeated_string_field\x18\x05 \x03(\tR\x13repeatedStringField\x120\n" +
+	"\x14repeated_int32_field\x18\x06 \x03(\x05R\x12repeatedInt32Field\x120\n" +
+	"\x14repeated_int64_field\x18\a \x03(\x03R\x12repeatedInt64Field\x12.\n" +
+	"\x13repeated_bool_field\x18\b \x03(\bR\x11repeatedBoolField\x12N\n" +
+	"\x14string_wrapper_field\x18\t \x01(\v2\x1c.google.protobuf.StringValueR\x12stringWrapperField\x12K\n" +
+	"\x13int32_wrapper_field\x18\n" +
+	" \x01(\v2\x1b.google.protobuf.Int32ValueR\x11int32WrapperField\x12K\n" +
+	"\x13int64_wrapper_field\x18\v \x01(\v2\x1b.google.protobuf.Int64ValueR\x11int64WrapperField\x12H\n" +
+	"\x12bool_wrapper_field\x18\f \x01(\v2\x1a.google.protobuf.BoolValueR\x10boolWrapperField\x128\n" +
+	"\n" +
+	"enum_field\x18\r \x01(\x0e2\x19.protobuf_filler.EnumTypeR\tenumField\x12\\\n" +
+	"\x14nested_message_field\x18\x0e \x01(\v2*.protobuf_filler.TestMessage.NestedMessageR\x12nestedMessageField\x1am\n" +
+	"\rNestedMessage\x12.\n" +
+	"\x13string_nested_field\x18\x01 \x01(\tR\x11stringNestedField\x12,\n" +
+	"\x12int32_nested_field\x18\x02 \x01(\x05R\x10int32NestedField\"\xef\x05\n" +
+	"\x0eComplexMessage\x12#\n" +
+	"\fstring_value\x18\x01 \x01(\tH\x00R\vstringValue\x12!\n" +
+	"\vint64_value\x18\x02 \x01(\x03H\x00R\n" +
+	"int64Value\x12H\n" +
+	"\vrange_value\x18\x03 \x01(\v2%.protobuf_filler.ComplexMessage.RangeH\x00R\n" +
+	"rangeValue\x12M\n" +
+	"\n" +
+	"string_map\x18\x04 \x03(\v2..protobuf_filler.ComplexMessage.StringMapEntryR\tstringMap\x12J\n" +
+	"\tint64_map\x18\x05 \x03(\v2-.protobuf_filler.ComplexMessage.Int64MapEntryR\bint64Map\x12P\n" +
+	"\vmessage_map\x18\x06 \x03(\v2/.protobuf_filler.ComplexMessage.MessageMapEntryR\n" +
+	"messageMap\x12B\n" +
+	"\x06nested\x18\a \x01(\v2*.protobuf_filler.TestMessage.NestedMessageR\x06nested\x1a+\n" +
+	"\x05Range\x12\x10\n" +
+	"\x03min\x18\x01 \x01(\x03R\x03min\x12\x10\n" +
+	"\x03max\x18\x02 \x01(\x03R\x03max\x1a<\n" +
+	"\x0eStringMapEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a;\n" +
+	"\rInt64MapEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1ai\n" +
+	"\x0fMessageMapEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12@\n" +
+	"\x05value\x18\x02 \x01(\v2*.protobuf_filler.TestMessage.NestedMessageR\x05value:\x028\x01B\a\n" +
+	"\x05value*>\n" +
+	"\bEnumType\x12\x0f\n" +
+	"\vUNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vFIRST_VALUE\x10\x01\x12\x10\n" +
+	"\fSECOND_VALUE\x10\x02B\x12Z\x10/protobuf_fillerb\x06proto3"
 
 var (
 	file_protobuf_filler_test_proto_rawDescOnce sync.Once
@@ -357,28 +524,39 @@ func file_protobuf_filler_test_proto_rawDescGZIP() []byte {
 }
 
 var file_protobuf_filler_test_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_protobuf_filler_test_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_protobuf_filler_test_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_protobuf_filler_test_proto_goTypes = []any{
 	(EnumType)(0),                     // 0: protobuf_filler.EnumType
 	(*TestMessage)(nil),               // 1: protobuf_filler.TestMessage
-	(*TestMessage_NestedMessage)(nil), // 2: protobuf_filler.TestMessage.NestedMessage
-	(*wrapperspb.StringValue)(nil),    // 3: google.protobuf.StringValue
-	(*wrapperspb.Int32Value)(nil),     // 4: google.protobuf.Int32Value
-	(*wrapperspb.Int64Value)(nil),     // 5: google.protobuf.Int64Value
-	(*wrapperspb.BoolValue)(nil),      // 6: google.protobuf.BoolValue
+	(*ComplexMessage)(nil),            // 2: protobuf_filler.ComplexMessage
+	(*TestMessage_NestedMessage)(nil), // 3: protobuf_filler.TestMessage.NestedMessage
+	(*ComplexMessage_Range)(nil),      // 4: protobuf_filler.ComplexMessage.Range
+	nil,                               // 5: protobuf_filler.ComplexMessage.StringMapEntry
+	nil,                               // 6: protobuf_filler.ComplexMessage.Int64MapEntry
+	nil,                               // 7: protobuf_filler.ComplexMessage.MessageMapEntry
+	(*wrapperspb.StringValue)(nil),    // 8: google.protobuf.StringValue
+	(*wrapperspb.Int32Value)(nil),     // 9: google.protobuf.Int32Value
+	(*wrapperspb.Int64Value)(nil),     // 10: google.protobuf.Int64Value
+	(*wrapperspb.BoolValue)(nil),      // 11: google.protobuf.BoolValue
 }
 var file_protobuf_filler_test_proto_depIdxs = []int32{
-	3, // 0: protobuf_filler.TestMessage.string_wrapper_field:type_name -> google.protobuf.StringValue
-	4, // 1: protobuf_filler.TestMessage.int32_wrapper_field:type_name -> google.protobuf.Int32Value
-	5, // 2: protobuf_filler.TestMessage.int64_wrapper_field:type_name -> google.protobuf.Int64Value
-	6, // 3: protobuf_filler.TestMessage.bool_wrapper_field:type_name -> google.protobuf.BoolValue
-	0, // 4: protobuf_filler.TestMessage.enum_field:type_name -> protobuf_filler.EnumType
-	2, // 5: protobuf_filler.TestMessage.nested_message_field:type_name -> protobuf_filler.TestMessage.NestedMessage
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	8,  // 0: protobuf_filler.TestMessage.string_wrapper_field:type_name -> google.protobuf.StringValue
+	9,  // 1: protobuf_filler.TestMessage.int32_wrapper_field:type_name -> google.protobuf.Int32Value
+	10, // 2: protobuf_filler.TestMessage.int64_wrapper_field:type_name -> google.protobuf.Int64Value
+	11, // 3: protobuf_filler.TestMessage.bool_wrapper_field:type_name -> google.protobuf.BoolValue
+	0,  // 4: protobuf_filler.TestMessage.enum_field:type_name -> protobuf_filler.EnumType
+	3,  // 5: protobuf_filler.TestMessage.nested_message_field:type_name -> protobuf_filler.TestMessage.NestedMessage
+	4,  // 6: protobuf_filler.ComplexMessage.range_value:type_name -> protobuf_filler.ComplexMessage.Range
+	5,  // 7: protobuf_filler.ComplexMessage.string_map:type_name -> protobuf_filler.ComplexMessage.StringMapEntry
+	6,  // 8: protobuf_filler.ComplexMessage.int64_map:type_name -> protobuf_filler.ComplexMessage.Int64MapEntry
+	7,  // 9: protobuf_filler.ComplexMessage.message_map:type_name -> protobuf_filler.ComplexMessage.MessageMapEntry
+	3,  // 10: protobuf_filler.ComplexMessage.nested:type_name -> protobuf_filler.TestMessage.NestedMessage
+	3,  // 11: protobuf_filler.ComplexMessage.MessageMapEntry.value:type_name -> protobuf_filler.TestMessage.NestedMessage
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_protobuf_filler_test_proto_init() }
@@ -386,13 +564,18 @@ func file_protobuf_filler_test_proto_init() {
 	if File_protobuf_filler_test_proto != nil {
 		return
 	}
+	file_protobuf_filler_test_proto_msgTypes[1].OneofWrappers = []any{
+		(*ComplexMessage_StringValue)(nil),
+		(*ComplexMessage_Int64Value)(nil),
+		(*ComplexMessage_RangeValue)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protobuf_filler_test_proto_rawDesc), len(file_protobuf_filler_test_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,206 @@
+// Package cassette records API traffic of acceptance tests to files and replays it without cloud access.
+//
+// Traffic is recorded when TF_ACC_RECORD=1 and replayed when TF_ACC_REPLAY=1, the cassette file is set
+// with TF_ACC_CASSETTE. The provider appends DialOptions to the options of its gRPC connections
+// and Transport into the S3 client, tests usually set the file with testhelpers.UseCassette.
+//
+// Payloads are stored as JSON with sensitive values hidden by logging.HideSensitive, authorization headers
+// and IAM tokens are never stored. Bodies of HTTP requests carry object contents and are stored as SHA-256 digests,
+// sensitive response headers are dropped the same way as in the API log. On replay every call is matched
+// by its method and payload, so a changed request, e.g. after a regression in expand functions, fails the test
+// instead of reaching the cloud.
+package cassette
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	// EnvRecord enables recording of the traffic to the cassette.
+	EnvRecord = "TF_ACC_RECORD"
+	// EnvReplay enables replaying of the traffic from the cassette.
+	EnvReplay = "TF_ACC_REPLAY"
+	// EnvPath is the path to the cassette file.
+	EnvPath = "TF_ACC_CASSETTE"
+)
+
+type Mode int
+
+const (
+	ModeOff Mode = iota
+	ModeRecord
+	ModeReplay
+)
+
+func (m Mode) String() string {
+	switch m {
+	case ModeRecord:
+		return "record"
+	case ModeReplay:
+		return "replay"
+	default:
+		return "off"
+	}
+}
+
+// ModeFromEnv returns the mode requested with TF_ACC_REPLAY or TF_ACC_RECORD, replaying takes precedence.
+func ModeFromEnv() Mode {
+	switch {
+	case os.Getenv(EnvReplay) == "1":
+		return ModeReplay
+	case os.Getenv(EnvRecord) == "1":
+		return ModeRecord
+	default:
+		return ModeOff
+	}
+}
+
+// Cassette is the recorded traffic of a single test.
+type Cassette struct {
+	mu   sync.Mutex
+	path string
+	mode Mode
+	data cassetteFile
+
+	usedCalls    []bool
+	usedRequests []bool
+}
+
+type cassetteFile struct {
+	// Values are generated values, e.g. random resource names, which must be the same on replay.
+	Values   map[string]string `json:"values,omitempty"`
+	Calls    []*Call           `json:"calls"`
+	Requests []*Request        `json:"http,omitempty"`
+}
+
+type registryKey struct {
+	path string
+	mode Mode
+}
+
+// registry holds the cassettes opened by the process. The provider is configured several times during a test,
+// all configurations share the same cassette so the calls are recorded and replayed in order.
+var (
+	registryMu sync.Mutex
+	registry   = map[registryKey]*Cassette{}
+)
+
+// FromEnv returns the cassette set with TF_ACC_CASSETTE, or nil if neither recording nor replaying is requested.
+func FromEnv() (*Cassette, error) {
+	mode := ModeFromEnv()
+	path := os.Getenv(EnvPath)
+	if mode == ModeOff || path == "" {
+		return nil, nil
+	}
+	return Open(path, mode)
+}
+
+// Open returns the cassette stored at path. A recorded cassette starts empty and overwrites the file,
+// a replayed one must exist.
+func Open(path string, mode Mode) (*Cassette, error) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	key := registryKey{path: path, mode: mode}
+	if c, ok := registry[key]; ok {
+		return c, nil
+	}
+
+	c := &Cassette{path: path, mode: mode}
+	switch mode {
+	case ModeRecord:
+		if err := c.save(); err != nil {
+			return nil, err
+		}
+	case ModeReplay:
+		if err := c.load(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("cassette %s can't be opened in mode %s", path, mode)
+	}
+
+	registry[key] = c
+	return c, nil
+}
+
+func (c *Cassette) Path() string {
+	return c.path
+}
+
+func (c *Cassette) Mode() Mode {
+	return c.mode
+}
+
+// Value returns the value generated by generate while recording and the recorded one while replaying.
+// It is safe to call on nil cassette.
+func (c *Cassette) Value(key string, generate func() string) string {
+	if c == nil {
+		return generate()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if v, ok := c.data.Values[key]; ok {
+		return v
+	}
+
+	v := generate()
+	if c.mode == ModeRecord {
+		if c.data.Values == nil {
+			c.data.Values = map[string]string{}
+		}
+		c.data.Values[key] = v
+		// Values are only needed on replay, a failed save is reported by the next recorded call.
+		_ = c.save()
+	}
+	return v
+}
+
+// Unused returns the number of recorded gRPC calls and HTTP requests which were not replayed.
+func (c *Cassette) Unused() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	n := 0
+	for _, used := range append(append([]bool{}, c.usedCalls...), c.usedRequests...) {
+		if !used {
+			n++
+		}
+	}
+	return n
+}
+
+func (c *Cassette) load() error {
+	b, err := os.ReadFile(c.path)
+	if err != nil {
+		return fmt.Errorf("failed to read cassette: %w", err)
+	}
+	if err := json.Unmarshal(b, &c.data); err != nil {
+		return fmt.Errorf("failed to parse cassette %s: %w", c.path, err)
+	}
+
+	c.usedCalls = make([]bool, len(c.data.Calls))
+	c.usedRequests = make([]bool, len(c.data.Requests))
+	return nil
+}
+
+// save writes the whole cassette, must be called with c.mu held or before the cassette is shared.
+func (c *Cassette) save() error {
+	b, err := json.MarshalIndent(&c.data, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %w", err)
+	}
+	if err := os.WriteFile(c.path, append(b, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package cassette_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/cassette"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers/fakecloud"
+)
+
+func buildSDK(t *testing.T, endpoint string, c *cassette.Cassette) *ycsdk.SDK {
+	t.Helper()
+
+	sdk, err := ycsdk.Build(context.Background(), ycsdk.Config{
+		Endpoint:    endpoint,
+		Plaintext:   true,
+		Credentials: ycsdk.OAuthToken("oauth-token"),
+	}, c.DialOptions()...)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sdk.Shutdown(context.Background()) })
+
+	return sdk
+}
+
+// createNetwork creates a network and reads it back, the same calls are made on recording and on replay.
+func createNetwork(t *testing.T, sdk *ycsdk.SDK, name string) *vpc.Network {
+	t.Helper()
+	ctx := context.Background()
+
+	op, err := sdk.WrapOperation(sdk.VPC().Network().Create(ctx, &vpc.CreateNetworkRequest{
+		FolderId: fakecloud.FolderID,
+		Name:     name,
+	}))
+	require.NoError(t, err)
+	require.NoError(t, op.Wait(ctx))
+	md, err := op.Metadata()
+	require.NoError(t, err)
+
+	network, err := sdk.VPC().Network().Get(ctx, &vpc.GetNetworkRequest{NetworkId: md.(*vpc.CreateNetworkMetadata).GetNetworkId()})
+	require.NoError(t, err)
+	return network
+}
+
+func TestCassette_GRPC(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "grpc.json")
+
+	rec, err := cassette.Open(path, cassette.ModeRecord)
+	require.NoError(t, err)
+	name := rec.Value("network-name", func() string { return "net-recorded" })
+
+	server, err := fakecloud.Start()
+	require.NoError(t, err)
+	sdk := buildSDK(t, server.Endpoint(), rec)
+	recorded := createNetwork(t, sdk, name)
+	_, err = sdk.VPC().Network().Get(context.Background(), &vpc.GetNetworkRequest{NetworkId: "enpmissing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	server.Stop()
+
+	replay, err := cassette.Open(path, cassette.ModeReplay)
+	require.NoError(t, err)
+	require.Equal(t, name, replay.Value("network-name", func() string { return "net-replayed" }))
+
+	// Nothing listens on the endpoint any more, every call is served from the cassette.
+	sdk = buildSDK(t, server.Endpoint(), replay)
+	replayed := createNetwork(t, sdk, name)
+	require.Equal(t, recorded.GetId(), replayed.GetId())
+	require.Equal(t, recorded.GetCreatedAt().AsTime(), replayed.GetCreatedAt().AsTime())
+
+	_, err = sdk.VPC().Network().Get(context.Background(), &vpc.GetNetworkRequest{NetworkId: "enpmissing"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = sdk.VPC().Network().Get(context.Background(), &vpc.GetNetworkRequest{NetworkId: replayed.GetId()})
+	require.Equal(t, codes.Unavailable, status.Code(err), "every recorded call is replayed once")
+	require.Zero(t, replay.Unused())
+}
+
+func TestCassette_HTTP(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "http.json")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/xml")
+		w.Header().Set("X-Auth-Token", "response-token")
+		if r.URL.Path == "/bucket/object" {
+			return
+		}
+		_, _ = w.Write([]byte("<Echo>" + string(body) + "</Echo>"))
+	}))
+
+	do := func(c *cassette.Cassette, path, body string) string {
+		client := &http.Client{Transport: c.Transport(nil)}
+		req, err := http.NewRequest(http.MethodPut, server.URL+path, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "secret")
+
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, "application/xml", resp.Header.Get("Content-Type"))
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return string(b)
+	}
+
+	rec, err := cassette.Open(path, cassette.ModeRecord)
+	require.NoError(t, err)
+	require.Equal(t, "<Echo>text</Echo>", do(rec, "/bucket/key?acl", "text"))
+	require.Equal(t, "<Echo>\xff</Echo>", do(rec, "/bucket/key?acl", "\xff"))
+	require.Equal(t, "", do(rec, "/bucket/object", "object-content"))
+	server.Close()
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(b), "object-content", "request bodies are stored as digests")
+	require.NotContains(t, string(b), "response-token", "sensitive response headers are not stored")
+	require.NotContains(t, string(b), "secret", "request headers are not stored")
+
+	replay, err := cassette.Open(path, cassette.ModeReplay)
+	require.NoError(t, err)
+	require.Equal(t, "", do(replay, "/bucket/object", "object-content"))
+	require.Equal(t, "<Echo>\xff</Echo>", do(replay, "/bucket/key?acl", "\xff"))
+	require.Equal(t, "<Echo>text</Echo>", do(replay, "/bucket/key?acl", "text"))
+
+	_, err = (&http.Client{Transport: replay.Transport(nil)}).Get(server.URL + "/bucket")
+	require.ErrorContains(t, err, "no recorded request")
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv(cassette.EnvRecord, "")
+	t.Setenv(cassette.EnvReplay, "")
+	t.Setenv(cassette.EnvPath, filepath.Join(t.TempDir(), "env.json"))
+
+	c, err := cassette.FromEnv()
+	require.NoError(t, err)
+	require.Nil(t, c)
+	require.Equal(t, "generated", c.Value("key", func() string { return "generated" }))
+
+	t.Setenv(cassette.EnvReplay, "1")
+	_, err = cassette.FromEnv()
+	require.Error(t, err, "replayed cassette must exist")
+
+	t.Setenv(cassette.EnvRecord, "1")
+	t.Setenv(cassette.EnvReplay, "")
+	c, err = cassette.FromEnv()
+	require.NoError(t, err)
+	require.Equal(t, cassette.ModeRecord, c.Mode())
+
+	again, err := cassette.FromEnv()
+	require.NoError(t, err)
+	require.Same(t, c, again, "provider configurations of a test share the cassette")
+}
@@ -0,0 +1,197 @@
+package cassette
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	protov1 "github.com/golang/protobuf/proto"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/logging"
+)
+
+// replayIAMToken is the IAM token returned on replay instead of the real one which is never recorded.
+const replayIAMToken = "t1.cassette.replay"
+
+// Call is a recorded unary gRPC call.
+type Call struct {
+	Method   string          `json:"method"`
+	Request  json.RawMessage `json:"request"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Error    *CallError      `json:"error,omitempty"`
+}
+
+type CallError struct {
+	Code    codes.Code `json:"code"`
+	Message string     `json:"message"`
+}
+
+// DialOptions returns options to be appended to the dial options of the SDK. Besides the interceptor, on replay
+// they point connections to an in-memory server, so the SDK can connect without reaching the endpoint.
+func (c *Cassette) DialOptions() []grpc.DialOption {
+	opts := []grpc.DialOption{grpc.WithChainUnaryInterceptor(c.UnaryClientInterceptor())}
+	if c.mode == ModeReplay {
+		opts = append(opts,
+			grpc.WithContextDialer(replayDialer),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+	}
+	return opts
+}
+
+var (
+	replayListenerOnce sync.Once
+	replayListener     *bufconn.Listener
+)
+
+// replayDialer connects to a local server without services, replayed calls never reach it.
+func replayDialer(ctx context.Context, _ string) (net.Conn, error) {
+	replayListenerOnce.Do(func() {
+		replayListener = bufconn.Listen(1 << 20)
+		go func() { _ = grpc.NewServer().Serve(replayListener) }()
+	})
+	return replayListener.DialContext(ctx)
+}
+
+// UnaryClientInterceptor records calls or replays them without invoking the server, depending on the cassette mode.
+// It should be the last interceptor in the chain, so the recorded calls are the ones sent to the server.
+// Use DialOptions to replay calls of the SDK.
+func (c *Cassette) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if c.mode == ModeReplay {
+			return c.replayCall(method, req, reply)
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if method == iam.IamTokenService_Create_FullMethodName {
+			return err
+		}
+		if recErr := c.recordCall(method, req, reply, err); recErr != nil {
+			return fmt.Errorf("cassette: failed to record %s: %w", method, recErr)
+		}
+		return err
+	}
+}
+
+func (c *Cassette) recordCall(method string, req, reply interface{}, callErr error) error {
+	call := &Call{Method: method}
+
+	var err error
+	if call.Request, err = marshalHidden(req); err != nil {
+		return err
+	}
+	if callErr != nil {
+		st := status.Convert(callErr)
+		call.Error = &CallError{Code: st.Code(), Message: st.Message()}
+	} else if call.Response, err = marshalHidden(reply); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.data.Calls = append(c.data.Calls, call)
+	return c.save()
+}
+
+func (c *Cassette) replayCall(method string, req, reply interface{}) error {
+	if method == iam.IamTokenService_Create_FullMethodName {
+		return replayIAMTokenResponse(reply)
+	}
+
+	got, err := hidden(req)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, call := range c.data.Calls {
+		if c.usedCalls[i] || call.Method != method {
+			continue
+		}
+
+		want := got.ProtoReflect().New().Interface()
+		if err := unmarshal(call.Request, want); err != nil {
+			return status.Errorf(codes.Internal, "cassette %s: failed to parse recorded request of %s: %s", c.path, method, err)
+		}
+		if !proto.Equal(want, got) {
+			continue
+		}
+
+		c.usedCalls[i] = true
+		if call.Error != nil {
+			return status.Error(call.Error.Code, call.Error.Message)
+		}
+		return unmarshalReply(call.Response, reply)
+	}
+
+	payload, _ := marshalHidden(req)
+	return status.Errorf(codes.Unavailable, "cassette %s: no recorded call %s with request %s", c.path, method, payload)
+}
+
+func replayIAMTokenResponse(reply interface{}) error {
+	resp, ok := reply.(*iam.CreateIamTokenResponse)
+	if !ok {
+		return status.Errorf(codes.Internal, "cassette: unexpected IAM token response type %T", reply)
+	}
+	resp.IamToken = replayIAMToken
+	resp.ExpiresAt = timestamppb.New(time.Now().Add(12 * time.Hour))
+	return nil
+}
+
+// hidden returns a copy of the message with sensitive values hidden.
+func hidden(m interface{}) (proto.Message, error) {
+	v1, ok := m.(protov1.Message)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "cassette: %T is not a protobuf message", m)
+	}
+	return protov1.MessageV2(logging.HideSensitiveValues(v1)), nil
+}
+
+func marshalHidden(m interface{}) (json.RawMessage, error) {
+	msg, err := hidden(m)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// protojson output is unstable on purpose, compact it to keep recordings diffable.
+	buf := &bytes.Buffer{}
+	if err := json.Compact(buf, b); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func unmarshal(b json.RawMessage, m proto.Message) error {
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(b, m)
+}
+
+func unmarshalReply(b json.RawMessage, reply interface{}) error {
+	v1, ok := reply.(protov1.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "cassette: %T is not a protobuf message", reply)
+	}
+	if err := unmarshal(b, protov1.MessageV2(v1)); err != nil {
+		return status.Errorf(codes.Internal, "cassette: failed to parse recorded response: %s", err)
+	}
+	return nil
+}
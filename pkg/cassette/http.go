@@ -0,0 +1,187 @@
+package cassette
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/logging"
+)
+
+const (
+	bodyEncodingBase64 = "base64"
+	bodyEncodingSHA256 = "sha256"
+)
+
+// Request is a recorded HTTP request with its response. Request headers are not recorded, they carry signatures and tokens.
+// Request bodies carry object contents, so only their SHA-256 digest is recorded to match requests on replay.
+type Request struct {
+	Method               string      `json:"method"`
+	URL                  string      `json:"url"`
+	Body                 string      `json:"body,omitempty"`
+	BodyEncoding         string      `json:"body_encoding,omitempty"`
+	StatusCode           int         `json:"status_code"`
+	Header               http.Header `json:"header,omitempty"`
+	ResponseBody         string      `json:"response_body,omitempty"`
+	ResponseBodyEncoding string      `json:"response_body_encoding,omitempty"`
+}
+
+// Transport wraps next to record requests or replays them without sending, depending on the cassette mode.
+// Nil next means http.DefaultTransport.
+func (c *Cassette) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &transport{c: c, next: next}
+}
+
+type transport struct {
+	c    *Cassette
+	next http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("cassette: failed to read request body: %w", err)
+	}
+
+	if t.c.mode == ModeReplay {
+		return t.c.replayRequest(req, body)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("cassette: failed to read response body: %w", err)
+	}
+
+	rec := &Request{
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		StatusCode: resp.StatusCode,
+		Header:     hideHeader(resp.Header),
+	}
+	rec.Body, rec.BodyEncoding = hideBody(body)
+	rec.ResponseBody, rec.ResponseBodyEncoding = encodeBody(respBody)
+
+	t.c.mu.Lock()
+	defer t.c.mu.Unlock()
+
+	t.c.data.Requests = append(t.c.data.Requests, rec)
+	if err := t.c.save(); err != nil {
+		return nil, fmt.Errorf("cassette: failed to record %s %s: %w", req.Method, req.URL, err)
+	}
+	return resp, nil
+}
+
+func (c *Cassette) replayRequest(req *http.Request, body []byte) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, rec := range c.data.Requests {
+		if c.usedRequests[i] || rec.Method != req.Method || rec.URL != req.URL.String() {
+			continue
+		}
+		if ok, err := matchBody(rec.Body, rec.BodyEncoding, body); err != nil {
+			return nil, fmt.Errorf("cassette %s: failed to decode recorded request body: %w", c.path, err)
+		} else if !ok {
+			continue
+		}
+		respBody, err := decodeBody(rec.ResponseBody, rec.ResponseBodyEncoding)
+		if err != nil {
+			return nil, fmt.Errorf("cassette %s: failed to decode recorded response body: %w", c.path, err)
+		}
+
+		c.usedRequests[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", rec.StatusCode, http.StatusText(rec.StatusCode)),
+			StatusCode:    rec.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        rec.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(respBody)),
+			ContentLength: int64(len(respBody)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("cassette %s: no recorded request %s %s", c.path, req.Method, req.URL)
+}
+
+// readBody reads the body and replaces it with an unread copy.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	b, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
+
+// encodeBody keeps text bodies readable in the cassette and stores binary ones as base64.
+func encodeBody(b []byte) (string, string) {
+	if utf8.Valid(b) {
+		return string(b), ""
+	}
+	return base64.StdEncoding.EncodeToString(b), bodyEncodingBase64
+}
+
+// hideBody returns the SHA-256 digest of a request body, an empty body is kept empty.
+func hideBody(b []byte) (string, string) {
+	if len(b) == 0 {
+		return "", ""
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), bodyEncodingSHA256
+}
+
+// matchBody reports whether the body is the recorded one, which is stored either as a digest or as is.
+func matchBody(s, encoding string, body []byte) (bool, error) {
+	if strings.ToLower(encoding) == bodyEncodingSHA256 {
+		digest, _ := hideBody(body)
+		return digest == s, nil
+	}
+	recBody, err := decodeBody(s, encoding)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(recBody, body), nil
+}
+
+// hideHeader returns a copy of the response header without the sensitive ones, the same as the API log does.
+func hideHeader(h http.Header) http.Header {
+	result := make(http.Header, len(h))
+	for k, v := range h {
+		if logging.HeaderIsNotSensitive(strings.ToLower(k)) {
+			result[k] = append([]string(nil), v...)
+		}
+	}
+	return result
+}
+
+func decodeBody(s, encoding string) ([]byte, error) {
+	switch strings.ToLower(encoding) {
+	case "":
+		return []byte(s), nil
+	case bodyEncodingBase64:
+		return base64.StdEncoding.DecodeString(s)
+	default:
+		return nil, fmt.Errorf("unknown body encoding %q", encoding)
+	}
+}
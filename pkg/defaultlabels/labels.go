@@ -0,0 +1,39 @@
+package defaultlabels
+
+// Merge returns labels of the resource with provider default labels added.
+// Labels of the resource take precedence over the default ones.
+func Merge(defaults, labels map[string]string) map[string]string {
+	merged := make(map[string]string, len(defaults)+len(labels))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range labels {
+		merged[k] = v
+	}
+	return merged
+}
+
+// Strip returns labels read from the API without the default labels, so that they can be compared with the configuration.
+// A default label is kept if it is present in known, e.g. because it is set explicitly in the configuration or prior state.
+func Strip(labels, defaults, known map[string]string) map[string]string {
+	stripped := make(map[string]string, len(labels))
+	for k, v := range labels {
+		if dv, ok := defaults[k]; ok && dv == v {
+			if _, ok := known[k]; !ok {
+				continue
+			}
+		}
+		stripped[k] = v
+	}
+	return stripped
+}
+
+// Suppressed reports whether a difference of a single label between the state and the configuration
+// is caused by a default label, i.e. the label is not configured and the state holds the default value.
+func Suppressed(key, stateValue string, defaults, config map[string]string) bool {
+	if _, ok := config[key]; ok {
+		return false
+	}
+	dv, ok := defaults[key]
+	return ok && dv == stateValue
+}
@@ -0,0 +1,86 @@
+package defaultlabels
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerge(t *testing.T) {
+	cases := []struct {
+		name     string
+		defaults map[string]string
+		labels   map[string]string
+		expected map[string]string
+	}{
+		{
+			name:     "no defaults",
+			labels:   map[string]string{"app": "web"},
+			expected: map[string]string{"app": "web"},
+		},
+		{
+			name:     "no labels",
+			defaults: map[string]string{"env": "prod"},
+			expected: map[string]string{"env": "prod"},
+		},
+		{
+			name:     "resource labels take precedence",
+			defaults: map[string]string{"env": "prod", "team": "core"},
+			labels:   map[string]string{"env": "test", "app": "web"},
+			expected: map[string]string{"env": "test", "team": "core", "app": "web"},
+		},
+		{
+			name:     "empty",
+			expected: map[string]string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Merge(tc.defaults, tc.labels))
+		})
+	}
+}
+
+func TestStrip(t *testing.T) {
+	defaults := map[string]string{"env": "prod", "team": "core"}
+
+	cases := []struct {
+		name     string
+		labels   map[string]string
+		known    map[string]string
+		expected map[string]string
+	}{
+		{
+			name:     "default labels are removed",
+			labels:   map[string]string{"env": "prod", "team": "core", "app": "web"},
+			expected: map[string]string{"app": "web"},
+		},
+		{
+			name:     "changed default label is kept",
+			labels:   map[string]string{"env": "test", "team": "core"},
+			expected: map[string]string{"env": "test"},
+		},
+		{
+			name:     "known default label is kept",
+			labels:   map[string]string{"env": "prod", "team": "core"},
+			known:    map[string]string{"env": "prod"},
+			expected: map[string]string{"env": "prod"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Strip(tc.labels, defaults, tc.known))
+		})
+	}
+}
+
+func TestSuppressed(t *testing.T) {
+	defaults := map[string]string{"env": "prod"}
+
+	assert.True(t, Suppressed("env", "prod", defaults, nil))
+	assert.False(t, Suppressed("env", "test", defaults, nil))
+	assert.False(t, Suppressed("env", "prod", defaults, map[string]string{"env": "test"}))
+	assert.False(t, Suppressed("app", "web", defaults, nil))
+}
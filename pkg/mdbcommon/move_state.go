@@ -0,0 +1,132 @@
+package mdbcommon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SDKv2 and framework resources are served by the same provider, so only its own resources can be moved.
+const movedStateProviderSuffix = "yandex-cloud/yandex"
+
+// DecodeMovedState decodes the raw state of the SDKv2 resource sourceTypeName into v.
+// Returns false if the state is moved from another resource type or provider, the StateMover must be skipped then.
+func DecodeMovedState(req resource.MoveStateRequest, sourceTypeName string, v any, diags *diag.Diagnostics) bool {
+	if req.SourceTypeName != sourceTypeName || !strings.HasSuffix(req.SourceProviderAddress, movedStateProviderSuffix) {
+		return false
+	}
+
+	if req.SourceSchemaVersion != 0 {
+		diags.AddError(
+			"Unable to move resource state",
+			fmt.Sprintf("Schema version %d of %s is not supported, upgrade the provider and refresh the state before moving it.", req.SourceSchemaVersion, sourceTypeName),
+		)
+		return true
+	}
+
+	if req.SourceRawState == nil || len(req.SourceRawState.JSON) == 0 {
+		diags.AddError("Unable to move resource state", fmt.Sprintf("State of %s is empty.", sourceTypeName))
+		return true
+	}
+
+	if err := json.Unmarshal(req.SourceRawState.JSON, v); err != nil {
+		diags.AddError("Unable to move resource state", fmt.Sprintf("Failed to decode state of %s: %s", sourceTypeName, err))
+	}
+	return true
+}
+
+// MovedHostLabel returns the key of a host moved from the `host` list of a SDKv2 resource into the `hosts` map:
+// the host name if it is set, otherwise the first label of the host FQDN.
+func MovedHostLabel(name, fqdn string) string {
+	if name != "" {
+		return name
+	}
+	label, _, _ := strings.Cut(fqdn, ".")
+	return label
+}
+
+// MovedResources is the `resources` block of SDKv2 MDB cluster resources.
+type MovedResources struct {
+	ResourcePresetId string `json:"resource_preset_id"`
+	DiskSize         int64  `json:"disk_size"`
+	DiskTypeId       string `json:"disk_type_id"`
+}
+
+// MovedBackupWindow is the `backup_window_start` block of SDKv2 MDB cluster resources.
+type MovedBackupWindow struct {
+	Hours   int64 `json:"hours"`
+	Minutes int64 `json:"minutes"`
+}
+
+// MovedMaintenanceWindow is the `maintenance_window` block of SDKv2 MDB cluster resources.
+type MovedMaintenanceWindow struct {
+	Type string `json:"type"`
+	Day  string `json:"day"`
+	Hour int64  `json:"hour"`
+}
+
+func MoveResources(ctx context.Context, blocks []MovedResources, diags *diag.Diagnostics) types.Object {
+	if len(blocks) == 0 {
+		return types.ObjectNull(ResourceType.AttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, ResourceType.AttrTypes, Resource{
+		ResourcePresetId: types.StringValue(blocks[0].ResourcePresetId),
+		DiskSize:         types.Int64Value(blocks[0].DiskSize),
+		DiskTypeId:       types.StringValue(blocks[0].DiskTypeId),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func MoveBackupWindowStart(ctx context.Context, blocks []MovedBackupWindow, diags *diag.Diagnostics) types.Object {
+	if len(blocks) == 0 {
+		return types.ObjectNull(BackupWindowType.AttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, BackupWindowType.AttrTypes, BackupWindow{
+		Hours:   types.Int64Value(blocks[0].Hours),
+		Minutes: types.Int64Value(blocks[0].Minutes),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func MoveMaintenanceWindow(ctx context.Context, blocks []MovedMaintenanceWindow, diags *diag.Diagnostics) types.Object {
+	if len(blocks) == 0 {
+		return types.ObjectNull(MaintenanceWindowType.AttrTypes)
+	}
+
+	mw := MaintenanceWindow{Type: types.StringValue(blocks[0].Type)}
+	if blocks[0].Type == weeklyType {
+		mw.Day = types.StringValue(blocks[0].Day)
+		mw.Hour = types.Int64Value(blocks[0].Hour)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, MaintenanceWindowType.AttrTypes, mw)
+	diags.Append(d...)
+	return obj
+}
+
+// MoveSettingsMap converts a settings map of a SDKv2 resource, e.g. `postgresql_config`.
+// Empty settings are moved as null, so they are read from the API on refresh.
+func MoveSettingsMap(ctx context.Context, settings map[string]string, t SettingsMapType, diags *diag.Diagnostics) SettingsMapValue {
+	if len(settings) == 0 {
+		return NewSettingsMapNull()
+	}
+
+	m, d := types.MapValueFrom(ctx, types.StringType, settings)
+	diags.Append(d...)
+	if d.HasError() {
+		return NewSettingsMapNull()
+	}
+
+	v, d := t.ValueFromMap(ctx, m)
+	diags.Append(d...)
+	return v.(SettingsMapValue)
+}
@@ -0,0 +1,119 @@
+package mdbcommon
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestYandexProvider_MDBDecodeMovedState(t *testing.T) {
+	t.Parallel()
+
+	const sourceTypeName = "yandex_mdb_postgresql_cluster"
+	const providerAddress = "registry.terraform.io/yandex-cloud/yandex"
+
+	cases := []struct {
+		testname    string
+		req         resource.MoveStateRequest
+		expectedOk  bool
+		expectedErr bool
+		expectedId  string
+	}{
+		{
+			testname: "CheckMovedState",
+			req: resource.MoveStateRequest{
+				SourceTypeName:        sourceTypeName,
+				SourceProviderAddress: providerAddress,
+				SourceRawState:        &tfprotov6.RawState{JSON: []byte(`{"id": "cid"}`)},
+			},
+			expectedOk: true,
+			expectedId: "cid",
+		},
+		{
+			testname: "CheckOtherResourceType",
+			req: resource.MoveStateRequest{
+				SourceTypeName:        "yandex_mdb_mysql_cluster",
+				SourceProviderAddress: providerAddress,
+				SourceRawState:        &tfprotov6.RawState{JSON: []byte(`{"id": "cid"}`)},
+			},
+		},
+		{
+			testname: "CheckOtherProvider",
+			req: resource.MoveStateRequest{
+				SourceTypeName:        sourceTypeName,
+				SourceProviderAddress: "registry.terraform.io/hashicorp/random",
+				SourceRawState:        &tfprotov6.RawState{JSON: []byte(`{"id": "cid"}`)},
+			},
+		},
+		{
+			testname: "CheckUnsupportedSchemaVersion",
+			req: resource.MoveStateRequest{
+				SourceTypeName:        sourceTypeName,
+				SourceProviderAddress: providerAddress,
+				SourceSchemaVersion:   1,
+				SourceRawState:        &tfprotov6.RawState{JSON: []byte(`{"id": "cid"}`)},
+			},
+			expectedOk:  true,
+			expectedErr: true,
+		},
+		{
+			testname: "CheckEmptyState",
+			req: resource.MoveStateRequest{
+				SourceTypeName:        sourceTypeName,
+				SourceProviderAddress: providerAddress,
+			},
+			expectedOk:  true,
+			expectedErr: true,
+		},
+		{
+			testname: "CheckMalformedState",
+			req: resource.MoveStateRequest{
+				SourceTypeName:        sourceTypeName,
+				SourceProviderAddress: providerAddress,
+				SourceRawState:        &tfprotov6.RawState{JSON: []byte(`{"id": 1}`)},
+			},
+			expectedOk:  true,
+			expectedErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		var diags diag.Diagnostics
+		var v struct {
+			Id string `json:"id"`
+		}
+
+		ok := DecodeMovedState(c.req, sourceTypeName, &v, &diags)
+		if ok != c.expectedOk {
+			t.Errorf("Unexpected decode result %s test: expected %t, actual %t", c.testname, c.expectedOk, ok)
+		}
+		if diags.HasError() != c.expectedErr {
+			t.Errorf("Unexpected decode diagnostics status %s test: expected %t, actual %t: %v", c.testname, c.expectedErr, diags.HasError(), diags.Errors())
+		}
+		if !c.expectedErr && v.Id != c.expectedId {
+			t.Errorf("Unexpected decode value %s test: expected %q, actual %q", c.testname, c.expectedId, v.Id)
+		}
+	}
+}
+
+func TestYandexProvider_MDBMovedHostLabel(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		fqdn     string
+		expected string
+	}{
+		{name: "na", fqdn: "rc1a-5o3ad8p6f2ee8br1.mdb.yandexcloud.net", expected: "na"},
+		{name: "", fqdn: "rc1a-5o3ad8p6f2ee8br1.mdb.yandexcloud.net", expected: "rc1a-5o3ad8p6f2ee8br1"},
+		{name: "", fqdn: "localhost", expected: "localhost"},
+	}
+
+	for _, c := range cases {
+		if actual := MovedHostLabel(c.name, c.fqdn); actual != c.expected {
+			t.Errorf("Unexpected host label for %q, %q: expected %q, actual %q", c.name, c.fqdn, c.expected, actual)
+		}
+	}
+}
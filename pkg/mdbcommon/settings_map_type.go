@@ -11,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 type SettingsAttributeInfoProvider interface {
@@ -19,7 +21,76 @@ type SettingsAttributeInfoProvider interface {
 	GetSetAttributes() map[string]struct{}
 }
 
-var _ basetypes.MapTypable = SettingsMapType{}
+// NewProtoSettingsAttributeInfoProvider creates SettingsAttributeInfoProvider for settings filled to the messages
+// by the protobuf adapter. Enum and list attributes are found by protoreflect, fields of nested messages are
+// flattened as the adapter does. Messages of several versions of the config are merged.
+func NewProtoSettingsAttributeInfoProvider(msgs ...proto.Message) SettingsAttributeInfoProvider {
+	p := &protoSettingsAttributeInfoProvider{
+		enumNames:     make(map[string]map[int32]string),
+		enumValues:    make(map[string]map[string]int32),
+		setAttributes: make(map[string]struct{}),
+	}
+	for _, m := range msgs {
+		p.addMessage(m.ProtoReflect().Descriptor())
+	}
+	return p
+}
+
+type protoSettingsAttributeInfoProvider struct {
+	enumNames     map[string]map[int32]string
+	enumValues    map[string]map[string]int32
+	setAttributes map[string]struct{}
+}
+
+func (p *protoSettingsAttributeInfoProvider) GetSettingsEnumNames() map[string]map[int32]string {
+	return p.enumNames
+}
+
+func (p *protoSettingsAttributeInfoProvider) GetSettingsEnumValues() map[string]map[string]int32 {
+	return p.enumValues
+}
+
+func (p *protoSettingsAttributeInfoProvider) GetSetAttributes() map[string]struct{} {
+	return p.setAttributes
+}
+
+func (p *protoSettingsAttributeInfoProvider) addMessage(md protoreflect.MessageDescriptor) {
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		name := string(fd.Name())
+
+		switch {
+		// Maps can't be set by a string setting
+		case fd.IsMap():
+		case fd.IsList():
+			p.setAttributes[name] = struct{}{}
+			if fd.Enum() != nil {
+				p.addEnum(name+".element", fd.Enum())
+			}
+		case fd.Enum() != nil:
+			p.addEnum(name, fd.Enum())
+		case fd.Message() != nil && fd.Message().ParentFile().Path() != "google/protobuf/wrappers.proto":
+			p.addMessage(fd.Message())
+		}
+	}
+}
+
+func (p *protoSettingsAttributeInfoProvider) addEnum(attr string, ed protoreflect.EnumDescriptor) {
+	if _, ok := p.enumNames[attr]; !ok {
+		p.enumNames[attr] = make(map[int32]string)
+		p.enumValues[attr] = make(map[string]int32)
+	}
+	for i := 0; i < ed.Values().Len(); i++ {
+		v := ed.Values().Get(i)
+		p.enumNames[attr][int32(v.Number())] = string(v.Name())
+		p.enumValues[attr][string(v.Name())] = int32(v.Number())
+	}
+}
+
+var (
+	_ basetypes.MapTypable                    = SettingsMapType{}
+	_ basetypes.MapValuableWithSemanticEquals = SettingsMapValue{}
+)
 
 // SettingsMapType type is based on the example in the terraform plugin framerwork documentation
 // https://developer.hashicorp.com/terraform/plugin/framework/handling-data/types/custom
@@ -111,6 +182,49 @@ func (v SettingsMapValue) Equal(o attr.Value) bool {
 	return v.MapValue.Equal(other.MapValue)
 }
 
+// MapSemanticEquals reports settings as equal if they convert to the same primitive values, e.g. an enum set by number
+// and by name, so the form written by the user is kept in the state instead of the one returned by the API.
+func (v SettingsMapValue) MapSemanticEquals(ctx context.Context, newValuable basetypes.MapValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(SettingsMapValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. It's error in provider", v, newValuable),
+		)
+		return false, diags
+	}
+
+	if v.IsNull() || v.IsUnknown() || newValue.IsNull() || newValue.IsUnknown() {
+		return v.MapValue.Equal(newValue.MapValue), nil
+	}
+	if v.p == nil {
+		v.p = newValue.p
+	}
+	if newValue.p == nil {
+		newValue.p = v.p
+	}
+	if v.p == nil || len(v.Elements()) != len(newValue.Elements()) {
+		return v.MapValue.Equal(newValue.MapValue), nil
+	}
+
+	// Values which can't be converted are reported by the plan, here they are just not equal
+	var convDiags diag.Diagnostics
+	oldElems, newElems := v.PrimitiveElements(ctx, &convDiags), newValue.PrimitiveElements(ctx, &convDiags)
+	if convDiags.HasError() {
+		return false, nil
+	}
+
+	for attr, oldVal := range oldElems {
+		newVal, ok := newElems[attr]
+		if !ok || !oldVal.Equal(newVal) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 // convertFromStringValue is necessary for converting string types to primitives.
 func (v SettingsMapValue) convertFromStringValue(ctx context.Context, a string, val types.String) (attr.Value, diag.Diagnostic) {
 
@@ -143,6 +257,11 @@ func (v SettingsMapValue) convertFromStringValue(ctx context.Context, a string,
 			return types.Int64Value(int64(num)), nil
 		}
 
+		// Some settings were numbers before they became enums, so numbers are accepted too
+		if num, err := strconv.ParseInt(s, 10, 32); err == nil {
+			return types.Int64Value(num), nil
+		}
+
 		return types.StringNull(), diag.NewErrorDiagnostic("Enum conversion error", fmt.Sprintf("Attribute %s has a unknown value %v", a, val))
 	}
 
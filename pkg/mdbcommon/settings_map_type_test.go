@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	config "github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1/config"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/spqr/v1"
 )
 
 type MockAttrInfoProvider struct{}
@@ -207,6 +208,37 @@ func TestYandexProvider_MDBSettingsMapGetPrimitiveMap(t *testing.T) {
 				"shared_preload_libraries": types.TupleValueMust([]attr.Type{types.Int64Type}, []attr.Value{types.Int64Value(1)}),
 			},
 		},
+		{
+			testname: "CheckNumericEnumAttributes",
+			reqVal: SettingsMapValue{
+				MapValue: types.MapValueMust(
+					types.StringType,
+					map[string]attr.Value{
+						"default_transaction_isolation": types.StringValue("1"),
+						"shared_preload_libraries":      types.StringValue("1,SHARED_PRELOAD_LIBRARIES_PG_CRON"),
+					},
+				),
+				p: &mockProvider,
+			},
+			expectedVal: map[string]attr.Value{
+				"default_transaction_isolation": types.Int64Value(1),
+				"shared_preload_libraries":      types.TupleValueMust([]attr.Type{types.Int64Type, types.Int64Type}, []attr.Value{types.Int64Value(1), types.Int64Value(5)}),
+			},
+		},
+		{
+			testname: "CheckUnknownEnumName",
+			reqVal: SettingsMapValue{
+				MapValue: types.MapValueMust(
+					types.StringType,
+					map[string]attr.Value{
+						"default_transaction_isolation": types.StringValue("TRANSACTION_ISOLATION_UNKNOWN"),
+					},
+				),
+				p: &mockProvider,
+			},
+			expectedVal:   map[string]attr.Value{},
+			expectedError: true,
+		},
 	}
 
 	for _, c := range cases {
@@ -240,3 +272,102 @@ func TestYandexProvider_MDBSettingsMapGetPrimitiveMap(t *testing.T) {
 		}
 	}
 }
+
+func TestYandexProvider_MDBSettingsMapSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	settings := func(elems map[string]string) SettingsMapValue {
+		values := make(map[string]attr.Value, len(elems))
+		for k, v := range elems {
+			values[k] = types.StringValue(v)
+		}
+		return SettingsMapValue{MapValue: types.MapValueMust(types.StringType, values), p: &mockProvider}
+	}
+
+	cases := []struct {
+		testname string
+		oldVal   SettingsMapValue
+		newVal   SettingsMapValue
+		expected bool
+	}{
+		{
+			testname: "CheckEnumNumberAndName",
+			oldVal:   settings(map[string]string{"default_transaction_isolation": "1", "max_connections": "100"}),
+			newVal:   settings(map[string]string{"default_transaction_isolation": "TRANSACTION_ISOLATION_READ_UNCOMMITTED", "max_connections": "100"}),
+			expected: true,
+		},
+		{
+			testname: "CheckListEnumNumbersAndNames",
+			oldVal:   settings(map[string]string{"shared_preload_libraries": "1,5"}),
+			newVal:   settings(map[string]string{"shared_preload_libraries": "SHARED_PRELOAD_LIBRARIES_AUTO_EXPLAIN,SHARED_PRELOAD_LIBRARIES_PG_CRON"}),
+			expected: true,
+		},
+		{
+			testname: "CheckFloatFormat",
+			oldVal:   settings(map[string]string{"bgwriter_lru_multiplier": "1.1"}),
+			newVal:   settings(map[string]string{"bgwriter_lru_multiplier": "1.10"}),
+			expected: true,
+		},
+		{
+			testname: "CheckDifferentEnum",
+			oldVal:   settings(map[string]string{"default_transaction_isolation": "2"}),
+			newVal:   settings(map[string]string{"default_transaction_isolation": "TRANSACTION_ISOLATION_READ_UNCOMMITTED"}),
+		},
+		{
+			testname: "CheckMissingAttribute",
+			oldVal:   settings(map[string]string{"max_connections": "100"}),
+			newVal:   settings(map[string]string{"search_path": "100"}),
+		},
+		{
+			testname: "CheckNull",
+			oldVal:   settings(map[string]string{"max_connections": "100"}),
+			newVal:   NewSettingsMapNull(),
+		},
+	}
+
+	for _, c := range cases {
+		equal, diags := c.oldVal.MapSemanticEquals(ctx, c.newVal)
+		if diags.HasError() {
+			t.Errorf("Unexpected semantic equality diagnostics %s test: errors: %v", c.testname, diags.Errors())
+			continue
+		}
+		if equal != c.expected {
+			t.Errorf("Unexpected semantic equality %s test: expected %t, actual %t", c.testname, c.expected, equal)
+		}
+	}
+}
+
+func TestYandexProvider_MDBProtoSettingsAttributeInfoProvider(t *testing.T) {
+	t.Parallel()
+
+	p := NewProtoSettingsAttributeInfoProvider(&config.PostgresqlConfig14{})
+	for attr, names := range settingsEnumNames {
+		if !reflect.DeepEqual(p.GetSettingsEnumNames()[attr], names) {
+			t.Errorf("Unexpected enum names of %s: expected %v, actual %v", attr, names, p.GetSettingsEnumNames()[attr])
+		}
+	}
+	for attr, values := range settingsEnumValues {
+		if !reflect.DeepEqual(p.GetSettingsEnumValues()[attr], values) {
+			t.Errorf("Unexpected enum values of %s: expected %v, actual %v", attr, values, p.GetSettingsEnumValues()[attr])
+		}
+	}
+	if !reflect.DeepEqual(p.GetSetAttributes(), listAttributes) {
+		t.Errorf("Unexpected list attributes: expected %v, actual %v", listAttributes, p.GetSetAttributes())
+	}
+
+	// Fields of nested messages are flattened, wrappers are primitives
+	p = NewProtoSettingsAttributeInfoProvider(&spqr.SpqrSpec{})
+	if !reflect.DeepEqual(p.GetSettingsEnumValues()["default_route_behavior"], spqr.RouterSettings_DefaultRouteBehavior_value) {
+		t.Errorf("Unexpected enum values of default_route_behavior: %v", p.GetSettingsEnumValues()["default_route_behavior"])
+	}
+	if !reflect.DeepEqual(p.GetSettingsEnumNames()["log_level"], spqr.LogLevel_name) {
+		t.Errorf("Unexpected enum names of log_level: %v", p.GetSettingsEnumNames()["log_level"])
+	}
+	if _, ok := p.GetSetAttributes()["time_quantiles"]; !ok {
+		t.Errorf("Attribute time_quantiles is expected to be a list: %v", p.GetSetAttributes())
+	}
+	if _, ok := p.GetSettingsEnumNames()["show_notice_messages"]; ok {
+		t.Errorf("Attribute show_notice_messages is not expected to be enum")
+	}
+}
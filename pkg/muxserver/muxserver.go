@@ -0,0 +1,44 @@
+package muxserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+// NewProviderServer returns the server combining SDKv2 and framework providers,
+// it is served by the provider binary and used by tools to load the provider schema.
+func NewProviderServer(ctx context.Context) (func() tfprotov6.ProviderServer, error) {
+	upgradedSdkProvider, _ := tf5to6server.UpgradeServer(
+		context.Background(),
+		yandex.NewSDKProvider().GRPCProvider,
+	)
+
+	providers := []func() tfprotov6.ProviderServer{
+		providerserver.NewProtocol6(yandex_framework.NewFrameworkProvider()),
+		func() tfprotov6.ProviderServer {
+			return upgradedSdkProvider
+		},
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+
+	server, ok := muxServer.ProviderServer().(tfprotov6.ProviderServerWithEphemeralResources)
+	if !ok {
+		return nil, fmt.Errorf("mux server %T doesn't serve ephemeral resources", muxServer.ProviderServer())
+	}
+
+	return func() tfprotov6.ProviderServer {
+		return recreateWarningServer{ProviderServerWithEphemeralResources: server}
+	}, nil
+}
@@ -0,0 +1,47 @@
+package muxserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/recreate"
+)
+
+// recreateWarningServer warns when replacement of a stateful resource is planned, naming the attributes forcing it.
+//
+// SDKv2 CustomizeDiff can't return warnings, so the warning is added to the plan response of the combined server,
+// which gets attributes requiring replacement from both SDKv2 ForceNew and framework RequiresReplace plan modifiers.
+//
+// The server embeds the interface with ephemeral resources, since terraform-plugin-go finds their methods
+// by type assertion and reports them as not implemented otherwise.
+type recreateWarningServer struct {
+	tfprotov6.ProviderServerWithEphemeralResources
+}
+
+var _ tfprotov6.ProviderServerWithEphemeralResources = recreateWarningServer{}
+
+func (s recreateWarningServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServerWithEphemeralResources.PlanResourceChange(ctx, req)
+	if err != nil || resp == nil || hasError(resp.Diagnostics) {
+		return resp, err
+	}
+
+	if summary, detail, ok := recreate.Warning(req.TypeName, resp.RequiresReplace); ok {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityWarning,
+			Summary:  summary,
+			Detail:   detail,
+		})
+	}
+	return resp, nil
+}
+
+func hasError(diags []*tfprotov6.Diagnostic) bool {
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			return true
+		}
+	}
+	return false
+}
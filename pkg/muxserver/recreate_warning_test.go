@@ -0,0 +1,100 @@
+package muxserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type planServer struct {
+	tfprotov6.ProviderServerWithEphemeralResources
+	resp *tfprotov6.PlanResourceChangeResponse
+}
+
+func (s planServer) PlanResourceChange(context.Context, *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	return s.resp, nil
+}
+
+func TestRecreateWarningServer(t *testing.T) {
+	zone := tftypes.NewAttributePath().WithAttributeName("zone")
+	errorDiag := &tfprotov6.Diagnostic{Severity: tfprotov6.DiagnosticSeverityError, Summary: "failed"}
+
+	cases := []struct {
+		name     string
+		typeName string
+		resp     *tfprotov6.PlanResourceChangeResponse
+		warning  bool
+	}{
+		{
+			name:     "stateful resource replaced",
+			typeName: "yandex_compute_disk",
+			resp:     &tfprotov6.PlanResourceChangeResponse{RequiresReplace: []*tftypes.AttributePath{zone}},
+			warning:  true,
+		},
+		{
+			name:     "stateful resource updated",
+			typeName: "yandex_compute_disk",
+			resp:     &tfprotov6.PlanResourceChangeResponse{},
+		},
+		{
+			name:     "stateless resource replaced",
+			typeName: "yandex_vpc_subnet",
+			resp:     &tfprotov6.PlanResourceChangeResponse{RequiresReplace: []*tftypes.AttributePath{zone}},
+		},
+		{
+			name:     "plan failed",
+			typeName: "yandex_compute_disk",
+			resp: &tfprotov6.PlanResourceChangeResponse{
+				RequiresReplace: []*tftypes.AttributePath{zone},
+				Diagnostics:     []*tfprotov6.Diagnostic{errorDiag},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := recreateWarningServer{ProviderServerWithEphemeralResources: planServer{resp: tc.resp}}
+			resp, err := server.PlanResourceChange(context.Background(), &tfprotov6.PlanResourceChangeRequest{TypeName: tc.typeName})
+			require.NoError(t, err)
+
+			var warnings []*tfprotov6.Diagnostic
+			for _, d := range resp.Diagnostics {
+				if d.Severity == tfprotov6.DiagnosticSeverityWarning {
+					warnings = append(warnings, d)
+				}
+			}
+			if !tc.warning {
+				assert.Empty(t, warnings)
+				return
+			}
+			require.Len(t, warnings, 1)
+			assert.Equal(t, tc.typeName+" will be recreated", warnings[0].Summary)
+		})
+	}
+}
+
+func TestNewProviderServer_EphemeralResources(t *testing.T) {
+	ctx := context.Background()
+
+	serverFactory, err := NewProviderServer(ctx)
+	require.NoError(t, err)
+
+	server, ok := serverFactory().(tfprotov6.ProviderServerWithEphemeralResources)
+	require.True(t, ok, "the provider server doesn't serve ephemeral resources")
+
+	metadata, err := server.GetMetadata(ctx, &tfprotov6.GetMetadataRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, metadata.EphemeralResources)
+
+	for _, r := range metadata.EphemeralResources {
+		resp, err := server.ValidateEphemeralResourceConfig(ctx, &tfprotov6.ValidateEphemeralResourceConfigRequest{TypeName: r.TypeName})
+		require.NoError(t, err)
+		for _, d := range resp.Diagnostics {
+			assert.NotContains(t, d.Summary, "Not Implemented", r.TypeName)
+		}
+	}
+}
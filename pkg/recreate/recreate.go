@@ -0,0 +1,109 @@
+package recreate
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// statefulResources lists resources holding user data, which is lost when the resource is replaced.
+// Values are in-place alternatives for attributes forcing replacement.
+var statefulResources = map[string]map[string]string{
+	"yandex_compute_disk": {
+		"zone": "The disk can be relocated to another zone with its data kept by `yc compute disk relocate`, " +
+			"after that the plan has no changes.",
+	},
+	"yandex_compute_filesystem": nil,
+	"yandex_storage_bucket":     nil,
+	"yandex_lockbox_secret":     nil,
+	"yandex_ydb_database_dedicated": {
+		"folder_id": ydbMove,
+	},
+	"yandex_ydb_database_serverless": {
+		"folder_id": ydbMove,
+	},
+	"yandex_ydb_table": nil,
+	"yandex_ydb_topic": nil,
+	"yandex_mdb_elasticsearch_cluster": {
+		"folder_id": clusterMove("managed-elasticsearch"),
+	},
+	"yandex_mdb_greenplum_cluster": {
+		"folder_id": clusterMove("managed-greenplum"),
+	},
+	"yandex_mdb_opensearch_cluster": {
+		"folder_id": clusterMove("managed-opensearch"),
+	},
+	"yandex_mdb_sqlserver_cluster": {
+		"folder_id": clusterMove("managed-sqlserver"),
+	},
+}
+
+// mdbResource matches managed database clusters and databases inside them, which are stateful as well.
+var mdbResource = regexp.MustCompile(`^yandex_mdb_\w+_(cluster|cluster_v2|database)$`)
+
+const ydbMove = "The database can be moved to another folder with its data kept by `yc ydb database move`, " +
+	"after that the plan has no changes."
+
+func clusterMove(cli string) string {
+	return fmt.Sprintf("The cluster can be moved to another folder with its data kept by `yc %s cluster move`, "+
+		"after that the plan has no changes.", cli)
+}
+
+// IsStateful reports whether the resource holds user data, so its replacement deserves a warning.
+func IsStateful(typeName string) bool {
+	_, ok := statefulResources[typeName]
+	return ok || mdbResource.MatchString(typeName)
+}
+
+// Warning returns the summary and the detail of the warning about planned replacement of the stateful resource.
+// Attributes are paths of attributes forcing replacement, ok is false if the resource is not stateful.
+func Warning(typeName string, attributes []*tftypes.AttributePath) (summary, detail string, ok bool) {
+	if !IsStateful(typeName) || len(attributes) == 0 {
+		return "", "", false
+	}
+
+	names := make([]string, 0, len(attributes))
+	seen := make(map[string]bool, len(attributes))
+	for _, p := range attributes {
+		name := AttributeName(p)
+		// SDKv2 marks `id` as requiring replacement along with the attributes which actually force it
+		if name != "" && name != "id" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return "", "", false
+	}
+	sort.Strings(names)
+
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, "`"+name+"`")
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Changing %s forces replacement of %s. ", strings.Join(quoted, ", "), typeName)
+	b.WriteString("The resource will be destroyed and created again, all its data will be lost.")
+	for _, name := range names {
+		if alternative, ok := statefulResources[typeName][name]; ok {
+			fmt.Fprintf(&b, "\n\n%s", alternative)
+		}
+	}
+
+	return fmt.Sprintf("%s will be recreated", typeName), b.String(), true
+}
+
+// AttributeName returns names of the attribute path joined by dots, element keys are omitted (e.g. restore.backup_id).
+func AttributeName(p *tftypes.AttributePath) string {
+	var names []string
+	for _, step := range p.Steps() {
+		if name, ok := step.(tftypes.AttributeName); ok {
+			names = append(names, string(name))
+		}
+	}
+	return strings.Join(names, ".")
+}
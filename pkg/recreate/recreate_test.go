@@ -0,0 +1,92 @@
+package recreate
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsStateful(t *testing.T) {
+	cases := []struct {
+		typeName string
+		expected bool
+	}{
+		{typeName: "yandex_compute_disk", expected: true},
+		{typeName: "yandex_storage_bucket", expected: true},
+		{typeName: "yandex_lockbox_secret", expected: true},
+		{typeName: "yandex_ydb_database_serverless", expected: true},
+		{typeName: "yandex_mdb_postgresql_cluster", expected: true},
+		{typeName: "yandex_mdb_postgresql_cluster_v2", expected: true},
+		{typeName: "yandex_mdb_mysql_database", expected: true},
+		{typeName: "yandex_mdb_mysql_user", expected: false},
+		{typeName: "yandex_compute_disk_iam_binding", expected: false},
+		{typeName: "yandex_vpc_network", expected: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.typeName, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsStateful(tc.typeName))
+		})
+	}
+}
+
+func TestWarning(t *testing.T) {
+	zone := tftypes.NewAttributePath().WithAttributeName("zone")
+	diskType := tftypes.NewAttributePath().WithAttributeName("type")
+	id := tftypes.NewAttributePath().WithAttributeName("id")
+	backupID := tftypes.NewAttributePath().WithAttributeName("restore").WithElementKeyInt(0).WithAttributeName("backup_id")
+
+	cases := []struct {
+		name       string
+		typeName   string
+		attributes []*tftypes.AttributePath
+		summary    string
+		detail     string
+		ok         bool
+	}{
+		{
+			name:       "not stateful",
+			typeName:   "yandex_vpc_network",
+			attributes: []*tftypes.AttributePath{zone},
+		},
+		{
+			name:     "no replacement",
+			typeName: "yandex_compute_disk",
+		},
+		{
+			name:       "only id",
+			typeName:   "yandex_compute_disk",
+			attributes: []*tftypes.AttributePath{id},
+		},
+		{
+			name:       "with alternative",
+			typeName:   "yandex_compute_disk",
+			attributes: []*tftypes.AttributePath{id, zone, diskType},
+			summary:    "yandex_compute_disk will be recreated",
+			detail: "Changing `type`, `zone` forces replacement of yandex_compute_disk. " +
+				"The resource will be destroyed and created again, all its data will be lost.\n\n" +
+				"The disk can be relocated to another zone with its data kept by `yc compute disk relocate`, " +
+				"after that the plan has no changes.",
+			ok: true,
+		},
+		{
+			name:       "nested attribute",
+			typeName:   "yandex_mdb_mysql_cluster",
+			attributes: []*tftypes.AttributePath{backupID, backupID},
+			summary:    "yandex_mdb_mysql_cluster will be recreated",
+			detail: "Changing `restore.backup_id` forces replacement of yandex_mdb_mysql_cluster. " +
+				"The resource will be destroyed and created again, all its data will be lost.",
+			ok: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			summary, detail, ok := Warning(tc.typeName, tc.attributes)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.summary, summary)
+			assert.Equal(t, tc.detail, detail)
+		})
+	}
+}
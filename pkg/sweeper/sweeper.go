@@ -0,0 +1,353 @@
+// Package sweeper runs sweepers of acceptance tests, which delete resources left in the test folder.
+//
+// Sweepers declare dependencies, i.e. sweepers which must complete before them (subnets are swept before
+// networks). Sweepers are grouped into levels by dependencies and sweepers of the same level run in parallel.
+// Sweepers declaring List and Delete can be run in the dry-run mode and skip resources younger than
+// -sweep-min-age. Other sweepers can't tell the age of resources, so they are skipped in both modes.
+package sweeper
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var (
+	flagDryRun      = flag.Bool("sweep-dry-run", false, "Report resources to be swept without deleting them")
+	flagMinAge      = flag.Duration("sweep-min-age", 0, "Sweep only resources created earlier than the duration ago, e.g. 3h")
+	flagParallelism = flag.Int("sweep-parallelism", 4, "Number of sweepers of the same level running at the same time")
+)
+
+// Resource is a resource found by a sweeper.
+type Resource struct {
+	ID   string
+	Name string
+	// CreatedAt is the creation time of the resource, zero time if unknown.
+	CreatedAt time.Time
+}
+
+// Sweeper deletes resources of a single type.
+type Sweeper struct {
+	Name string
+	// Dependencies are names of sweepers which must complete before the sweeper.
+	Dependencies []string
+
+	// List returns resources to be swept, e.g. all resources of the type in the test folder.
+	List func(ctx context.Context) ([]Resource, error)
+	// Delete deletes a resource returned by List.
+	Delete func(ctx context.Context, id string) error
+
+	// F sweeps all resources at once, it's used when List and Delete are not set.
+	// Such sweepers are skipped in the dry-run mode and when -sweep-min-age is set.
+	F func(region string) error
+}
+
+var (
+	registryMu sync.Mutex
+	registry   = make(map[string]*Sweeper)
+)
+
+// Add registers the sweeper, it is called from init functions of test files.
+func Add(s *Sweeper) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, ok := registry[s.Name]; ok {
+		log.Fatalf("[ERROR] Sweeper %q already exists", s.Name)
+	}
+	if s.F == nil && (s.List == nil || s.Delete == nil) {
+		log.Fatalf("[ERROR] Sweeper %q must declare either List and Delete or F", s.Name)
+	}
+	registry[s.Name] = s
+}
+
+// TestMain runs registered sweepers if -sweep flag is set, otherwise runs tests. The flag may list
+// several regions separated by commas, they are swept one after another.
+// Sweepers registered by resource.AddTestSweepers run after the registered ones.
+func TestMain(m interface{ Run() int }) {
+	flag.Parse()
+
+	region := flagValue("sweep")
+	if region == "" || len(registry) == 0 {
+		resource.TestMain(m)
+		return
+	}
+
+	opts := Options{
+		DryRun:        *flagDryRun,
+		MinAge:        *flagMinAge,
+		Parallelism:   *flagParallelism,
+		AllowFailures: flagValue("sweep-allow-failures") == "true",
+		Out:           os.Stdout,
+	}
+	if run := flagValue("sweep-run"); run != "" {
+		opts.Run = strings.Split(run, ",")
+	}
+
+	if err := runRegions(context.Background(), registry, strings.Split(region, ","), opts); err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+	if opts.DryRun {
+		os.Exit(0)
+	}
+	resource.TestMain(m)
+}
+
+// flagValue returns the value of the flag declared by terraform-plugin-testing.
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+	return ""
+}
+
+// Options of the sweepers run.
+type Options struct {
+	Region string
+	// Run is the list of sweepers to run with their dependencies, all sweepers are run if empty.
+	Run           []string
+	DryRun        bool
+	MinAge        time.Duration
+	Parallelism   int
+	AllowFailures bool
+	// Out is the destination of the report.
+	Out io.Writer
+	// Now returns the current time, it's used for filtering by age.
+	Now func() time.Time
+}
+
+// Run runs the sweepers level by level, sweepers of the next level start when all sweepers
+// of the previous one completed.
+func Run(ctx context.Context, sweepers map[string]*Sweeper, opts Options) error {
+	if opts.Out == nil {
+		opts.Out = io.Discard
+	}
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	if opts.Parallelism < 1 {
+		opts.Parallelism = 1
+	}
+
+	levels, err := Levels(sweepers, opts.Run)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for i, level := range levels {
+		fmt.Fprintf(opts.Out, "Level %d: %s\n", i+1, strings.Join(names(level), ", "))
+
+		reports := make([]string, len(level))
+		levelErrs := make([]error, len(level))
+		sem := make(chan struct{}, opts.Parallelism)
+		var wg sync.WaitGroup
+		for j, s := range level {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+
+				var report strings.Builder
+				levelErrs[j] = runSweeper(ctx, s, opts, &report)
+				reports[j] = report.String()
+			}()
+		}
+		wg.Wait()
+
+		for j := range level {
+			fmt.Fprint(opts.Out, reports[j])
+			if levelErrs[j] != nil {
+				errs = append(errs, levelErrs[j])
+			}
+		}
+		if len(errs) > 0 && !opts.AllowFailures {
+			return errors.Join(errs...)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// runRegions runs the sweepers in each of the regions one after another. Unless failures are allowed,
+// regions after the failed one are not swept.
+func runRegions(ctx context.Context, sweepers map[string]*Sweeper, regions []string, opts Options) error {
+	if opts.Out == nil {
+		opts.Out = io.Discard
+	}
+
+	var errs []error
+	for _, region := range regions {
+		opts.Region = strings.TrimSpace(region)
+		if opts.Region == "" {
+			continue
+		}
+
+		fmt.Fprintf(opts.Out, "Region %s\n", opts.Region)
+		if err := Run(ctx, sweepers, opts); err != nil {
+			errs = append(errs, fmt.Errorf("region %s: %w", opts.Region, err))
+			if !opts.AllowFailures {
+				break
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func runSweeper(ctx context.Context, s *Sweeper, opts Options, report io.Writer) error {
+	start := time.Now()
+	if s.List == nil {
+		if opts.DryRun {
+			fmt.Fprintf(report, "  %s: skipped, dry run is not supported\n", s.Name)
+			return nil
+		}
+		if opts.MinAge > 0 {
+			// The sweeper would delete young resources too, e.g. the ones of running tests
+			fmt.Fprintf(report, "  %s: skipped, min age is not supported\n", s.Name)
+			return nil
+		}
+		if err := s.F(opts.Region); err != nil {
+			fmt.Fprintf(report, "  %s: failed: %s\n", s.Name, err)
+			return fmt.Errorf("sweeper %s: %w", s.Name, err)
+		}
+		fmt.Fprintf(report, "  %s: done in %s\n", s.Name, time.Since(start).Round(time.Second))
+		return nil
+	}
+
+	resources, err := s.List(ctx)
+	if err != nil {
+		fmt.Fprintf(report, "  %s: failed to list resources: %s\n", s.Name, err)
+		return fmt.Errorf("sweeper %s: list resources: %w", s.Name, err)
+	}
+
+	var errs []error
+	deleted, skipped := 0, 0
+	for _, r := range resources {
+		if opts.MinAge > 0 && !r.CreatedAt.IsZero() && opts.Now().Sub(r.CreatedAt) < opts.MinAge {
+			skipped++
+			fmt.Fprintf(report, "  %s: skip %s, created %s ago\n", s.Name, describe(r), opts.Now().Sub(r.CreatedAt).Round(time.Second))
+			continue
+		}
+		if opts.DryRun {
+			deleted++
+			fmt.Fprintf(report, "  %s: would delete %s\n", s.Name, describe(r))
+			continue
+		}
+		if err := s.Delete(ctx, r.ID); err != nil {
+			fmt.Fprintf(report, "  %s: failed to delete %s: %s\n", s.Name, describe(r), err)
+			errs = append(errs, fmt.Errorf("sweeper %s: delete %s: %w", s.Name, r.ID, err))
+			continue
+		}
+		deleted++
+	}
+
+	action := "deleted"
+	if opts.DryRun {
+		action = "to delete"
+	}
+	fmt.Fprintf(report, "  %s: %d %s, %d skipped, %d failed in %s\n",
+		s.Name, deleted, action, skipped, len(errs), time.Since(start).Round(time.Second))
+	return errors.Join(errs...)
+}
+
+func describe(r Resource) string {
+	if r.Name == "" {
+		return r.ID
+	}
+	return fmt.Sprintf("%s (%s)", r.ID, r.Name)
+}
+
+// Levels groups the sweepers to run by dependencies: the first level contains sweepers without dependencies,
+// sweepers of the next levels depend only on sweepers of the previous ones. If run is not empty, only sweepers
+// from the list and their dependencies are returned. Sweepers and dependencies which are not registered are ignored,
+// since they may be registered by resource.AddTestSweepers.
+func Levels(sweepers map[string]*Sweeper, run []string) ([][]*Sweeper, error) {
+	selected := make(map[string]bool)
+	var visit func(name string)
+	visit = func(name string) {
+		s, ok := sweepers[name]
+		if !ok || selected[name] {
+			return
+		}
+		selected[name] = true
+		for _, dep := range s.Dependencies {
+			visit(dep)
+		}
+	}
+	if len(run) == 0 {
+		for name := range sweepers {
+			visit(name)
+		}
+	}
+	for _, name := range run {
+		visit(strings.TrimSpace(name))
+	}
+
+	level := make(map[string]int, len(selected))
+	const visiting = -1
+	var depth func(name string, path []string) (int, error)
+	depth = func(name string, path []string) (int, error) {
+		switch l, ok := level[name]; {
+		case ok && l == visiting:
+			return 0, fmt.Errorf("sweepers dependency cycle: %s", strings.Join(append(path, name), " -> "))
+		case ok:
+			return l, nil
+		}
+
+		level[name] = visiting
+		l := 0
+		for _, dep := range sweepers[name].Dependencies {
+			if !selected[dep] {
+				continue
+			}
+			d, err := depth(dep, append(path, name))
+			if err != nil {
+				return 0, err
+			}
+			l = max(l, d+1)
+		}
+		level[name] = l
+		return l, nil
+	}
+
+	var levels [][]*Sweeper
+	for _, name := range sortedNames(selected) {
+		l, err := depth(name, nil)
+		if err != nil {
+			return nil, err
+		}
+		for len(levels) <= l {
+			levels = append(levels, nil)
+		}
+		levels[l] = append(levels[l], sweepers[name])
+	}
+	return levels, nil
+}
+
+func sortedNames(set map[string]bool) []string {
+	result := make([]string, 0, len(set))
+	for name := range set {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func names(sweepers []*Sweeper) []string {
+	result := make([]string, 0, len(sweepers))
+	for _, s := range sweepers {
+		result = append(result, s.Name)
+	}
+	return result
+}
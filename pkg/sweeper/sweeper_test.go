@@ -0,0 +1,233 @@
+package sweeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func levelNames(levels [][]*Sweeper) [][]string {
+	result := make([][]string, 0, len(levels))
+	for _, level := range levels {
+		result = append(result, names(level))
+	}
+	return result
+}
+
+func TestLevels(t *testing.T) {
+	sweepers := map[string]*Sweeper{
+		"network":  {Name: "network", Dependencies: []string{"subnet", "route_table"}},
+		"subnet":   {Name: "subnet", Dependencies: []string{"instance", "mdb_cluster"}},
+		"instance": {Name: "instance", Dependencies: []string{"instance_group"}},
+		// Registered by resource.AddTestSweepers
+		"route_table": {Name: "route_table", Dependencies: []string{"gateway_of_legacy_sweeper"}},
+		"mdb_cluster": {Name: "mdb_cluster"},
+		"disk":        {Name: "disk", Dependencies: []string{"instance"}},
+	}
+
+	cases := []struct {
+		name     string
+		run      []string
+		expected [][]string
+	}{
+		{
+			name: "all",
+			expected: [][]string{
+				{"instance", "mdb_cluster", "route_table"},
+				{"disk", "subnet"},
+				{"network"},
+			},
+		},
+		{
+			name: "run with dependencies",
+			run:  []string{"subnet"},
+			expected: [][]string{
+				{"instance", "mdb_cluster"},
+				{"subnet"},
+			},
+		},
+		{
+			name:     "unknown sweeper",
+			run:      []string{"mdb_cluster", "legacy"},
+			expected: [][]string{{"mdb_cluster"}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			levels, err := Levels(sweepers, tc.run)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, levelNames(levels))
+		})
+	}
+}
+
+func TestLevelsCycle(t *testing.T) {
+	sweepers := map[string]*Sweeper{
+		"a": {Name: "a", Dependencies: []string{"b"}},
+		"b": {Name: "b", Dependencies: []string{"c"}},
+		"c": {Name: "c", Dependencies: []string{"a"}},
+	}
+
+	_, err := Levels(sweepers, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "dependency cycle: a -> b -> c -> a")
+}
+
+type fakeCloud struct {
+	mu        sync.Mutex
+	resources map[string][]Resource
+	deleted   []string
+}
+
+func (c *fakeCloud) sweeper(name string, deps ...string) *Sweeper {
+	return &Sweeper{
+		Name:         name,
+		Dependencies: deps,
+		List: func(context.Context) ([]Resource, error) {
+			return c.resources[name], nil
+		},
+		Delete: func(_ context.Context, id string) error {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			if strings.HasPrefix(id, "broken") {
+				return errors.New("internal error")
+			}
+			c.deleted = append(c.deleted, id)
+			return nil
+		},
+	}
+}
+
+func TestRun(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	newCloud := func() *fakeCloud {
+		return &fakeCloud{resources: map[string][]Resource{
+			"network": {{ID: "net1", Name: "tf-test-net", CreatedAt: now.Add(-5 * time.Hour)}},
+			"subnet": {
+				{ID: "subnet1", CreatedAt: now.Add(-5 * time.Hour)},
+				{ID: "subnet2", CreatedAt: now.Add(-10 * time.Minute)},
+				{ID: "subnet3"},
+			},
+		}}
+	}
+
+	t.Run("dependencies are swept first", func(t *testing.T) {
+		cloud := newCloud()
+		sweepers := map[string]*Sweeper{
+			"network": cloud.sweeper("network", "subnet"),
+			"subnet":  cloud.sweeper("subnet"),
+		}
+
+		err := Run(context.Background(), sweepers, Options{Parallelism: 2, Now: func() time.Time { return now }})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"subnet1", "subnet2", "subnet3", "net1"}, cloud.deleted)
+	})
+
+	t.Run("min age", func(t *testing.T) {
+		cloud := newCloud()
+		legacyCalled := false
+		sweepers := map[string]*Sweeper{
+			"subnet": cloud.sweeper("subnet"),
+			"legacy": {Name: "legacy", F: func(string) error {
+				legacyCalled = true
+				return nil
+			}},
+		}
+
+		out := &strings.Builder{}
+		err := Run(context.Background(), sweepers, Options{MinAge: time.Hour, Out: out, Now: func() time.Time { return now }})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"subnet1", "subnet3"}, cloud.deleted)
+		assert.False(t, legacyCalled)
+		assert.Contains(t, out.String(), "legacy: skipped, min age is not supported\n")
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		cloud := newCloud()
+		legacyCalled := false
+		sweepers := map[string]*Sweeper{
+			"network": cloud.sweeper("network", "subnet", "legacy"),
+			"subnet":  cloud.sweeper("subnet"),
+			"legacy": {Name: "legacy", F: func(string) error {
+				legacyCalled = true
+				return nil
+			}},
+		}
+
+		out := &strings.Builder{}
+		err := Run(context.Background(), sweepers, Options{DryRun: true, MinAge: time.Hour, Out: out, Now: func() time.Time { return now }})
+		require.NoError(t, err)
+		assert.Empty(t, cloud.deleted)
+		assert.False(t, legacyCalled)
+
+		report := out.String()
+		assert.Contains(t, report, "Level 1: legacy, subnet\n")
+		assert.Contains(t, report, "legacy: skipped, dry run is not supported\n")
+		assert.Contains(t, report, "subnet: would delete subnet1\n")
+		assert.Contains(t, report, "subnet: skip subnet2, created 10m0s ago\n")
+		assert.Contains(t, report, "network: would delete net1 (tf-test-net)\n")
+		assert.Contains(t, report, "network: 1 to delete, 0 skipped, 0 failed")
+	})
+
+	t.Run("failure stops next levels", func(t *testing.T) {
+		cloud := newCloud()
+		cloud.resources["subnet"] = []Resource{{ID: "broken-subnet"}}
+		sweepers := map[string]*Sweeper{
+			"network": cloud.sweeper("network", "subnet"),
+			"subnet":  cloud.sweeper("subnet"),
+		}
+
+		err := Run(context.Background(), sweepers, Options{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "sweeper subnet: delete broken-subnet: internal error")
+		assert.Empty(t, cloud.deleted)
+
+		err = Run(context.Background(), sweepers, Options{AllowFailures: true})
+		require.Error(t, err)
+		assert.Equal(t, []string{"net1"}, cloud.deleted)
+	})
+}
+
+func TestRunRegions(t *testing.T) {
+	newSweepers := func(swept *[]string, failIn string) map[string]*Sweeper {
+		return map[string]*Sweeper{
+			"legacy": {Name: "legacy", F: func(region string) error {
+				*swept = append(*swept, region)
+				if region == failIn {
+					return errors.New("internal error")
+				}
+				return nil
+			}},
+		}
+	}
+
+	t.Run("all regions are swept", func(t *testing.T) {
+		var swept []string
+		out := &strings.Builder{}
+		err := runRegions(context.Background(), newSweepers(&swept, ""), []string{"ru-central1", " kz1", ""}, Options{Out: out})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ru-central1", "kz1"}, swept)
+		assert.Contains(t, out.String(), "Region ru-central1\n")
+		assert.Contains(t, out.String(), "Region kz1\n")
+	})
+
+	t.Run("failure stops next regions", func(t *testing.T) {
+		var swept []string
+		err := runRegions(context.Background(), newSweepers(&swept, "ru-central1"), []string{"ru-central1", "kz1"}, Options{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "region ru-central1: sweeper legacy: internal error")
+		assert.Equal(t, []string{"ru-central1"}, swept)
+
+		swept = nil
+		err = runRegions(context.Background(), newSweepers(&swept, "ru-central1"), []string{"ru-central1", "kz1"}, Options{AllowFailures: true})
+		require.Error(t, err)
+		assert.Equal(t, []string{"ru-central1", "kz1"}, swept)
+	})
+}
@@ -0,0 +1,73 @@
+package testhelpers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/cassette"
+)
+
+// CassetteDir is the directory of the test package where cassettes are stored.
+const CassetteDir = "testdata/cassettes"
+
+// cassetteEnvVars lists environment variables which end up in API requests. Their values are recorded
+// and set back on replay, so the test sends the same requests whatever the environment is.
+var cassetteEnvVars = []string{
+	"YC_CLOUD_ID",
+	"YC_FOLDER_ID",
+	"YC_ORGANIZATION_ID",
+	"YC_ZONE",
+	"YC_REGION",
+}
+
+// replayToken is the token used on replay if none is set, IAM tokens are passed to the SDK as is.
+const replayToken = "t1.cassette.replay"
+
+// UseCassette records the API traffic of the test to testdata/cassettes/<test name>.json with TF_ACC_RECORD=1
+// and replays it from there with TF_ACC_REPLAY=1. Returns nil if neither is requested.
+// On replay the cloud, folder and zone of the recording are set to the environment and no credentials are needed.
+//
+// The cassette is passed to the provider with an environment variable, so the test can't be parallel.
+// Random values used in the test configuration must be taken with Value to be the same on replay:
+//
+//	c := testhelpers.UseCassette(t)
+//	name := c.Value("name", func() string { return acctest.RandomWithPrefix("tf-test") })
+func UseCassette(t *testing.T) *cassette.Cassette {
+	t.Helper()
+
+	mode := cassette.ModeFromEnv()
+	if mode == cassette.ModeOff {
+		return nil
+	}
+
+	path := filepath.Join(CassetteDir, t.Name()+".json")
+	if mode == cassette.ModeReplay {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			t.Skipf("cassette %s is not recorded", path)
+		}
+	}
+	t.Setenv(cassette.EnvPath, path)
+
+	c, err := cassette.Open(path, mode)
+	if err != nil {
+		t.Fatalf("failed to open cassette: %s", err)
+	}
+	for _, name := range cassetteEnvVars {
+		v := c.Value("env."+name, func() string { return os.Getenv(name) })
+		if mode == cassette.ModeReplay {
+			t.Setenv(name, v)
+		}
+	}
+	if mode == cassette.ModeReplay {
+		if os.Getenv("YC_TOKEN") == "" && os.Getenv("YC_SERVICE_ACCOUNT_KEY_FILE") == "" {
+			t.Setenv("YC_TOKEN", replayToken)
+		}
+		t.Cleanup(func() {
+			if n := c.Unused(); n > 0 {
+				t.Logf("%d recorded calls of cassette %s were not replayed", n, path)
+			}
+		})
+	}
+	return c
+}
@@ -25,6 +25,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/cassette"
 )
 
 var AccProviders map[string]tfprotov6.ProviderServer
@@ -104,6 +105,11 @@ func init() {
 }
 
 func AccPreCheck(t *testing.T) {
+	if cassette.ModeFromEnv() == cassette.ModeReplay {
+		// Replayed tests take the environment from the cassette, see UseCassette.
+		return
+	}
+
 	for _, varName := range AccEnvVars {
 		if val := os.Getenv(varName); val == "" {
 			t.Fatalf("%s must be set for acceptance tests", varName)
@@ -167,6 +173,17 @@ func GetBillingAccountId() string {
 }
 
 func setTestIDs() error {
+	if cassette.ModeFromEnv() == cassette.ModeReplay {
+		// Replayed tests don't reach the cloud, so only the IDs set in the environment are known.
+		cloudID = os.Getenv("YC_CLOUD_ID")
+		organizationID = os.Getenv("YC_ORGANIZATION_ID")
+		folderID = os.Getenv("YC_FOLDER_ID")
+		userLogin1 = os.Getenv("YC_LOGIN")
+		userLogin2 = os.Getenv("YC_LOGIN_2")
+		storageEndpoint = os.Getenv("YC_STORAGE_ENDPOINT_URL")
+		return nil
+	}
+
 	// init sdk client based on env var
 	envEndpoint := os.Getenv("YC_ENDPOINT")
 	if envEndpoint == "" {
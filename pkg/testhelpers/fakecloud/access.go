@@ -0,0 +1,138 @@
+package fakecloud
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// accessBindings stores access bindings of resources by resource ID. Bindings of deleted resources are not cleaned up,
+// IDs are never reused.
+type accessBindings struct {
+	mu       sync.Mutex
+	bindings map[string][]*access.AccessBinding
+}
+
+func newAccessBindings() *accessBindings {
+	return &accessBindings{bindings: make(map[string][]*access.AccessBinding)}
+}
+
+func (a *accessBindings) list(resourceID string) *access.ListAccessBindingsResponse {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	resp := &access.ListAccessBindingsResponse{}
+	for _, binding := range a.bindings[resourceID] {
+		resp.AccessBindings = append(resp.AccessBindings, clone(binding))
+	}
+	return resp
+}
+
+// set replaces the bindings of the resource and returns the effective changes.
+func (a *accessBindings) set(resourceID string, bindings []*access.AccessBinding) *access.AccessBindingsOperationResult {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	result := &access.AccessBindingsOperationResult{}
+	for _, binding := range a.bindings[resourceID] {
+		if !slices.ContainsFunc(bindings, sameBinding(binding)) {
+			result.EffectiveDeltas = append(result.EffectiveDeltas, delta(access.AccessBindingAction_REMOVE, binding))
+		}
+	}
+
+	var stored []*access.AccessBinding
+	for _, binding := range bindings {
+		if slices.ContainsFunc(stored, sameBinding(binding)) {
+			continue
+		}
+		if !slices.ContainsFunc(a.bindings[resourceID], sameBinding(binding)) {
+			result.EffectiveDeltas = append(result.EffectiveDeltas, delta(access.AccessBindingAction_ADD, binding))
+		}
+		stored = append(stored, clone(binding))
+	}
+	a.bindings[resourceID] = stored
+	return result
+}
+
+// update applies the deltas to the bindings of the resource and returns the ones which changed them.
+func (a *accessBindings) update(resourceID string, deltas []*access.AccessBindingDelta) (*access.AccessBindingsOperationResult, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	result := &access.AccessBindingsOperationResult{}
+	bindings := slices.Clone(a.bindings[resourceID])
+	for _, d := range deltas {
+		binding := d.GetAccessBinding()
+		i := slices.IndexFunc(bindings, sameBinding(binding))
+
+		switch d.GetAction() {
+		case access.AccessBindingAction_ADD:
+			if i >= 0 {
+				continue
+			}
+			bindings = append(bindings, clone(binding))
+		case access.AccessBindingAction_REMOVE:
+			if i < 0 {
+				continue
+			}
+			bindings = slices.Delete(bindings, i, i+1)
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported access binding action %s", d.GetAction())
+		}
+		result.EffectiveDeltas = append(result.EffectiveDeltas, delta(d.GetAction(), binding))
+	}
+	a.bindings[resourceID] = bindings
+	return result, nil
+}
+
+// sameBinding returns a matcher of bindings with the same role and subject as binding.
+func sameBinding(binding *access.AccessBinding) func(*access.AccessBinding) bool {
+	return func(b *access.AccessBinding) bool {
+		return b.GetRoleId() == binding.GetRoleId() &&
+			b.GetSubject().GetId() == binding.GetSubject().GetId() &&
+			b.GetSubject().GetType() == binding.GetSubject().GetType()
+	}
+}
+
+func delta(action access.AccessBindingAction, binding *access.AccessBinding) *access.AccessBindingDelta {
+	return &access.AccessBindingDelta{Action: action, AccessBinding: clone(binding)}
+}
+
+// accessBindingsHandler implements access binding methods of a service whose resources are stored in items.
+type accessBindingsHandler[T proto.Message] struct {
+	s     *Server
+	items *collection[T]
+}
+
+func (h accessBindingsHandler[T]) list(req *access.ListAccessBindingsRequest) (*access.ListAccessBindingsResponse, error) {
+	if _, err := h.items.get(req.GetResourceId()); err != nil {
+		return nil, err
+	}
+	return h.s.accessBindings.list(req.GetResourceId()), nil
+}
+
+func (h accessBindingsHandler[T]) set(req *access.SetAccessBindingsRequest) (*operation.Operation, error) {
+	if _, err := h.items.get(req.GetResourceId()); err != nil {
+		return nil, err
+	}
+
+	result := h.s.accessBindings.set(req.GetResourceId(), req.GetAccessBindings())
+	return h.s.done("Set access bindings", &access.SetAccessBindingsMetadata{ResourceId: req.GetResourceId()}, result)
+}
+
+func (h accessBindingsHandler[T]) update(req *access.UpdateAccessBindingsRequest) (*operation.Operation, error) {
+	if _, err := h.items.get(req.GetResourceId()); err != nil {
+		return nil, err
+	}
+
+	result, err := h.s.accessBindings.update(req.GetResourceId(), req.GetAccessBindingDeltas())
+	if err != nil {
+		return nil, err
+	}
+	return h.s.done("Update access bindings", &access.UpdateAccessBindingsMetadata{ResourceId: req.GetResourceId()}, result)
+}
@@ -0,0 +1,189 @@
+package fakecloud
+
+import (
+	"math/rand/v2"
+	"regexp"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const idAlphabet = "abcdefghijklmnopqrstuv0123456789"
+
+// newID returns an ID of the same format as the cloud does: a service prefix and 17 random characters.
+func newID(prefix string) string {
+	b := make([]byte, 17)
+	for i := range b {
+		b[i] = idAlphabet[rand.IntN(len(idAlphabet))]
+	}
+	return prefix + string(b)
+}
+
+// collection is an in-memory storage of resources of one type. Resources are cloned on the way in and out,
+// so callers never share memory with the storage.
+type collection[T proto.Message] struct {
+	kind   string
+	prefix string
+
+	mu    sync.Mutex
+	items map[string]T
+	ids   []string
+}
+
+func newCollection[T proto.Message](kind, prefix string) *collection[T] {
+	return &collection[T]{
+		kind:   kind,
+		prefix: prefix,
+		items:  make(map[string]T),
+	}
+}
+
+func (c *collection[T]) newID() string {
+	return newID(c.prefix)
+}
+
+func (c *collection[T]) notFound(id string) error {
+	return status.Errorf(codes.NotFound, "%s %s not found", c.kind, id)
+}
+
+func (c *collection[T]) insert(item T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := idOf(item)
+	if _, ok := c.items[id]; !ok {
+		c.ids = append(c.ids, id)
+	}
+	c.items[id] = clone(item)
+}
+
+func (c *collection[T]) get(id string) (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, ok := c.items[id]
+	if !ok {
+		var zero T
+		return zero, c.notFound(id)
+	}
+	return clone(item), nil
+}
+
+// list returns the matching resources in the order of creation.
+func (c *collection[T]) list(match func(T) bool) []T {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var result []T
+	for _, id := range c.ids {
+		if item := c.items[id]; match(item) {
+			result = append(result, clone(item))
+		}
+	}
+	return result
+}
+
+// update applies fn to a copy of the resource and stores it if fn succeeds.
+func (c *collection[T]) update(id string, fn func(T) error) (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero T
+	item, ok := c.items[id]
+	if !ok {
+		return zero, c.notFound(id)
+	}
+
+	updated := clone(item)
+	if err := fn(updated); err != nil {
+		return zero, err
+	}
+	c.items[id] = updated
+	return clone(updated), nil
+}
+
+func (c *collection[T]) delete(id string) (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, ok := c.items[id]
+	if !ok {
+		var zero T
+		return zero, c.notFound(id)
+	}
+
+	delete(c.items, id)
+	for i := range c.ids {
+		if c.ids[i] == id {
+			c.ids = append(c.ids[:i], c.ids[i+1:]...)
+			break
+		}
+	}
+	return item, nil
+}
+
+func clone[T proto.Message](m T) T {
+	return proto.Clone(m).(T)
+}
+
+func idOf(m proto.Message) string {
+	r := m.ProtoReflect()
+	return r.Get(r.Descriptor().Fields().ByName("id")).String()
+}
+
+// applyUpdateMask copies the fields listed in the mask from an update request to the resource.
+// Only top-level fields which have the same name and type in both messages are supported.
+func applyUpdateMask(dst, req proto.Message, mask *fieldmaskpb.FieldMask) error {
+	d := dst.ProtoReflect()
+	r := clone(req).ProtoReflect()
+
+	for _, path := range mask.GetPaths() {
+		df := d.Descriptor().Fields().ByName(protoreflect.Name(path))
+		rf := r.Descriptor().Fields().ByName(protoreflect.Name(path))
+		if df == nil || rf == nil || !sameFieldType(df, rf) {
+			return status.Errorf(codes.InvalidArgument, "update of field %q is not supported", path)
+		}
+
+		if r.Has(rf) {
+			d.Set(df, r.Get(rf))
+		} else {
+			d.Clear(df)
+		}
+	}
+	return nil
+}
+
+func sameFieldType(a, b protoreflect.FieldDescriptor) bool {
+	if a.Kind() != b.Kind() || a.Cardinality() != b.Cardinality() || a.IsMap() != b.IsMap() {
+		return false
+	}
+	if a.IsMap() {
+		return sameFieldType(a.MapKey(), b.MapKey()) && sameFieldType(a.MapValue(), b.MapValue())
+	}
+	if a.Message() != nil {
+		return a.Message().FullName() == b.Message().FullName()
+	}
+	if a.Enum() != nil {
+		return a.Enum().FullName() == b.Enum().FullName()
+	}
+	return true
+}
+
+var nameFilterRegexp = regexp.MustCompile(`^\s*name\s*=\s*"([^"]*)"\s*$`)
+
+// nameFilter returns a matcher for the `filter` of List requests. Only filtering by name is supported.
+func nameFilter(filter string) (func(name string) bool, error) {
+	if filter == "" {
+		return func(string) bool { return true }, nil
+	}
+
+	m := nameFilterRegexp.FindStringSubmatch(filter)
+	if m == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported filter %q", filter)
+	}
+	return func(name string) bool { return name == m[1] }, nil
+}
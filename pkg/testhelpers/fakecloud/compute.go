@@ -0,0 +1,457 @@
+package fakecloud
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"net/netip"
+	"slices"
+	"strconv"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultDiskTypeID    = "network-hdd"
+	defaultDiskBlockSize = 4096
+	// defaultDiskSize is the size of the disks created from an instance disk spec without size, images are not served to take it from.
+	defaultDiskSize = 10 << 30
+)
+
+func setDiskDefaults(disk *compute.Disk) {
+	if disk.TypeId == "" {
+		disk.TypeId = defaultDiskTypeID
+	}
+	if disk.BlockSize == 0 {
+		disk.BlockSize = defaultDiskBlockSize
+	}
+	// The cloud returns an empty policy rather than none.
+	if disk.DiskPlacementPolicy == nil {
+		disk.DiskPlacementPolicy = &compute.DiskPlacementPolicy{}
+	}
+}
+
+type diskService struct {
+	compute.UnimplementedDiskServiceServer
+	s *Server
+}
+
+func (d *diskService) Get(_ context.Context, req *compute.GetDiskRequest) (*compute.Disk, error) {
+	return d.s.disks.get(req.GetDiskId())
+}
+
+func (d *diskService) List(_ context.Context, req *compute.ListDisksRequest) (*compute.ListDisksResponse, error) {
+	match, err := nameFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	return &compute.ListDisksResponse{
+		Disks: d.s.disks.list(func(disk *compute.Disk) bool {
+			return disk.GetFolderId() == req.GetFolderId() && match(disk.GetName())
+		}),
+	}, nil
+}
+
+// Create creates a disk. Images and snapshots are not served, so their IDs are only recorded as the disk source.
+func (d *diskService) Create(_ context.Context, req *compute.CreateDiskRequest) (*operation.Operation, error) {
+	if err := d.s.checkFolder(req.GetFolderId()); err != nil {
+		return nil, err
+	}
+	if req.GetSize() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "size must be positive")
+	}
+
+	disk := &compute.Disk{
+		Id:                  d.s.disks.newID(),
+		FolderId:            req.GetFolderId(),
+		CreatedAt:           timestamppb.Now(),
+		Name:                req.GetName(),
+		Description:         req.GetDescription(),
+		Labels:              req.GetLabels(),
+		TypeId:              req.GetTypeId(),
+		ZoneId:              req.GetZoneId(),
+		Size:                req.GetSize(),
+		BlockSize:           req.GetBlockSize(),
+		Status:              compute.Disk_READY,
+		DiskPlacementPolicy: req.GetDiskPlacementPolicy(),
+	}
+	setDiskDefaults(disk)
+	switch source := req.GetSource().(type) {
+	case *compute.CreateDiskRequest_ImageId:
+		disk.Source = &compute.Disk_SourceImageId{SourceImageId: source.ImageId}
+	case *compute.CreateDiskRequest_SnapshotId:
+		disk.Source = &compute.Disk_SourceSnapshotId{SourceSnapshotId: source.SnapshotId}
+	}
+	d.s.disks.insert(disk)
+
+	return d.s.done("Create disk", &compute.CreateDiskMetadata{DiskId: disk.Id}, disk)
+}
+
+func (d *diskService) Update(_ context.Context, req *compute.UpdateDiskRequest) (*operation.Operation, error) {
+	disk, err := d.s.disks.update(req.GetDiskId(), func(disk *compute.Disk) error {
+		size := disk.GetSize()
+		if err := applyUpdateMask(disk, req, req.GetUpdateMask()); err != nil {
+			return err
+		}
+		if disk.GetSize() < size {
+			return status.Errorf(codes.InvalidArgument, "disk size can't be decreased from %d to %d", size, disk.GetSize())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return d.s.done("Update disk", &compute.UpdateDiskMetadata{DiskId: disk.Id}, disk)
+}
+
+func (d *diskService) ListAccessBindings(_ context.Context, req *access.ListAccessBindingsRequest) (*access.ListAccessBindingsResponse, error) {
+	return d.accessBindings().list(req)
+}
+
+func (d *diskService) SetAccessBindings(_ context.Context, req *access.SetAccessBindingsRequest) (*operation.Operation, error) {
+	return d.accessBindings().set(req)
+}
+
+func (d *diskService) UpdateAccessBindings(_ context.Context, req *access.UpdateAccessBindingsRequest) (*operation.Operation, error) {
+	return d.accessBindings().update(req)
+}
+
+func (d *diskService) accessBindings() accessBindingsHandler[*compute.Disk] {
+	return accessBindingsHandler[*compute.Disk]{s: d.s, items: d.s.disks}
+}
+
+func (d *diskService) Delete(_ context.Context, req *compute.DeleteDiskRequest) (*operation.Operation, error) {
+	disk, err := d.s.disks.get(req.GetDiskId())
+	if err != nil {
+		return nil, err
+	}
+	if len(disk.GetInstanceIds()) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Disk %s is attached to instance %s", disk.GetId(), disk.GetInstanceIds()[0])
+	}
+	if _, err := d.s.disks.delete(req.GetDiskId()); err != nil {
+		return nil, err
+	}
+
+	return d.s.done("Delete disk", &compute.DeleteDiskMetadata{DiskId: req.GetDiskId()}, nil)
+}
+
+type instanceService struct {
+	compute.UnimplementedInstanceServiceServer
+	s *Server
+}
+
+func (i *instanceService) Get(_ context.Context, req *compute.GetInstanceRequest) (*compute.Instance, error) {
+	return i.s.instances.get(req.GetInstanceId())
+}
+
+func (i *instanceService) List(_ context.Context, req *compute.ListInstancesRequest) (*compute.ListInstancesResponse, error) {
+	match, err := nameFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	return &compute.ListInstancesResponse{
+		Instances: i.s.instances.list(func(instance *compute.Instance) bool {
+			return instance.GetFolderId() == req.GetFolderId() && match(instance.GetName())
+		}),
+	}, nil
+}
+
+// Create creates a running instance. Boot and secondary disks are either created from the disk spec or attached
+// by ID, primary IPv4 addresses are allocated in the subnets of the network interfaces unless given.
+func (i *instanceService) Create(_ context.Context, req *compute.CreateInstanceRequest) (*operation.Operation, error) {
+	if err := i.s.checkFolder(req.GetFolderId()); err != nil {
+		return nil, err
+	}
+	if req.GetResourcesSpec().GetCores() <= 0 || req.GetResourcesSpec().GetMemory() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "resources_spec.cores and resources_spec.memory must be positive")
+	}
+	if req.GetBootDiskSpec() == nil {
+		return nil, status.Error(codes.InvalidArgument, "boot_disk_spec is required")
+	}
+	if len(req.GetNetworkInterfaceSpecs()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "network_interface_specs is required")
+	}
+
+	instance := &compute.Instance{
+		Id:               i.s.instances.newID(),
+		FolderId:         req.GetFolderId(),
+		CreatedAt:        timestamppb.Now(),
+		Name:             req.GetName(),
+		Description:      req.GetDescription(),
+		Labels:           req.GetLabels(),
+		ZoneId:           req.GetZoneId(),
+		PlatformId:       req.GetPlatformId(),
+		Resources:        resourcesOf(req.GetResourcesSpec()),
+		Status:           compute.Instance_RUNNING,
+		Metadata:         req.GetMetadata(),
+		MetadataOptions:  req.GetMetadataOptions(),
+		SchedulingPolicy: req.GetSchedulingPolicy(),
+		ServiceAccountId: req.GetServiceAccountId(),
+		NetworkSettings:  req.GetNetworkSettings(),
+		PlacementPolicy:  req.GetPlacementPolicy(),
+	}
+	if instance.ZoneId == "" {
+		instance.ZoneId = Zone
+	}
+	// The cloud returns empty policies rather than none.
+	if instance.SchedulingPolicy == nil {
+		instance.SchedulingPolicy = &compute.SchedulingPolicy{}
+	}
+	if instance.PlacementPolicy == nil {
+		instance.PlacementPolicy = &compute.PlacementPolicy{}
+	}
+	if instance.MetadataOptions == nil {
+		instance.MetadataOptions = &compute.MetadataOptions{}
+	}
+	if instance.NetworkSettings == nil {
+		instance.NetworkSettings = &compute.NetworkSettings{Type: compute.NetworkSettings_STANDARD}
+	}
+	instance.Fqdn = req.GetHostname()
+	if instance.Fqdn == "" {
+		instance.Fqdn = instance.Id
+	}
+	instance.Fqdn += ".auto.internal"
+
+	for idx, spec := range req.GetNetworkInterfaceSpecs() {
+		nic, err := i.s.networkInterface(strconv.Itoa(idx), spec)
+		if err != nil {
+			return nil, err
+		}
+		instance.NetworkInterfaces = append(instance.NetworkInterfaces, nic)
+	}
+
+	var err error
+	if instance.BootDisk, err = i.s.attachDisk(instance, req.GetBootDiskSpec()); err != nil {
+		i.s.detachDisks(instance)
+		return nil, err
+	}
+	for _, spec := range req.GetSecondaryDiskSpecs() {
+		disk, err := i.s.attachDisk(instance, spec)
+		if err != nil {
+			i.s.detachDisks(instance)
+			return nil, err
+		}
+		instance.SecondaryDisks = append(instance.SecondaryDisks, disk)
+	}
+	i.s.instances.insert(instance)
+
+	return i.s.done("Create instance", &compute.CreateInstanceMetadata{InstanceId: instance.Id}, instance)
+}
+
+// Update updates the top-level fields of an instance, `resources_spec` updates its resources.
+func (i *instanceService) Update(_ context.Context, req *compute.UpdateInstanceRequest) (*operation.Operation, error) {
+	instance, err := i.s.instances.update(req.GetInstanceId(), func(instance *compute.Instance) error {
+		mask := &fieldmaskpb.FieldMask{}
+		for _, path := range req.GetUpdateMask().GetPaths() {
+			if path == "resources_spec" {
+				instance.Resources = resourcesOf(req.GetResourcesSpec())
+				continue
+			}
+			mask.Paths = append(mask.Paths, path)
+		}
+		return applyUpdateMask(instance, req, mask)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return i.s.done("Update instance", &compute.UpdateInstanceMetadata{InstanceId: instance.Id}, instance)
+}
+
+// Delete deletes an instance along with its disks marked for auto deletion, other disks are detached.
+func (i *instanceService) Delete(_ context.Context, req *compute.DeleteInstanceRequest) (*operation.Operation, error) {
+	instance, err := i.s.instances.delete(req.GetInstanceId())
+	if err != nil {
+		return nil, err
+	}
+	i.s.detachDisks(instance)
+
+	return i.s.done("Delete instance", &compute.DeleteInstanceMetadata{InstanceId: req.GetInstanceId()}, nil)
+}
+
+func (i *instanceService) Start(_ context.Context, req *compute.StartInstanceRequest) (*operation.Operation, error) {
+	instance, err := i.s.setInstanceStatus(req.GetInstanceId(), compute.Instance_RUNNING)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.s.done("Start instance", &compute.StartInstanceMetadata{InstanceId: instance.Id}, instance)
+}
+
+func (i *instanceService) Stop(_ context.Context, req *compute.StopInstanceRequest) (*operation.Operation, error) {
+	if _, err := i.s.setInstanceStatus(req.GetInstanceId(), compute.Instance_STOPPED); err != nil {
+		return nil, err
+	}
+
+	return i.s.done("Stop instance", &compute.StopInstanceMetadata{InstanceId: req.GetInstanceId()}, nil)
+}
+
+func (s *Server) setInstanceStatus(id string, st compute.Instance_Status) (*compute.Instance, error) {
+	return s.instances.update(id, func(instance *compute.Instance) error {
+		instance.Status = st
+		return nil
+	})
+}
+
+func resourcesOf(spec *compute.ResourcesSpec) *compute.Resources {
+	resources := &compute.Resources{
+		Memory:       spec.GetMemory(),
+		Cores:        spec.GetCores(),
+		CoreFraction: spec.GetCoreFraction(),
+		Gpus:         spec.GetGpus(),
+	}
+	if resources.CoreFraction == 0 {
+		resources.CoreFraction = 100
+	}
+	return resources
+}
+
+// attachDisk creates a disk from the spec or takes an existing one and marks it as attached to the instance.
+func (s *Server) attachDisk(instance *compute.Instance, spec *compute.AttachedDiskSpec) (*compute.AttachedDisk, error) {
+	diskID := spec.GetDiskId()
+	if diskSpec := spec.GetDiskSpec(); diskSpec != nil {
+		disk := &compute.Disk{
+			Id:                  s.disks.newID(),
+			FolderId:            instance.GetFolderId(),
+			CreatedAt:           timestamppb.Now(),
+			Name:                diskSpec.GetName(),
+			Description:         diskSpec.GetDescription(),
+			TypeId:              diskSpec.GetTypeId(),
+			ZoneId:              instance.GetZoneId(),
+			Size:                diskSpec.GetSize(),
+			BlockSize:           diskSpec.GetBlockSize(),
+			Status:              compute.Disk_READY,
+			DiskPlacementPolicy: diskSpec.GetDiskPlacementPolicy(),
+		}
+		setDiskDefaults(disk)
+		if disk.Size == 0 {
+			disk.Size = defaultDiskSize
+		}
+		switch source := diskSpec.GetSource().(type) {
+		case *compute.AttachedDiskSpec_DiskSpec_ImageId:
+			disk.Source = &compute.Disk_SourceImageId{SourceImageId: source.ImageId}
+		case *compute.AttachedDiskSpec_DiskSpec_SnapshotId:
+			disk.Source = &compute.Disk_SourceSnapshotId{SourceSnapshotId: source.SnapshotId}
+		}
+		s.disks.insert(disk)
+		diskID = disk.Id
+	}
+
+	_, err := s.disks.update(diskID, func(disk *compute.Disk) error {
+		if len(disk.GetInstanceIds()) > 0 {
+			return status.Errorf(codes.FailedPrecondition, "Disk %s is already attached to instance %s", disk.GetId(), disk.GetInstanceIds()[0])
+		}
+		disk.InstanceIds = []string{instance.GetId()}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	attached := &compute.AttachedDisk{
+		Mode:       compute.AttachedDisk_READ_WRITE,
+		DeviceName: spec.GetDeviceName(),
+		AutoDelete: spec.GetAutoDelete(),
+		DiskId:     diskID,
+	}
+	if spec.GetMode() == compute.AttachedDiskSpec_READ_ONLY {
+		attached.Mode = compute.AttachedDisk_READ_ONLY
+	}
+	if attached.DeviceName == "" {
+		attached.DeviceName = diskID
+	}
+	return attached, nil
+}
+
+// detachDisks detaches the disks of an instance and deletes the ones marked for auto deletion.
+func (s *Server) detachDisks(instance *compute.Instance) {
+	disks := instance.GetSecondaryDisks()
+	if instance.GetBootDisk() != nil {
+		disks = append(disks, instance.GetBootDisk())
+	}
+
+	for _, attached := range disks {
+		if attached.GetAutoDelete() {
+			s.disks.delete(attached.GetDiskId())
+			continue
+		}
+		s.disks.update(attached.GetDiskId(), func(disk *compute.Disk) error {
+			disk.InstanceIds = slices.DeleteFunc(disk.InstanceIds, func(id string) bool { return id == instance.GetId() })
+			return nil
+		})
+	}
+}
+
+// networkInterface returns an interface in the subnet of the spec with the requested or the first free address.
+func (s *Server) networkInterface(index string, spec *compute.NetworkInterfaceSpec) (*compute.NetworkInterface, error) {
+	subnet, err := s.subnets.get(spec.GetSubnetId())
+	if err != nil {
+		return nil, err
+	}
+
+	address := spec.GetPrimaryV4AddressSpec().GetAddress()
+	if address == "" {
+		if address, err = s.freeAddress(subnet); err != nil {
+			return nil, err
+		}
+	}
+
+	nic := &compute.NetworkInterface{
+		Index:            index,
+		MacAddress:       newMacAddress(),
+		SubnetId:         subnet.GetId(),
+		PrimaryV4Address: &compute.PrimaryAddress{Address: address},
+		SecurityGroupIds: spec.GetSecurityGroupIds(),
+	}
+	if nat := spec.GetPrimaryV4AddressSpec().GetOneToOneNatSpec(); nat != nil {
+		nic.PrimaryV4Address.OneToOneNat = &compute.OneToOneNat{
+			Address:   nat.GetAddress(),
+			IpVersion: compute.IpVersion_IPV4,
+		}
+		if nic.PrimaryV4Address.OneToOneNat.Address == "" {
+			nic.PrimaryV4Address.OneToOneNat.Address = "198.51.100." + strconv.Itoa(1+rand.IntN(254))
+		}
+	}
+	return nic, nil
+}
+
+// freeAddress returns the first address of the subnet which is not used by instances.
+// The first two host addresses are skipped as the cloud reserves them for the gateway and DNS.
+func (s *Server) freeAddress(subnet *vpc.Subnet) (string, error) {
+	used := make(map[string]bool)
+	for _, instance := range s.instances.list(func(*compute.Instance) bool { return true }) {
+		for _, nic := range instance.GetNetworkInterfaces() {
+			if nic.GetSubnetId() == subnet.GetId() {
+				used[nic.GetPrimaryV4Address().GetAddress()] = true
+			}
+		}
+	}
+
+	for _, cidr := range subnet.GetV4CidrBlocks() {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "invalid CIDR block %q of subnet %s", cidr, subnet.GetId())
+		}
+		addr := prefix.Masked().Addr().Next().Next().Next()
+		for ; prefix.Contains(addr); addr = addr.Next() {
+			if !used[addr.String()] {
+				return addr.String(), nil
+			}
+		}
+	}
+	return "", status.Errorf(codes.ResourceExhausted, "no free addresses left in subnet %s", subnet.GetId())
+}
+
+func newMacAddress() string {
+	return fmt.Sprintf("d0:0d:%02x:%02x:%02x:%02x", rand.IntN(256), rand.IntN(256), rand.IntN(256), rand.IntN(256))
+}
@@ -0,0 +1,281 @@
+package fakecloud
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type dnsZoneService struct {
+	dns.UnimplementedDnsZoneServiceServer
+	s *Server
+}
+
+func (z *dnsZoneService) Get(_ context.Context, req *dns.GetDnsZoneRequest) (*dns.DnsZone, error) {
+	return z.s.dnsZones.get(req.GetDnsZoneId())
+}
+
+func (z *dnsZoneService) List(_ context.Context, req *dns.ListDnsZonesRequest) (*dns.ListDnsZonesResponse, error) {
+	match, err := nameFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	return &dns.ListDnsZonesResponse{
+		DnsZones: z.s.dnsZones.list(func(zone *dns.DnsZone) bool {
+			return zone.GetFolderId() == req.GetFolderId() && match(zone.GetName())
+		}),
+	}, nil
+}
+
+func (z *dnsZoneService) Create(_ context.Context, req *dns.CreateDnsZoneRequest) (*operation.Operation, error) {
+	if err := z.s.checkFolder(req.GetFolderId()); err != nil {
+		return nil, err
+	}
+	if req.GetZone() == "" {
+		return nil, status.Error(codes.InvalidArgument, "zone is required")
+	}
+
+	zone := &dns.DnsZone{
+		Id:                 z.s.dnsZones.newID(),
+		FolderId:           req.GetFolderId(),
+		CreatedAt:          timestamppb.Now(),
+		Name:               req.GetName(),
+		Description:        req.GetDescription(),
+		Labels:             req.GetLabels(),
+		Zone:               req.GetZone(),
+		PrivateVisibility:  req.GetPrivateVisibility(),
+		PublicVisibility:   req.GetPublicVisibility(),
+		DeletionProtection: req.GetDeletionProtection(),
+	}
+	z.s.dnsZones.insert(zone)
+
+	return z.s.done("Create DNS zone", &dns.CreateDnsZoneMetadata{DnsZoneId: zone.Id}, zone)
+}
+
+func (z *dnsZoneService) Update(_ context.Context, req *dns.UpdateDnsZoneRequest) (*operation.Operation, error) {
+	zone, err := z.s.dnsZones.update(req.GetDnsZoneId(), func(zone *dns.DnsZone) error {
+		return applyUpdateMask(zone, req, req.GetUpdateMask())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return z.s.done("Update DNS zone", &dns.UpdateDnsZoneMetadata{DnsZoneId: zone.Id}, zone)
+}
+
+func (z *dnsZoneService) Delete(_ context.Context, req *dns.DeleteDnsZoneRequest) (*operation.Operation, error) {
+	zone, err := z.s.dnsZones.get(req.GetDnsZoneId())
+	if err != nil {
+		return nil, err
+	}
+	if zone.GetDeletionProtection() {
+		return nil, status.Errorf(codes.FailedPrecondition, "DNS zone %s is protected from deletion", zone.GetId())
+	}
+	if _, err := z.s.dnsZones.delete(zone.GetId()); err != nil {
+		return nil, err
+	}
+	z.s.recordSets.deleteZone(zone.GetId())
+
+	return z.s.done("Delete DNS zone", &dns.DeleteDnsZoneMetadata{DnsZoneId: zone.GetId()}, nil)
+}
+
+func (z *dnsZoneService) GetRecordSet(_ context.Context, req *dns.GetDnsZoneRecordSetRequest) (*dns.RecordSet, error) {
+	if _, err := z.s.dnsZones.get(req.GetDnsZoneId()); err != nil {
+		return nil, err
+	}
+	return z.s.recordSets.get(req.GetDnsZoneId(), req.GetName(), req.GetType())
+}
+
+func (z *dnsZoneService) ListRecordSets(_ context.Context, req *dns.ListDnsZoneRecordSetsRequest) (*dns.ListDnsZoneRecordSetsResponse, error) {
+	if _, err := z.s.dnsZones.get(req.GetDnsZoneId()); err != nil {
+		return nil, err
+	}
+	match, err := nameFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	return &dns.ListDnsZoneRecordSetsResponse{
+		RecordSets: z.s.recordSets.list(req.GetDnsZoneId(), match),
+	}, nil
+}
+
+// UpdateRecordSets applies deletions and additions atomically. Deleted record sets must exist and added ones must not.
+func (z *dnsZoneService) UpdateRecordSets(_ context.Context, req *dns.UpdateRecordSetsRequest) (*operation.Operation, error) {
+	if _, err := z.s.dnsZones.get(req.GetDnsZoneId()); err != nil {
+		return nil, err
+	}
+
+	diff, err := z.s.recordSets.apply(req.GetDnsZoneId(), func(sets map[recordSetKey]*dns.RecordSet, diff *dns.RecordSetDiff) error {
+		for _, rs := range req.GetDeletions() {
+			key := keyOf(rs)
+			if _, ok := sets[key]; !ok {
+				return status.Errorf(codes.NotFound, "Record set %s %s not found", rs.GetName(), rs.GetType())
+			}
+			diff.Deletions = append(diff.Deletions, sets[key])
+			delete(sets, key)
+		}
+		for _, rs := range req.GetAdditions() {
+			key := keyOf(rs)
+			if _, ok := sets[key]; ok {
+				return status.Errorf(codes.AlreadyExists, "Record set %s %s already exists", rs.GetName(), rs.GetType())
+			}
+			sets[key] = rs
+			diff.Additions = append(diff.Additions, rs)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return z.s.done("Update record sets", &dns.UpdateRecordSetsMetadata{}, diff)
+}
+
+// UpsertRecordSets removes the given records, replaces whole record sets and merges records into existing ones.
+func (z *dnsZoneService) UpsertRecordSets(_ context.Context, req *dns.UpsertRecordSetsRequest) (*operation.Operation, error) {
+	if _, err := z.s.dnsZones.get(req.GetDnsZoneId()); err != nil {
+		return nil, err
+	}
+
+	diff, err := z.s.recordSets.apply(req.GetDnsZoneId(), func(sets map[recordSetKey]*dns.RecordSet, diff *dns.RecordSetDiff) error {
+		for _, rs := range req.GetDeletions() {
+			key := keyOf(rs)
+			if old, ok := sets[key]; ok {
+				diff.Deletions = append(diff.Deletions, old)
+				delete(sets, key)
+				if rest := withoutData(old, rs.GetData()); len(rest.GetData()) > 0 && len(rs.GetData()) > 0 {
+					sets[key] = rest
+					diff.Additions = append(diff.Additions, rest)
+				}
+			}
+		}
+		for _, rs := range req.GetReplacements() {
+			key := keyOf(rs)
+			if old, ok := sets[key]; ok {
+				diff.Deletions = append(diff.Deletions, old)
+			}
+			sets[key] = rs
+			diff.Additions = append(diff.Additions, rs)
+		}
+		for _, rs := range req.GetMerges() {
+			key := keyOf(rs)
+			merged := rs
+			if old, ok := sets[key]; ok {
+				diff.Deletions = append(diff.Deletions, old)
+				merged = proto.Clone(old).(*dns.RecordSet)
+				merged.Ttl = rs.GetTtl()
+				merged.Data = append(withoutData(old, rs.GetData()).GetData(), rs.GetData()...)
+			}
+			sets[key] = merged
+			diff.Additions = append(diff.Additions, merged)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return z.s.done("Upsert record sets", &dns.UpsertRecordSetsMetadata{}, diff)
+}
+
+type recordSetKey struct {
+	name, typ string
+}
+
+func keyOf(rs *dns.RecordSet) recordSetKey {
+	return recordSetKey{name: rs.GetName(), typ: rs.GetType()}
+}
+
+// withoutData returns a copy of the record set without the given records.
+func withoutData(rs *dns.RecordSet, data []string) *dns.RecordSet {
+	drop := make(map[string]bool, len(data))
+	for _, d := range data {
+		drop[d] = true
+	}
+
+	res := proto.Clone(rs).(*dns.RecordSet)
+	res.Data = nil
+	for _, d := range rs.GetData() {
+		if !drop[d] {
+			res.Data = append(res.Data, d)
+		}
+	}
+	return res
+}
+
+// recordSets stores record sets of all DNS zones, keyed by zone ID and by record set name and type.
+type recordSets struct {
+	mu    sync.Mutex
+	zones map[string]map[recordSetKey]*dns.RecordSet
+}
+
+func newRecordSets() *recordSets {
+	return &recordSets{zones: map[string]map[recordSetKey]*dns.RecordSet{}}
+}
+
+func (r *recordSets) get(zoneID, name, typ string) (*dns.RecordSet, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rs, ok := r.zones[zoneID][recordSetKey{name: name, typ: typ}]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Record set %s %s not found", name, typ)
+	}
+	return clone(rs), nil
+}
+
+func (r *recordSets) list(zoneID string, match func(string) bool) []*dns.RecordSet {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var res []*dns.RecordSet
+	for _, rs := range r.zones[zoneID] {
+		if match(rs.GetName()) {
+			res = append(res, clone(rs))
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].GetName() != res[j].GetName() {
+			return res[i].GetName() < res[j].GetName()
+		}
+		return res[i].GetType() < res[j].GetType()
+	})
+	return res
+}
+
+// apply runs fn on a copy of the zone record sets and stores the copy only if fn succeeds.
+func (r *recordSets) apply(zoneID string, fn func(map[recordSetKey]*dns.RecordSet, *dns.RecordSetDiff) error) (*dns.RecordSetDiff, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sets := make(map[recordSetKey]*dns.RecordSet, len(r.zones[zoneID]))
+	for key, rs := range r.zones[zoneID] {
+		sets[key] = rs
+	}
+
+	diff := &dns.RecordSetDiff{}
+	if err := fn(sets, diff); err != nil {
+		return nil, err
+	}
+	for key, rs := range sets {
+		sets[key] = clone(rs)
+	}
+	r.zones[zoneID] = sets
+	return diff, nil
+}
+
+func (r *recordSets) deleteZone(zoneID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.zones, zoneID)
+}
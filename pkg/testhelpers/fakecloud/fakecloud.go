@@ -0,0 +1,168 @@
+// Package fakecloud serves an in-memory subset of Yandex Cloud API on a local listener,
+// so CRUD, import and drift tests of the provider can run without credentials.
+//
+// The server implements endpoint discovery, operations and the core methods of
+// Compute disks with their access bindings and instances, VPC networks and subnets, IAM service accounts with their keys,
+// Resource Manager clouds and folders, DNS zones with their record sets and Lockbox secrets with their versions.
+// Other methods return codes.Unimplemented.
+// Operations are completed by the time they are returned.
+//
+// Point the provider at the server with its `endpoint` and `plaintext` settings, or with NewTestServer
+// which sets the environment variables read by the provider:
+//
+//	func TestFakeCloud_ComputeInstance(t *testing.T) {
+//		fakecloud.NewTestServer(t)
+//		resource.UnitTest(t, resource.TestCase{...})
+//	}
+//
+// Such tests still run the terraform binary, see TF_ACC_TERRAFORM_PATH of terraform-plugin-testing.
+package fakecloud
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/endpoint"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// CloudID is the ID of the cloud which exists on a started server.
+	CloudID = "b1gfakecloud00000001"
+	// FolderID is the ID of the folder which exists on a started server.
+	FolderID = "b1gfakefolder0000001"
+	// Zone is the default availability zone to be used in tests.
+	Zone = "ru-central1-a"
+	// Token is the IAM token accepted by the server. Any token is accepted, this one is not exchanged by the SDK.
+	Token = "t1.fakecloud.token"
+)
+
+// Server is an in-memory Yandex Cloud API.
+type Server struct {
+	listener net.Listener
+	grpc     *grpc.Server
+
+	operations      *collection[*operation.Operation]
+	clouds          *collection[*resourcemanager.Cloud]
+	folders         *collection[*resourcemanager.Folder]
+	serviceAccounts *collection[*iam.ServiceAccount]
+	keys            *collection[*iam.Key]
+	networks        *collection[*vpc.Network]
+	subnets         *collection[*vpc.Subnet]
+	disks           *collection[*compute.Disk]
+	instances       *collection[*compute.Instance]
+	dnsZones        *collection[*dns.DnsZone]
+	recordSets      *recordSets
+	accessBindings  *accessBindings
+	lockboxSecrets  *collection[*lockbox.Secret]
+	lockboxVersions *collection[*lockbox.Version]
+	lockboxPayloads *lockboxPayloads
+
+	failLockboxVersions atomic.Bool
+}
+
+// Start starts a server on a random local port. The server contains the cloud CloudID and the folder FolderID.
+func Start() (*Server, error) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen: %w", err)
+	}
+
+	s := &Server{
+		listener:        lis,
+		grpc:            grpc.NewServer(),
+		operations:      newCollection[*operation.Operation]("Operation", "fop"),
+		clouds:          newCollection[*resourcemanager.Cloud]("Cloud", "b1g"),
+		folders:         newCollection[*resourcemanager.Folder]("Folder", "b1g"),
+		serviceAccounts: newCollection[*iam.ServiceAccount]("Service account", "aje"),
+		keys:            newCollection[*iam.Key]("Key", "aje"),
+		networks:        newCollection[*vpc.Network]("Network", "enp"),
+		subnets:         newCollection[*vpc.Subnet]("Subnet", "e9b"),
+		disks:           newCollection[*compute.Disk]("Disk", "fhm"),
+		instances:       newCollection[*compute.Instance]("Instance", "fhm"),
+		dnsZones:        newCollection[*dns.DnsZone]("DNS zone", "dns"),
+		recordSets:      newRecordSets(),
+		accessBindings:  newAccessBindings(),
+		lockboxSecrets:  newCollection[*lockbox.Secret]("Secret", "e6q"),
+		lockboxVersions: newCollection[*lockbox.Version]("Version", "e6q"),
+		lockboxPayloads: newLockboxPayloads(),
+	}
+
+	s.clouds.insert(&resourcemanager.Cloud{
+		Id:        CloudID,
+		CreatedAt: timestamppb.Now(),
+		Name:      "fakecloud",
+	})
+	s.folders.insert(&resourcemanager.Folder{
+		Id:        FolderID,
+		CloudId:   CloudID,
+		CreatedAt: timestamppb.Now(),
+		Name:      "default",
+		Status:    resourcemanager.Folder_ACTIVE,
+	})
+
+	endpoint.RegisterApiEndpointServiceServer(s.grpc, &apiEndpointService{s: s})
+	operation.RegisterOperationServiceServer(s.grpc, &operationService{s: s})
+	resourcemanager.RegisterCloudServiceServer(s.grpc, &cloudService{s: s})
+	resourcemanager.RegisterFolderServiceServer(s.grpc, &folderService{s: s})
+	iam.RegisterIamTokenServiceServer(s.grpc, &iamTokenService{})
+	iam.RegisterYandexPassportUserAccountServiceServer(s.grpc, &userAccountService{})
+	iam.RegisterServiceAccountServiceServer(s.grpc, &serviceAccountService{s: s})
+	iam.RegisterKeyServiceServer(s.grpc, &keyService{s: s})
+	vpc.RegisterNetworkServiceServer(s.grpc, &networkService{s: s})
+	vpc.RegisterSubnetServiceServer(s.grpc, &subnetService{s: s})
+	compute.RegisterDiskServiceServer(s.grpc, &diskService{s: s})
+	compute.RegisterInstanceServiceServer(s.grpc, &instanceService{s: s})
+	dns.RegisterDnsZoneServiceServer(s.grpc, &dnsZoneService{s: s})
+	lockbox.RegisterSecretServiceServer(s.grpc, &lockboxSecretService{s: s})
+	lockbox.RegisterPayloadServiceServer(s.grpc, &lockboxPayloadService{s: s})
+
+	go s.grpc.Serve(lis)
+
+	return s, nil
+}
+
+// NewTestServer starts a server, stops it on the test cleanup and points the provider at it with environment variables.
+// It can't be used in parallel tests.
+func NewTestServer(t testing.TB) *Server {
+	t.Helper()
+
+	s, err := Start()
+	if err != nil {
+		t.Fatalf("failed to start fake cloud: %s", err)
+	}
+	t.Cleanup(s.Stop)
+
+	t.Setenv("YC_ENDPOINT", s.Endpoint())
+	t.Setenv("YC_PLAINTEXT", strconv.FormatBool(true))
+	t.Setenv("YC_TOKEN", Token)
+	t.Setenv("YC_SERVICE_ACCOUNT_KEY_FILE", "")
+	t.Setenv("YC_CLOUD_ID", CloudID)
+	t.Setenv("YC_FOLDER_ID", FolderID)
+	t.Setenv("YC_ZONE", Zone)
+	// Storage is not served, and its client fails to initialize with a custom CA bundle of AWS SDK.
+	t.Setenv("AWS_CA_BUNDLE", "")
+
+	return s
+}
+
+// Endpoint returns the address of the server to be used as the provider `endpoint`.
+func (s *Server) Endpoint() string {
+	return s.listener.Addr().String()
+}
+
+// Stop stops the server and drops its state.
+func (s *Server) Stop() {
+	s.grpc.Stop()
+}
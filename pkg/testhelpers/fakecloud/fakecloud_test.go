@@ -0,0 +1,485 @@
+package fakecloud_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"github.com/yandex-cloud/go-sdk/operation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers/fakecloud"
+)
+
+func newSDK(t *testing.T) *ycsdk.SDK {
+	t.Helper()
+
+	_, sdk := newServerAndSDK(t)
+	return sdk
+}
+
+func newServerAndSDK(t *testing.T) (*fakecloud.Server, *ycsdk.SDK) {
+	t.Helper()
+
+	s, err := fakecloud.Start()
+	require.NoError(t, err)
+	t.Cleanup(s.Stop)
+
+	sdk, err := ycsdk.Build(context.Background(), ycsdk.Config{
+		Endpoint:    s.Endpoint(),
+		Plaintext:   true,
+		Credentials: ycsdk.NewIAMTokenCredentials(fakecloud.Token),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sdk.Shutdown(context.Background()) })
+
+	return s, sdk
+}
+
+// waitFor returns a function which waits for a wrapped operation and fails the test on error.
+func waitFor(t *testing.T) func(*operation.Operation, error) *operation.Operation {
+	return func(op *operation.Operation, err error) *operation.Operation {
+		t.Helper()
+
+		require.NoError(t, err)
+		require.NoError(t, op.Wait(context.Background()))
+		return op
+	}
+}
+
+func requireCode(t *testing.T, code codes.Code, err error) {
+	t.Helper()
+
+	require.Error(t, err)
+	require.Equal(t, code, status.Code(err), err.Error())
+}
+
+func TestServer_VPC(t *testing.T) {
+	ctx := context.Background()
+	sdk := newSDK(t)
+	wait := waitFor(t)
+
+	op := wait(sdk.WrapOperation(sdk.VPC().Network().Create(ctx, &vpc.CreateNetworkRequest{
+		FolderId: fakecloud.FolderID,
+		Name:     "net",
+		Labels:   map[string]string{"env": "test"},
+	})))
+	md, err := op.Metadata()
+	require.NoError(t, err)
+	networkID := md.(*vpc.CreateNetworkMetadata).GetNetworkId()
+
+	network, err := sdk.VPC().Network().Get(ctx, &vpc.GetNetworkRequest{NetworkId: networkID})
+	require.NoError(t, err)
+	require.Equal(t, "net", network.GetName())
+	require.Equal(t, map[string]string{"env": "test"}, network.GetLabels())
+
+	op = wait(sdk.WrapOperation(sdk.VPC().Subnet().Create(ctx, &vpc.CreateSubnetRequest{
+		FolderId:     fakecloud.FolderID,
+		Name:         "subnet",
+		NetworkId:    networkID,
+		ZoneId:       fakecloud.Zone,
+		V4CidrBlocks: []string{"10.0.0.0/24"},
+	})))
+	resp, err := op.Response()
+	require.NoError(t, err)
+	subnetID := resp.(*vpc.Subnet).GetId()
+
+	_, err = sdk.VPC().Network().Delete(ctx, &vpc.DeleteNetworkRequest{NetworkId: networkID})
+	requireCode(t, codes.FailedPrecondition, err)
+
+	wait(sdk.WrapOperation(sdk.VPC().Network().Update(ctx, &vpc.UpdateNetworkRequest{
+		NetworkId:   networkID,
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+		Name:        "ignored",
+		Description: "updated",
+	})))
+	network, err = sdk.VPC().Network().Get(ctx, &vpc.GetNetworkRequest{NetworkId: networkID})
+	require.NoError(t, err)
+	require.Equal(t, "net", network.GetName())
+	require.Equal(t, "updated", network.GetDescription())
+
+	list, err := sdk.VPC().Subnet().List(ctx, &vpc.ListSubnetsRequest{FolderId: fakecloud.FolderID, Filter: `name = "subnet"`})
+	require.NoError(t, err)
+	require.Len(t, list.GetSubnets(), 1)
+
+	wait(sdk.WrapOperation(sdk.VPC().Subnet().Delete(ctx, &vpc.DeleteSubnetRequest{SubnetId: subnetID})))
+	wait(sdk.WrapOperation(sdk.VPC().Network().Delete(ctx, &vpc.DeleteNetworkRequest{NetworkId: networkID})))
+
+	_, err = sdk.VPC().Network().Get(ctx, &vpc.GetNetworkRequest{NetworkId: networkID})
+	requireCode(t, codes.NotFound, err)
+}
+
+func TestServer_ComputeDisk(t *testing.T) {
+	ctx := context.Background()
+	sdk := newSDK(t)
+	wait := waitFor(t)
+
+	op := wait(sdk.WrapOperation(sdk.Compute().Disk().Create(ctx, &compute.CreateDiskRequest{
+		FolderId: fakecloud.FolderID,
+		Name:     "disk",
+		ZoneId:   fakecloud.Zone,
+		Size:     1 << 30,
+		Source:   &compute.CreateDiskRequest_ImageId{ImageId: "fd8image"},
+	})))
+	resp, err := op.Response()
+	require.NoError(t, err)
+	disk := resp.(*compute.Disk)
+	require.Equal(t, "network-hdd", disk.GetTypeId())
+	require.Equal(t, "fd8image", disk.GetSourceImageId())
+	require.Equal(t, compute.Disk_READY, disk.GetStatus())
+
+	_, err = sdk.Compute().Disk().Update(ctx, &compute.UpdateDiskRequest{
+		DiskId:     disk.GetId(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"size"}},
+		Size:       1 << 20,
+	})
+	requireCode(t, codes.InvalidArgument, err)
+
+	wait(sdk.WrapOperation(sdk.Compute().Disk().Update(ctx, &compute.UpdateDiskRequest{
+		DiskId:     disk.GetId(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"size"}},
+		Size:       2 << 30,
+	})))
+	disk, err = sdk.Compute().Disk().Get(ctx, &compute.GetDiskRequest{DiskId: disk.GetId()})
+	require.NoError(t, err)
+	require.EqualValues(t, 2<<30, disk.GetSize())
+
+	wait(sdk.WrapOperation(sdk.Compute().Disk().Delete(ctx, &compute.DeleteDiskRequest{DiskId: disk.GetId()})))
+	_, err = sdk.Compute().Disk().Get(ctx, &compute.GetDiskRequest{DiskId: disk.GetId()})
+	requireCode(t, codes.NotFound, err)
+}
+
+func TestServer_AccessBindings(t *testing.T) {
+	ctx := context.Background()
+	sdk := newSDK(t)
+	wait := waitFor(t)
+
+	op := wait(sdk.WrapOperation(sdk.Compute().Disk().Create(ctx, &compute.CreateDiskRequest{
+		FolderId: fakecloud.FolderID,
+		ZoneId:   fakecloud.Zone,
+		Size:     1 << 30,
+	})))
+	resp, err := op.Response()
+	require.NoError(t, err)
+	diskID := resp.(*compute.Disk).GetId()
+
+	viewer := &access.AccessBinding{RoleId: "viewer", Subject: &access.Subject{Id: "allUsers", Type: "system"}}
+	editor := &access.AccessBinding{RoleId: "editor", Subject: &access.Subject{Id: "allUsers", Type: "system"}}
+
+	op = wait(sdk.WrapOperation(sdk.Compute().Disk().UpdateAccessBindings(ctx, &access.UpdateAccessBindingsRequest{
+		ResourceId: diskID,
+		AccessBindingDeltas: []*access.AccessBindingDelta{
+			{Action: access.AccessBindingAction_ADD, AccessBinding: viewer},
+			{Action: access.AccessBindingAction_ADD, AccessBinding: viewer},
+			{Action: access.AccessBindingAction_REMOVE, AccessBinding: editor},
+		},
+	})))
+	resp, err = op.Response()
+	require.NoError(t, err)
+	require.Len(t, resp.(*access.AccessBindingsOperationResult).GetEffectiveDeltas(), 1)
+
+	wait(sdk.WrapOperation(sdk.Compute().Disk().SetAccessBindings(ctx, &access.SetAccessBindingsRequest{
+		ResourceId:     diskID,
+		AccessBindings: []*access.AccessBinding{editor},
+	})))
+	list, err := sdk.Compute().Disk().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{ResourceId: diskID})
+	require.NoError(t, err)
+	require.Len(t, list.GetAccessBindings(), 1)
+	require.Equal(t, "editor", list.GetAccessBindings()[0].GetRoleId())
+
+	_, err = sdk.Compute().Disk().ListAccessBindings(ctx, &access.ListAccessBindingsRequest{ResourceId: "fhmmissing"})
+	requireCode(t, codes.NotFound, err)
+}
+
+func TestServer_ComputeInstance(t *testing.T) {
+	ctx := context.Background()
+	sdk := newSDK(t)
+	wait := waitFor(t)
+
+	op := wait(sdk.WrapOperation(sdk.VPC().Network().Create(ctx, &vpc.CreateNetworkRequest{FolderId: fakecloud.FolderID})))
+	resp, err := op.Response()
+	require.NoError(t, err)
+	op = wait(sdk.WrapOperation(sdk.VPC().Subnet().Create(ctx, &vpc.CreateSubnetRequest{
+		FolderId:     fakecloud.FolderID,
+		NetworkId:    resp.(*vpc.Network).GetId(),
+		ZoneId:       fakecloud.Zone,
+		V4CidrBlocks: []string{"10.0.0.0/24"},
+	})))
+	resp, err = op.Response()
+	require.NoError(t, err)
+	subnetID := resp.(*vpc.Subnet).GetId()
+
+	op = wait(sdk.WrapOperation(sdk.Compute().Disk().Create(ctx, &compute.CreateDiskRequest{
+		FolderId: fakecloud.FolderID,
+		ZoneId:   fakecloud.Zone,
+		Size:     1 << 30,
+	})))
+	resp, err = op.Response()
+	require.NoError(t, err)
+	dataDiskID := resp.(*compute.Disk).GetId()
+
+	create := func() (*compute.Instance, error) {
+		op, err := sdk.WrapOperation(sdk.Compute().Instance().Create(ctx, &compute.CreateInstanceRequest{
+			FolderId:      fakecloud.FolderID,
+			Name:          "vm",
+			ZoneId:        fakecloud.Zone,
+			ResourcesSpec: &compute.ResourcesSpec{Cores: 2, Memory: 2 << 30},
+			BootDiskSpec: &compute.AttachedDiskSpec{
+				AutoDelete: true,
+				Disk: &compute.AttachedDiskSpec_DiskSpec_{DiskSpec: &compute.AttachedDiskSpec_DiskSpec{
+					Source: &compute.AttachedDiskSpec_DiskSpec_ImageId{ImageId: "fd8image"},
+				}},
+			},
+			SecondaryDiskSpecs:    []*compute.AttachedDiskSpec{{Disk: &compute.AttachedDiskSpec_DiskId{DiskId: dataDiskID}}},
+			NetworkInterfaceSpecs: []*compute.NetworkInterfaceSpec{{SubnetId: subnetID, PrimaryV4AddressSpec: &compute.PrimaryAddressSpec{}}},
+		}))
+		if err != nil {
+			return nil, err
+		}
+		if err := op.Wait(ctx); err != nil {
+			return nil, err
+		}
+		resp, err := op.Response()
+		if err != nil {
+			return nil, err
+		}
+		return resp.(*compute.Instance), nil
+	}
+
+	instance, err := create()
+	require.NoError(t, err)
+	require.Equal(t, compute.Instance_RUNNING, instance.GetStatus())
+	require.EqualValues(t, 100, instance.GetResources().GetCoreFraction())
+	require.Equal(t, "10.0.0.3", instance.GetNetworkInterfaces()[0].GetPrimaryV4Address().GetAddress())
+	bootDiskID := instance.GetBootDisk().GetDiskId()
+	bootDisk, err := sdk.Compute().Disk().Get(ctx, &compute.GetDiskRequest{DiskId: bootDiskID})
+	require.NoError(t, err)
+	require.Equal(t, []string{instance.GetId()}, bootDisk.GetInstanceIds())
+	require.Equal(t, "fd8image", bootDisk.GetSourceImageId())
+
+	_, err = create()
+	requireCode(t, codes.FailedPrecondition, err)
+	_, err = sdk.Compute().Disk().Delete(ctx, &compute.DeleteDiskRequest{DiskId: dataDiskID})
+	requireCode(t, codes.FailedPrecondition, err)
+	_, err = sdk.VPC().Subnet().Delete(ctx, &vpc.DeleteSubnetRequest{SubnetId: subnetID})
+	requireCode(t, codes.FailedPrecondition, err)
+
+	wait(sdk.WrapOperation(sdk.Compute().Instance().Stop(ctx, &compute.StopInstanceRequest{InstanceId: instance.GetId()})))
+	wait(sdk.WrapOperation(sdk.Compute().Instance().Update(ctx, &compute.UpdateInstanceRequest{
+		InstanceId:    instance.GetId(),
+		UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"resources_spec", "labels"}},
+		ResourcesSpec: &compute.ResourcesSpec{Cores: 4, Memory: 4 << 30, CoreFraction: 20},
+		Labels:        map[string]string{"env": "test"},
+	})))
+	instance, err = sdk.Compute().Instance().Get(ctx, &compute.GetInstanceRequest{InstanceId: instance.GetId()})
+	require.NoError(t, err)
+	require.Equal(t, compute.Instance_STOPPED, instance.GetStatus())
+	require.EqualValues(t, 4, instance.GetResources().GetCores())
+	require.EqualValues(t, 20, instance.GetResources().GetCoreFraction())
+	require.Equal(t, map[string]string{"env": "test"}, instance.GetLabels())
+
+	wait(sdk.WrapOperation(sdk.Compute().Instance().Delete(ctx, &compute.DeleteInstanceRequest{InstanceId: instance.GetId()})))
+	_, err = sdk.Compute().Disk().Get(ctx, &compute.GetDiskRequest{DiskId: bootDiskID})
+	requireCode(t, codes.NotFound, err)
+	dataDisk, err := sdk.Compute().Disk().Get(ctx, &compute.GetDiskRequest{DiskId: dataDiskID})
+	require.NoError(t, err)
+	require.Empty(t, dataDisk.GetInstanceIds())
+}
+
+func TestServer_FolderDeletesResources(t *testing.T) {
+	ctx := context.Background()
+	sdk := newSDK(t)
+	wait := waitFor(t)
+
+	op := wait(sdk.WrapOperation(sdk.ResourceManager().Folder().Create(ctx, &resourcemanager.CreateFolderRequest{
+		CloudId: fakecloud.CloudID,
+		Name:    "folder",
+	})))
+	md, err := op.Metadata()
+	require.NoError(t, err)
+	folderID := md.(*resourcemanager.CreateFolderMetadata).GetFolderId()
+
+	op = wait(sdk.WrapOperation(sdk.IAM().ServiceAccount().Create(ctx, &iam.CreateServiceAccountRequest{
+		FolderId: folderID,
+		Name:     "sa",
+	})))
+	md, err = op.Metadata()
+	require.NoError(t, err)
+	accountID := md.(*iam.CreateServiceAccountMetadata).GetServiceAccountId()
+
+	_, err = sdk.IAM().ServiceAccount().Create(ctx, &iam.CreateServiceAccountRequest{FolderId: "b1gunknown", Name: "sa"})
+	requireCode(t, codes.NotFound, err)
+
+	wait(sdk.WrapOperation(sdk.ResourceManager().Folder().Delete(ctx, &resourcemanager.DeleteFolderRequest{FolderId: folderID})))
+
+	_, err = sdk.IAM().ServiceAccount().Get(ctx, &iam.GetServiceAccountRequest{ServiceAccountId: accountID})
+	requireCode(t, codes.NotFound, err)
+}
+
+func TestServer_DNS(t *testing.T) {
+	ctx := context.Background()
+	sdk := newSDK(t)
+	wait := waitFor(t)
+
+	op := wait(sdk.WrapOperation(sdk.DNS().DnsZone().Create(ctx, &dns.CreateDnsZoneRequest{
+		FolderId:           fakecloud.FolderID,
+		Name:               "zone",
+		Zone:               "example.com.",
+		DeletionProtection: true,
+	})))
+	md, err := op.Metadata()
+	require.NoError(t, err)
+	zoneID := md.(*dns.CreateDnsZoneMetadata).GetDnsZoneId()
+
+	record := &dns.RecordSet{Name: "www.example.com.", Type: "A", Ttl: 300, Data: []string{"10.0.0.1"}}
+	wait(sdk.WrapOperation(sdk.DNS().DnsZone().UpdateRecordSets(ctx, &dns.UpdateRecordSetsRequest{
+		DnsZoneId: zoneID,
+		Additions: []*dns.RecordSet{record},
+	})))
+
+	_, err = sdk.DNS().DnsZone().UpdateRecordSets(ctx, &dns.UpdateRecordSetsRequest{
+		DnsZoneId: zoneID,
+		Additions: []*dns.RecordSet{record},
+	})
+	requireCode(t, codes.AlreadyExists, err)
+
+	wait(sdk.WrapOperation(sdk.DNS().DnsZone().UpsertRecordSets(ctx, &dns.UpsertRecordSetsRequest{
+		DnsZoneId: zoneID,
+		Merges:    []*dns.RecordSet{{Name: "www.example.com.", Type: "A", Ttl: 600, Data: []string{"10.0.0.2"}}},
+	})))
+	rs, err := sdk.DNS().DnsZone().GetRecordSet(ctx, &dns.GetDnsZoneRecordSetRequest{DnsZoneId: zoneID, Name: "www.example.com.", Type: "A"})
+	require.NoError(t, err)
+	require.EqualValues(t, 600, rs.GetTtl())
+	require.Equal(t, []string{"10.0.0.1", "10.0.0.2"}, rs.GetData())
+
+	wait(sdk.WrapOperation(sdk.DNS().DnsZone().UpdateRecordSets(ctx, &dns.UpdateRecordSetsRequest{
+		DnsZoneId: zoneID,
+		Deletions: []*dns.RecordSet{rs},
+	})))
+	_, err = sdk.DNS().DnsZone().GetRecordSet(ctx, &dns.GetDnsZoneRecordSetRequest{DnsZoneId: zoneID, Name: "www.example.com.", Type: "A"})
+	requireCode(t, codes.NotFound, err)
+
+	_, err = sdk.DNS().DnsZone().Delete(ctx, &dns.DeleteDnsZoneRequest{DnsZoneId: zoneID})
+	requireCode(t, codes.FailedPrecondition, err)
+
+	wait(sdk.WrapOperation(sdk.DNS().DnsZone().Update(ctx, &dns.UpdateDnsZoneRequest{
+		DnsZoneId:  zoneID,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"deletion_protection"}},
+	})))
+	wait(sdk.WrapOperation(sdk.DNS().DnsZone().Delete(ctx, &dns.DeleteDnsZoneRequest{DnsZoneId: zoneID})))
+}
+
+func TestServer_Lockbox(t *testing.T) {
+	ctx := context.Background()
+	s, sdk := newServerAndSDK(t)
+	wait := waitFor(t)
+
+	op := wait(sdk.WrapOperation(sdk.LockboxSecret().Secret().Create(ctx, &lockbox.CreateSecretRequest{
+		FolderId: fakecloud.FolderID,
+		Name:     "secret",
+		VersionPayloadEntries: []*lockbox.PayloadEntryChange{
+			{Key: "user", Value: &lockbox.PayloadEntryChange_TextValue{TextValue: "admin"}},
+			{Key: "password", Value: &lockbox.PayloadEntryChange_TextValue{TextValue: "first"}},
+		},
+	})))
+	md, err := op.Metadata()
+	require.NoError(t, err)
+	secretID := md.(*lockbox.CreateSecretMetadata).GetSecretId()
+
+	secret, err := sdk.LockboxSecret().Secret().Get(ctx, &lockbox.GetSecretRequest{SecretId: secretID})
+	require.NoError(t, err)
+	firstID := secret.GetCurrentVersion().GetId()
+	require.NotEmpty(t, firstID)
+
+	op = wait(sdk.WrapOperation(sdk.LockboxSecret().Secret().AddVersion(ctx, &lockbox.AddVersionRequest{
+		SecretId:       secretID,
+		BaseVersionId:  firstID,
+		PayloadEntries: []*lockbox.PayloadEntryChange{{Key: "user"}, {Key: "password", Value: &lockbox.PayloadEntryChange_TextValue{TextValue: "second"}}},
+	})))
+	md, err = op.Metadata()
+	require.NoError(t, err)
+	secondID := md.(*lockbox.AddVersionMetadata).GetVersionId()
+
+	payload, err := sdk.LockboxPayload().Payload().Get(ctx, &lockbox.GetPayloadRequest{SecretId: secretID})
+	require.NoError(t, err)
+	require.Equal(t, secondID, payload.GetVersionId())
+	require.Len(t, payload.GetEntries(), 1)
+	require.Equal(t, "password", payload.GetEntries()[0].GetKey())
+	require.Equal(t, "second", payload.GetEntries()[0].GetTextValue())
+
+	s.FailLockboxVersionOperations(true)
+	op, err = sdk.WrapOperation(sdk.LockboxSecret().Secret().AddVersion(ctx, &lockbox.AddVersionRequest{SecretId: secretID}))
+	require.NoError(t, err)
+	requireCode(t, codes.Internal, op.Wait(ctx))
+	md, err = op.Metadata()
+	require.NoError(t, err)
+	failedID := md.(*lockbox.AddVersionMetadata).GetVersionId()
+	s.FailLockboxVersionOperations(false)
+
+	wait(sdk.WrapOperation(sdk.LockboxSecret().Secret().ScheduleVersionDestruction(ctx, &lockbox.ScheduleVersionDestructionRequest{
+		SecretId:  secretID,
+		VersionId: firstID,
+	})))
+	_, err = sdk.LockboxSecret().Secret().ScheduleVersionDestruction(ctx, &lockbox.ScheduleVersionDestructionRequest{
+		SecretId:  secretID,
+		VersionId: firstID,
+	})
+	requireCode(t, codes.FailedPrecondition, err)
+
+	versions, err := sdk.LockboxSecret().Secret().ListVersions(ctx, &lockbox.ListVersionsRequest{SecretId: secretID})
+	require.NoError(t, err)
+	statuses := make(map[string]lockbox.Version_Status)
+	for _, v := range versions.GetVersions() {
+		statuses[v.GetId()] = v.GetStatus()
+	}
+	require.Equal(t, map[string]lockbox.Version_Status{
+		firstID:  lockbox.Version_SCHEDULED_FOR_DESTRUCTION,
+		secondID: lockbox.Version_ACTIVE,
+		failedID: lockbox.Version_ACTIVE,
+	}, statuses)
+
+	wait(sdk.WrapOperation(sdk.LockboxSecret().Secret().Delete(ctx, &lockbox.DeleteSecretRequest{SecretId: secretID})))
+	_, err = sdk.LockboxSecret().Secret().ListVersions(ctx, &lockbox.ListVersionsRequest{SecretId: secretID})
+	requireCode(t, codes.NotFound, err)
+}
+
+func TestServer_IAMKeys(t *testing.T) {
+	ctx := context.Background()
+	sdk := newSDK(t)
+	wait := waitFor(t)
+
+	op := wait(sdk.WrapOperation(sdk.IAM().ServiceAccount().Create(ctx, &iam.CreateServiceAccountRequest{
+		FolderId: fakecloud.FolderID,
+		Name:     "sa",
+	})))
+	md, err := op.Metadata()
+	require.NoError(t, err)
+	saID := md.(*iam.CreateServiceAccountMetadata).GetServiceAccountId()
+
+	resp, err := sdk.IAM().Key().Create(ctx, &iam.CreateKeyRequest{ServiceAccountId: saID, Description: "key"})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.GetPrivateKey())
+	require.Equal(t, iam.Key_RSA_2048, resp.GetKey().GetKeyAlgorithm())
+
+	key, err := sdk.IAM().Key().Get(ctx, &iam.GetKeyRequest{KeyId: resp.GetKey().GetId()})
+	require.NoError(t, err)
+	require.Equal(t, saID, key.GetServiceAccountId())
+	require.Equal(t, resp.GetKey().GetPublicKey(), key.GetPublicKey())
+
+	_, err = sdk.IAM().Key().Create(ctx, &iam.CreateKeyRequest{ServiceAccountId: "ajenotfound"})
+	requireCode(t, codes.NotFound, err)
+
+	wait(sdk.WrapOperation(sdk.IAM().ServiceAccount().Delete(ctx, &iam.DeleteServiceAccountRequest{ServiceAccountId: saID})))
+	_, err = sdk.IAM().Key().Get(ctx, &iam.GetKeyRequest{KeyId: key.GetId()})
+	requireCode(t, codes.NotFound, err)
+}
@@ -0,0 +1,268 @@
+package fakecloud
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultPendingPeriod is the time after which a version scheduled for destruction is destroyed by the cloud.
+const defaultPendingPeriod = 7 * 24 * time.Hour
+
+// FailLockboxVersionOperations makes operations of AddVersion end with an error. The versions are still added,
+// as it happens when an operation fails or waiting for it is interrupted after the version is created.
+func (s *Server) FailLockboxVersionOperations(fail bool) {
+	s.failLockboxVersions.Store(fail)
+}
+
+// lockboxPayloads stores the entries of Lockbox versions by version ID.
+type lockboxPayloads struct {
+	mu      sync.Mutex
+	entries map[string][]*lockbox.Payload_Entry
+}
+
+func newLockboxPayloads() *lockboxPayloads {
+	return &lockboxPayloads{entries: make(map[string][]*lockbox.Payload_Entry)}
+}
+
+func (p *lockboxPayloads) get(versionID string) []*lockbox.Payload_Entry {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var result []*lockbox.Payload_Entry
+	for _, e := range p.entries[versionID] {
+		result = append(result, clone(e))
+	}
+	return result
+}
+
+// add stores the entries of the base version with the changes applied. A change without a value removes the entry.
+func (p *lockboxPayloads) add(versionID, baseVersionID string, changes []*lockbox.PayloadEntryChange) []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var keys []string
+	entries := make(map[string]*lockbox.Payload_Entry)
+	for _, e := range p.entries[baseVersionID] {
+		keys = append(keys, e.GetKey())
+		entries[e.GetKey()] = e
+	}
+	for _, c := range changes {
+		if _, ok := entries[c.GetKey()]; !ok {
+			keys = append(keys, c.GetKey())
+		}
+		switch v := c.GetValue().(type) {
+		case *lockbox.PayloadEntryChange_TextValue:
+			entries[c.GetKey()] = &lockbox.Payload_Entry{Key: c.GetKey(), Value: &lockbox.Payload_Entry_TextValue{TextValue: v.TextValue}}
+		case *lockbox.PayloadEntryChange_BinaryValue:
+			entries[c.GetKey()] = &lockbox.Payload_Entry{Key: c.GetKey(), Value: &lockbox.Payload_Entry_BinaryValue{BinaryValue: v.BinaryValue}}
+		default:
+			delete(entries, c.GetKey())
+		}
+	}
+
+	var result []*lockbox.Payload_Entry
+	var resultKeys []string
+	for _, key := range keys {
+		if e, ok := entries[key]; ok {
+			result = append(result, clone(e))
+			resultKeys = append(resultKeys, key)
+		}
+	}
+	p.entries[versionID] = result
+	return resultKeys
+}
+
+func (p *lockboxPayloads) delete(versionID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.entries, versionID)
+}
+
+type lockboxSecretService struct {
+	lockbox.UnimplementedSecretServiceServer
+	s *Server
+}
+
+func (l *lockboxSecretService) Get(_ context.Context, req *lockbox.GetSecretRequest) (*lockbox.Secret, error) {
+	return l.s.lockboxSecrets.get(req.GetSecretId())
+}
+
+func (l *lockboxSecretService) Create(_ context.Context, req *lockbox.CreateSecretRequest) (*operation.Operation, error) {
+	if err := l.s.checkFolder(req.GetFolderId()); err != nil {
+		return nil, err
+	}
+	if req.GetPayloadSpecification() != nil {
+		return nil, status.Error(codes.Unimplemented, "password_payload_specification is not supported")
+	}
+
+	secret := &lockbox.Secret{
+		Id:                 l.s.lockboxSecrets.newID(),
+		FolderId:           req.GetFolderId(),
+		CreatedAt:          timestamppb.Now(),
+		Name:               req.GetName(),
+		Description:        req.GetDescription(),
+		Labels:             req.GetLabels(),
+		KmsKeyId:           req.GetKmsKeyId(),
+		Status:             lockbox.Secret_ACTIVE,
+		DeletionProtection: req.GetDeletionProtection(),
+	}
+	l.s.lockboxSecrets.insert(secret)
+
+	if req.GetCreateVersion().GetValue() || len(req.GetVersionPayloadEntries()) > 0 {
+		if _, err := l.s.addLockboxVersion(secret.Id, "", "", req.GetVersionPayloadEntries()); err != nil {
+			return nil, err
+		}
+		secret, _ = l.s.lockboxSecrets.get(secret.Id)
+	}
+
+	return l.s.done("Create secret", &lockbox.CreateSecretMetadata{SecretId: secret.Id}, secret)
+}
+
+func (l *lockboxSecretService) Delete(_ context.Context, req *lockbox.DeleteSecretRequest) (*operation.Operation, error) {
+	secret, err := l.s.lockboxSecrets.get(req.GetSecretId())
+	if err != nil {
+		return nil, err
+	}
+	if secret.GetDeletionProtection() {
+		return nil, status.Errorf(codes.FailedPrecondition, "Secret %s is protected from deletion", secret.GetId())
+	}
+	l.s.deleteLockboxSecret(secret.GetId())
+
+	return l.s.done("Delete secret", &lockbox.DeleteSecretMetadata{SecretId: secret.GetId()}, secret)
+}
+
+func (l *lockboxSecretService) ListVersions(_ context.Context, req *lockbox.ListVersionsRequest) (*lockbox.ListVersionsResponse, error) {
+	if _, err := l.s.lockboxSecrets.get(req.GetSecretId()); err != nil {
+		return nil, err
+	}
+
+	return &lockbox.ListVersionsResponse{
+		Versions: l.s.lockboxSecretVersions(req.GetSecretId()),
+	}, nil
+}
+
+func (l *lockboxSecretService) AddVersion(_ context.Context, req *lockbox.AddVersionRequest) (*operation.Operation, error) {
+	version, err := l.s.addLockboxVersion(req.GetSecretId(), req.GetBaseVersionId(), req.GetDescription(), req.GetPayloadEntries())
+	if err != nil {
+		return nil, err
+	}
+
+	md := &lockbox.AddVersionMetadata{SecretId: version.GetSecretId(), VersionId: version.GetId()}
+	if l.s.failLockboxVersions.Load() {
+		return l.s.failed("Add version", md, status.New(codes.Internal, "version is added, but the operation failed"))
+	}
+	return l.s.done("Add version", md, version)
+}
+
+func (l *lockboxSecretService) ScheduleVersionDestruction(_ context.Context, req *lockbox.ScheduleVersionDestructionRequest) (*operation.Operation, error) {
+	secret, err := l.s.lockboxSecrets.get(req.GetSecretId())
+	if err != nil {
+		return nil, err
+	}
+
+	pendingPeriod := defaultPendingPeriod
+	if req.GetPendingPeriod() != nil {
+		pendingPeriod = req.GetPendingPeriod().AsDuration()
+	}
+	destroyAt := timestamppb.New(time.Now().Add(pendingPeriod))
+
+	version, err := l.s.lockboxVersions.update(req.GetVersionId(), func(version *lockbox.Version) error {
+		if version.GetSecretId() != secret.GetId() {
+			return l.s.lockboxVersions.notFound(req.GetVersionId())
+		}
+		if version.GetStatus() != lockbox.Version_ACTIVE {
+			return status.Errorf(codes.FailedPrecondition, "Version %s is not active", version.GetId())
+		}
+		version.Status = lockbox.Version_SCHEDULED_FOR_DESTRUCTION
+		version.DestroyAt = destroyAt
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	md := &lockbox.ScheduleVersionDestructionMetadata{SecretId: secret.GetId(), VersionId: version.GetId(), DestroyAt: destroyAt}
+	return l.s.done("Schedule version destruction", md, version)
+}
+
+// addLockboxVersion adds a version built from the base version, or the current one if the base is not set,
+// and makes it current.
+func (s *Server) addLockboxVersion(secretID, baseVersionID, description string, changes []*lockbox.PayloadEntryChange) (*lockbox.Version, error) {
+	secret, err := s.lockboxSecrets.get(secretID)
+	if err != nil {
+		return nil, err
+	}
+	if baseVersionID == "" {
+		baseVersionID = secret.GetCurrentVersion().GetId()
+	} else if base, err := s.lockboxVersions.get(baseVersionID); err != nil || base.GetSecretId() != secretID {
+		return nil, status.Errorf(codes.InvalidArgument, "Base version %s is not found in secret %s", baseVersionID, secretID)
+	}
+
+	version := &lockbox.Version{
+		Id:          s.lockboxVersions.newID(),
+		SecretId:    secretID,
+		CreatedAt:   timestamppb.Now(),
+		Description: description,
+		Status:      lockbox.Version_ACTIVE,
+	}
+	version.PayloadEntryKeys = s.lockboxPayloads.add(version.Id, baseVersionID, changes)
+	s.lockboxVersions.insert(version)
+
+	if _, err := s.lockboxSecrets.update(secretID, func(secret *lockbox.Secret) error {
+		secret.CurrentVersion = clone(version)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return version, nil
+}
+
+func (s *Server) lockboxSecretVersions(secretID string) []*lockbox.Version {
+	return s.lockboxVersions.list(func(version *lockbox.Version) bool {
+		return version.GetSecretId() == secretID
+	})
+}
+
+func (s *Server) deleteLockboxSecret(secretID string) {
+	for _, version := range s.lockboxSecretVersions(secretID) {
+		s.lockboxVersions.delete(version.GetId())
+		s.lockboxPayloads.delete(version.GetId())
+	}
+	s.lockboxSecrets.delete(secretID)
+}
+
+type lockboxPayloadService struct {
+	lockbox.UnimplementedPayloadServiceServer
+	s *Server
+}
+
+// Get returns the payload of the version, or of the current one if the version is not set.
+// Versions scheduled for destruction keep their payload until they are destroyed.
+func (l *lockboxPayloadService) Get(_ context.Context, req *lockbox.GetPayloadRequest) (*lockbox.Payload, error) {
+	secret, err := l.s.lockboxSecrets.get(req.GetSecretId())
+	if err != nil {
+		return nil, err
+	}
+
+	versionID := req.GetVersionId()
+	if versionID == "" {
+		versionID = secret.GetCurrentVersion().GetId()
+	}
+	version, err := l.s.lockboxVersions.get(versionID)
+	if err != nil || version.GetSecretId() != secret.GetId() {
+		return nil, status.Errorf(codes.NotFound, "Version %s of secret %s not found", versionID, secret.GetId())
+	}
+
+	return &lockbox.Payload{
+		VersionId: version.GetId(),
+		Entries:   l.s.lockboxPayloads.get(version.GetId()),
+	}, nil
+}
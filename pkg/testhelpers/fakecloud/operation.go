@@ -0,0 +1,116 @@
+package fakecloud
+
+import (
+	"context"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/endpoint"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// endpointIDs lists services discovered by the SDK. All of them are served by the same listener,
+// the ones without implementation return codes.Unimplemented.
+var endpointIDs = []string{
+	"endpoint",
+	"operation",
+	"compute",
+	"vpc",
+	"iam",
+	"resource-manager",
+	"dns",
+	"lockbox",
+	"lockbox-payload",
+}
+
+type apiEndpointService struct {
+	endpoint.UnimplementedApiEndpointServiceServer
+	s *Server
+}
+
+func (e *apiEndpointService) Get(_ context.Context, req *endpoint.GetApiEndpointRequest) (*endpoint.ApiEndpoint, error) {
+	for _, id := range endpointIDs {
+		if id == req.GetApiEndpointId() {
+			return &endpoint.ApiEndpoint{Id: id, Address: e.s.Endpoint()}, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "API endpoint %s not found", req.GetApiEndpointId())
+}
+
+func (e *apiEndpointService) List(context.Context, *endpoint.ListApiEndpointsRequest) (*endpoint.ListApiEndpointsResponse, error) {
+	resp := &endpoint.ListApiEndpointsResponse{}
+	for _, id := range endpointIDs {
+		resp.Endpoints = append(resp.Endpoints, &endpoint.ApiEndpoint{Id: id, Address: e.s.Endpoint()})
+	}
+	return resp, nil
+}
+
+type operationService struct {
+	operation.UnimplementedOperationServiceServer
+	s *Server
+}
+
+func (o *operationService) Get(_ context.Context, req *operation.GetOperationRequest) (*operation.Operation, error) {
+	return o.s.operations.get(req.GetOperationId())
+}
+
+func (o *operationService) Cancel(_ context.Context, req *operation.CancelOperationRequest) (*operation.Operation, error) {
+	// Operations are done by the time they are returned, so there is nothing to cancel.
+	return o.s.operations.get(req.GetOperationId())
+}
+
+// done returns a completed operation with the given metadata and response, nil response means google.protobuf.Empty.
+func (s *Server) done(description string, metadata, response proto.Message) (*operation.Operation, error) {
+	if response == nil {
+		response = &emptypb.Empty{}
+	}
+
+	md, err := anypb.New(metadata)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to pack operation metadata: %s", err)
+	}
+	resp, err := anypb.New(response)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to pack operation response: %s", err)
+	}
+
+	now := timestamppb.Now()
+	op := &operation.Operation{
+		Id:          s.operations.newID(),
+		Description: description,
+		CreatedAt:   now,
+		CreatedBy:   "fakecloud",
+		ModifiedAt:  now,
+		Done:        true,
+		Metadata:    md,
+		Result:      &operation.Operation_Response{Response: resp},
+	}
+	s.operations.insert(op)
+	return op, nil
+}
+
+// failed returns a completed operation with the given metadata which ended with the error.
+func (s *Server) failed(description string, metadata proto.Message, st *status.Status) (*operation.Operation, error) {
+	md, err := anypb.New(metadata)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to pack operation metadata: %s", err)
+	}
+
+	now := timestamppb.Now()
+	op := &operation.Operation{
+		Id:          s.operations.newID(),
+		Description: description,
+		CreatedAt:   now,
+		CreatedBy:   "fakecloud",
+		ModifiedAt:  now,
+		Done:        true,
+		Metadata:    md,
+		Result:      &operation.Operation_Error{Error: st.Proto()},
+	}
+	s.operations.insert(op)
+	return op, nil
+}
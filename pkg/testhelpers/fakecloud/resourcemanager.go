@@ -0,0 +1,146 @@
+package fakecloud
+
+import (
+	"context"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type cloudService struct {
+	resourcemanager.UnimplementedCloudServiceServer
+	s *Server
+}
+
+func (c *cloudService) Get(_ context.Context, req *resourcemanager.GetCloudRequest) (*resourcemanager.Cloud, error) {
+	return c.s.clouds.get(req.GetCloudId())
+}
+
+func (c *cloudService) List(_ context.Context, req *resourcemanager.ListCloudsRequest) (*resourcemanager.ListCloudsResponse, error) {
+	match, err := nameFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	return &resourcemanager.ListCloudsResponse{
+		Clouds: c.s.clouds.list(func(cloud *resourcemanager.Cloud) bool {
+			return match(cloud.GetName())
+		}),
+	}, nil
+}
+
+type folderService struct {
+	resourcemanager.UnimplementedFolderServiceServer
+	s *Server
+}
+
+// checkFolder checks that the folder of a new resource exists.
+func (s *Server) checkFolder(folderID string) error {
+	_, err := s.folders.get(folderID)
+	return err
+}
+
+func (f *folderService) Get(_ context.Context, req *resourcemanager.GetFolderRequest) (*resourcemanager.Folder, error) {
+	return f.s.folders.get(req.GetFolderId())
+}
+
+func (f *folderService) List(_ context.Context, req *resourcemanager.ListFoldersRequest) (*resourcemanager.ListFoldersResponse, error) {
+	match, err := nameFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	return &resourcemanager.ListFoldersResponse{
+		Folders: f.s.folders.list(func(folder *resourcemanager.Folder) bool {
+			return folder.GetCloudId() == req.GetCloudId() && match(folder.GetName())
+		}),
+	}, nil
+}
+
+func (f *folderService) Create(_ context.Context, req *resourcemanager.CreateFolderRequest) (*operation.Operation, error) {
+	if _, err := f.s.clouds.get(req.GetCloudId()); err != nil {
+		return nil, err
+	}
+
+	folder := &resourcemanager.Folder{
+		Id:          f.s.folders.newID(),
+		CloudId:     req.GetCloudId(),
+		CreatedAt:   timestamppb.Now(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Labels:      req.GetLabels(),
+		Status:      resourcemanager.Folder_ACTIVE,
+	}
+	f.s.folders.insert(folder)
+
+	return f.s.done("Create folder", &resourcemanager.CreateFolderMetadata{FolderId: folder.Id}, folder)
+}
+
+func (f *folderService) Update(_ context.Context, req *resourcemanager.UpdateFolderRequest) (*operation.Operation, error) {
+	folder, err := f.s.folders.update(req.GetFolderId(), func(folder *resourcemanager.Folder) error {
+		return applyUpdateMask(folder, req, req.GetUpdateMask())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return f.s.done("Update folder", &resourcemanager.UpdateFolderMetadata{FolderId: folder.Id}, folder)
+}
+
+// Delete deletes the folder with all its resources. A folder with `delete_after` is only marked as pending deletion.
+func (f *folderService) Delete(_ context.Context, req *resourcemanager.DeleteFolderRequest) (*operation.Operation, error) {
+	md := &resourcemanager.DeleteFolderMetadata{
+		FolderId:    req.GetFolderId(),
+		DeleteAfter: req.GetDeleteAfter(),
+	}
+
+	if req.GetDeleteAfter() != nil {
+		_, err := f.s.folders.update(req.GetFolderId(), func(folder *resourcemanager.Folder) error {
+			folder.Status = resourcemanager.Folder_PENDING_DELETION
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return f.s.done("Delete folder", md, nil)
+	}
+
+	if _, err := f.s.folders.delete(req.GetFolderId()); err != nil {
+		return nil, err
+	}
+	f.s.deleteFolderResources(req.GetFolderId())
+
+	return f.s.done("Delete folder", md, nil)
+}
+
+func (s *Server) deleteFolderResources(folderID string) {
+	for _, instance := range s.instances.list(func(instance *compute.Instance) bool { return instance.GetFolderId() == folderID }) {
+		s.instances.delete(instance.GetId())
+		s.detachDisks(instance)
+	}
+	for _, sa := range s.serviceAccounts.list(func(sa *iam.ServiceAccount) bool { return sa.GetFolderId() == folderID }) {
+		s.deleteServiceAccount(sa.GetId())
+	}
+	for _, subnet := range s.subnets.list(func(subnet *vpc.Subnet) bool { return subnet.GetFolderId() == folderID }) {
+		s.subnets.delete(subnet.GetId())
+	}
+	for _, network := range s.networks.list(func(network *vpc.Network) bool { return network.GetFolderId() == folderID }) {
+		s.networks.delete(network.GetId())
+	}
+	for _, disk := range s.disks.list(func(disk *compute.Disk) bool { return disk.GetFolderId() == folderID }) {
+		s.disks.delete(disk.GetId())
+	}
+	for _, zone := range s.dnsZones.list(func(zone *dns.DnsZone) bool { return zone.GetFolderId() == folderID }) {
+		s.dnsZones.delete(zone.GetId())
+		s.recordSets.deleteZone(zone.GetId())
+	}
+	for _, secret := range s.lockboxSecrets.list(func(secret *lockbox.Secret) bool { return secret.GetFolderId() == folderID }) {
+		s.deleteLockboxSecret(secret.GetId())
+	}
+}
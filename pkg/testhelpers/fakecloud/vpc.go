@@ -0,0 +1,172 @@
+package fakecloud
+
+import (
+	"context"
+	"slices"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type networkService struct {
+	vpc.UnimplementedNetworkServiceServer
+	s *Server
+}
+
+func (n *networkService) Get(_ context.Context, req *vpc.GetNetworkRequest) (*vpc.Network, error) {
+	return n.s.networks.get(req.GetNetworkId())
+}
+
+func (n *networkService) List(_ context.Context, req *vpc.ListNetworksRequest) (*vpc.ListNetworksResponse, error) {
+	match, err := nameFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	return &vpc.ListNetworksResponse{
+		Networks: n.s.networks.list(func(network *vpc.Network) bool {
+			return network.GetFolderId() == req.GetFolderId() && match(network.GetName())
+		}),
+	}, nil
+}
+
+func (n *networkService) ListSubnets(_ context.Context, req *vpc.ListNetworkSubnetsRequest) (*vpc.ListNetworkSubnetsResponse, error) {
+	if _, err := n.s.networks.get(req.GetNetworkId()); err != nil {
+		return nil, err
+	}
+
+	return &vpc.ListNetworkSubnetsResponse{
+		Subnets: n.s.networkSubnets(req.GetNetworkId()),
+	}, nil
+}
+
+func (n *networkService) Create(_ context.Context, req *vpc.CreateNetworkRequest) (*operation.Operation, error) {
+	if err := n.s.checkFolder(req.GetFolderId()); err != nil {
+		return nil, err
+	}
+
+	network := &vpc.Network{
+		Id:          n.s.networks.newID(),
+		FolderId:    req.GetFolderId(),
+		CreatedAt:   timestamppb.Now(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Labels:      req.GetLabels(),
+	}
+	n.s.networks.insert(network)
+
+	return n.s.done("Create network", &vpc.CreateNetworkMetadata{NetworkId: network.Id}, network)
+}
+
+func (n *networkService) Update(_ context.Context, req *vpc.UpdateNetworkRequest) (*operation.Operation, error) {
+	network, err := n.s.networks.update(req.GetNetworkId(), func(network *vpc.Network) error {
+		return applyUpdateMask(network, req, req.GetUpdateMask())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return n.s.done("Update network", &vpc.UpdateNetworkMetadata{NetworkId: network.Id}, network)
+}
+
+func (n *networkService) Delete(_ context.Context, req *vpc.DeleteNetworkRequest) (*operation.Operation, error) {
+	if len(n.s.networkSubnets(req.GetNetworkId())) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Network %s is not empty", req.GetNetworkId())
+	}
+	if _, err := n.s.networks.delete(req.GetNetworkId()); err != nil {
+		return nil, err
+	}
+
+	return n.s.done("Delete network", &vpc.DeleteNetworkMetadata{NetworkId: req.GetNetworkId()}, nil)
+}
+
+func (s *Server) networkSubnets(networkID string) []*vpc.Subnet {
+	return s.subnets.list(func(subnet *vpc.Subnet) bool {
+		return subnet.GetNetworkId() == networkID
+	})
+}
+
+type subnetService struct {
+	vpc.UnimplementedSubnetServiceServer
+	s *Server
+}
+
+func (sn *subnetService) Get(_ context.Context, req *vpc.GetSubnetRequest) (*vpc.Subnet, error) {
+	return sn.s.subnets.get(req.GetSubnetId())
+}
+
+func (sn *subnetService) List(_ context.Context, req *vpc.ListSubnetsRequest) (*vpc.ListSubnetsResponse, error) {
+	match, err := nameFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	return &vpc.ListSubnetsResponse{
+		Subnets: sn.s.subnets.list(func(subnet *vpc.Subnet) bool {
+			return subnet.GetFolderId() == req.GetFolderId() && match(subnet.GetName())
+		}),
+	}, nil
+}
+
+func (sn *subnetService) Create(_ context.Context, req *vpc.CreateSubnetRequest) (*operation.Operation, error) {
+	if err := sn.s.checkFolder(req.GetFolderId()); err != nil {
+		return nil, err
+	}
+	if _, err := sn.s.networks.get(req.GetNetworkId()); err != nil {
+		return nil, err
+	}
+	if len(req.GetV4CidrBlocks()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "v4_cidr_blocks is required")
+	}
+
+	subnet := &vpc.Subnet{
+		Id:           sn.s.subnets.newID(),
+		FolderId:     req.GetFolderId(),
+		CreatedAt:    timestamppb.Now(),
+		Name:         req.GetName(),
+		Description:  req.GetDescription(),
+		Labels:       req.GetLabels(),
+		NetworkId:    req.GetNetworkId(),
+		ZoneId:       req.GetZoneId(),
+		V4CidrBlocks: req.GetV4CidrBlocks(),
+		RouteTableId: req.GetRouteTableId(),
+		DhcpOptions:  req.GetDhcpOptions(),
+	}
+	sn.s.subnets.insert(subnet)
+
+	return sn.s.done("Create subnet", &vpc.CreateSubnetMetadata{SubnetId: subnet.Id}, subnet)
+}
+
+func (sn *subnetService) Update(_ context.Context, req *vpc.UpdateSubnetRequest) (*operation.Operation, error) {
+	subnet, err := sn.s.subnets.update(req.GetSubnetId(), func(subnet *vpc.Subnet) error {
+		return applyUpdateMask(subnet, req, req.GetUpdateMask())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sn.s.done("Update subnet", &vpc.UpdateSubnetMetadata{SubnetId: subnet.Id}, subnet)
+}
+
+func (sn *subnetService) Delete(_ context.Context, req *vpc.DeleteSubnetRequest) (*operation.Operation, error) {
+	if len(sn.s.subnetInstances(req.GetSubnetId())) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Subnet %s is used by instances", req.GetSubnetId())
+	}
+	if _, err := sn.s.subnets.delete(req.GetSubnetId()); err != nil {
+		return nil, err
+	}
+
+	return sn.s.done("Delete subnet", &vpc.DeleteSubnetMetadata{SubnetId: req.GetSubnetId()}, nil)
+}
+
+func (s *Server) subnetInstances(subnetID string) []*compute.Instance {
+	return s.instances.list(func(instance *compute.Instance) bool {
+		return slices.ContainsFunc(instance.GetNetworkInterfaces(), func(nic *compute.NetworkInterface) bool {
+			return nic.GetSubnetId() == subnetID
+		})
+	})
+}
@@ -9,6 +9,8 @@ import (
 	"strings"
 	"time"
 
+	// Declares -sweep-dry-run and -sweep-min-age flags, so they are accepted by all test packages of `make sweep`
+	_ "github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 
@@ -0,0 +1,227 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/grpc/codes"
+)
+
+// IAM member types accepted by access bindings.
+const (
+	IamMemberTypeUserAccount    = "userAccount"
+	IamMemberTypeServiceAccount = "serviceAccount"
+	IamMemberTypeGroup          = "group"
+	IamMemberTypeFederatedUser  = "federatedUser"
+	IamMemberTypeSystem         = "system"
+)
+
+var iamMemberTypes = []string{
+	IamMemberTypeUserAccount,
+	IamMemberTypeServiceAccount,
+	IamMemberTypeGroup,
+	IamMemberTypeFederatedUser,
+	IamMemberTypeSystem,
+}
+
+var (
+	iamSubjectIDRegexp       = regexp.MustCompile(`^[a-zA-Z0-9_.@-]+$`)
+	iamFederatedUserIDRegexp = regexp.MustCompile(`^[a-zA-Z0-9_.@-]+:?$`)
+	iamSystemSubjectRegexp   = regexp.MustCompile(`^(allAuthenticatedUsers|allUsers|group:[a-zA-Z]+:[a-zA-Z0-9_-]+:users)$`)
+	iamRoleRegexp            = regexp.MustCompile(`^[a-z0-9]([a-zA-Z0-9_-]*[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9_-]*[a-zA-Z0-9])?)*$`)
+)
+
+// IamMember checks that member is in the `{type}:{id}` format with one of the known subject types.
+func IamMember(member string) error {
+	memberType, id, ok := strings.Cut(member, ":")
+	if !ok || memberType == "" || id == "" {
+		return fmt.Errorf("expect 'member' value should be in TYPE:ID format, got %q", member)
+	}
+
+	switch memberType {
+	case IamMemberTypeUserAccount, IamMemberTypeServiceAccount, IamMemberTypeGroup:
+		if !iamSubjectIDRegexp.MatchString(id) {
+			return fmt.Errorf("member %q has invalid %s ID %q", member, memberType, id)
+		}
+	case IamMemberTypeFederatedUser:
+		if !iamFederatedUserIDRegexp.MatchString(id) {
+			return fmt.Errorf("member %q has invalid %s ID %q", member, memberType, id)
+		}
+	case IamMemberTypeSystem:
+		if !iamSystemSubjectRegexp.MatchString(id) {
+			return fmt.Errorf("member %q is not a known system subject, expected one of system:allAuthenticatedUsers, system:allUsers or system:group:{federation|organization}:{id}:users", member)
+		}
+	default:
+		msg := fmt.Sprintf("member %q has unknown type %q, expected one of %s", member, memberType, strings.Join(iamMemberTypes, ", "))
+		if suggestion := closestIamMemberType(memberType); suggestion != "" {
+			msg += fmt.Sprintf(" (did you mean %q?)", suggestion+":"+id)
+		}
+		return fmt.Errorf("%s", msg)
+	}
+	return nil
+}
+
+// IamRole checks that role looks like an IAM role ID, e.g. `admin` or `resource-manager.clouds.owner`.
+func IamRole(role string) error {
+	if !iamRoleRegexp.MatchString(role) {
+		return fmt.Errorf("role %q is not a valid role ID, see https://yandex.cloud/docs/iam/roles-reference", role)
+	}
+	return nil
+}
+
+func closestIamMemberType(memberType string) string {
+	best, bestDistance := "", 3
+	for _, t := range iamMemberTypes {
+		if d := levenshtein(strings.ToLower(memberType), strings.ToLower(t)); d < bestDistance {
+			best, bestDistance = t, d
+		}
+	}
+	return best
+}
+
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+type iamStringValidator struct {
+	description string
+	check       func(string) error
+}
+
+func (v iamStringValidator) Description(_ context.Context) string {
+	return v.description
+}
+
+func (v iamStringValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v iamStringValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := v.check(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid IAM value", err.Error())
+	}
+}
+
+// IamMemberValidator returns a framework validator for IAM members, see IamMember.
+func IamMemberValidator() validator.String {
+	return iamStringValidator{description: "value must be an IAM member in the TYPE:ID format", check: IamMember}
+}
+
+// IamRoleValidator returns a framework validator for IAM roles, see IamRole.
+func IamRoleValidator() validator.String {
+	return iamStringValidator{description: "value must be an IAM role ID", check: IamRole}
+}
+
+// ResolveIamRole gets the role by ID, returning a readable error if the role does not exist.
+func ResolveIamRole(ctx context.Context, sdk *ycsdk.SDK, roleID string) (*iam.Role, error) {
+	role, err := sdk.IAM().Role().Get(ctx, &iam.GetRoleRequest{RoleId: roleID})
+	if err != nil {
+		if IsStatusWithCode(err, codes.NotFound) {
+			return nil, fmt.Errorf("role not found: %s", roleID)
+		}
+		return nil, err
+	}
+	return role, nil
+}
+
+// IamChecker verifies roles and service account members against the API.
+// Successful and definitive (NotFound, InvalidArgument) results are cached, so every role or service account
+// is checked once per provider run. Other errors, e.g. timeouts, are retried on the next check.
+type IamChecker struct {
+	sdk *ycsdk.SDK
+
+	mu              sync.Mutex
+	roles           map[string]error
+	serviceAccounts map[string]error
+}
+
+var iamCheckers sync.Map
+
+// GetIamChecker returns the checker shared by all resources which use the SDK.
+func GetIamChecker(sdk *ycsdk.SDK) *IamChecker {
+	checker, _ := iamCheckers.LoadOrStore(sdk, &IamChecker{
+		sdk:             sdk,
+		roles:           make(map[string]error),
+		serviceAccounts: make(map[string]error),
+	})
+	return checker.(*IamChecker)
+}
+
+// CheckRole verifies that the role exists.
+func (c *IamChecker) CheckRole(ctx context.Context, role string) error {
+	err := c.cached(c.roles, role, func() error {
+		_, err := c.sdk.IAM().Role().Get(ctx, &iam.GetRoleRequest{RoleId: role})
+		return err
+	})
+	if IsStatusWithCode(err, codes.NotFound) {
+		return fmt.Errorf("role not found: %s", role)
+	}
+	return err
+}
+
+// CheckMember verifies that a `serviceAccount:` member refers to an existing service account.
+// Other member types can not be checked without additional permissions and are accepted as is.
+func (c *IamChecker) CheckMember(ctx context.Context, member string) error {
+	memberType, id, _ := strings.Cut(member, ":")
+	if memberType != IamMemberTypeServiceAccount {
+		return nil
+	}
+
+	err := c.cached(c.serviceAccounts, id, func() error {
+		_, err := c.sdk.IAM().ServiceAccount().Get(ctx, &iam.GetServiceAccountRequest{ServiceAccountId: id})
+		return err
+	})
+	if IsStatusWithCode(err, codes.NotFound) {
+		return fmt.Errorf("member %q: service account %q does not exist, check that the ID is not a user or group ID", member, id)
+	}
+	if err != nil {
+		return fmt.Errorf("member %q: failed to get service account %q: %w", member, id, err)
+	}
+	return nil
+}
+
+// cached returns the cached result of check for the key, or calls check. Only results which can't change
+// during the run are cached: success, and NotFound or InvalidArgument errors.
+func (c *IamChecker) cached(cache map[string]error, key string, check func() error) error {
+	c.mu.Lock()
+	if err, ok := cache[key]; ok {
+		c.mu.Unlock()
+		return err
+	}
+	c.mu.Unlock()
+
+	err := check()
+	if err != nil && !IsStatusWithCode(err, codes.NotFound) && !IsStatusWithCode(err, codes.InvalidArgument) {
+		return err
+	}
+
+	c.mu.Lock()
+	cache[key] = err
+	c.mu.Unlock()
+	return err
+}
@@ -0,0 +1,113 @@
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIamMember(t *testing.T) {
+	valid := []string{
+		"userAccount:ajelprpohp7r8cl6gq5p",
+		"serviceAccount:ajelprpohp7r8cl6gq5p",
+		"group:ajelprpohp7r8cl6gq5p",
+		"federatedUser:ajelprpohp7r8cl6gq5p",
+		"federatedUser:ajelprpohp7r8cl6gq5p:",
+		"system:allUsers",
+		"system:allAuthenticatedUsers",
+		"system:group:organization:bpf3crucp1v2ak0e7g9b:users",
+		"system:group:federation:bpf3crucp1v2ak0e7g9b:users",
+	}
+	for _, member := range valid {
+		assert.NoError(t, IamMember(member), member)
+	}
+
+	invalid := map[string]string{
+		"ajelprpohp7r8cl6gq5p":                  "TYPE:ID format",
+		"serviceAccount:":                       "TYPE:ID format",
+		"serviceAcount:ajelprpohp7r8cl6gq5p":    `did you mean "serviceAccount:ajelprpohp7r8cl6gq5p"`,
+		"useraccount:ajelprpohp7r8cl6gq5p":      `did you mean "userAccount:ajelprpohp7r8cl6gq5p"`,
+		"robot:ajelprpohp7r8cl6gq5p":            "unknown type",
+		"serviceAccount:aje lprpohp7r8cl6gq5p":  "invalid serviceAccount ID",
+		"serviceAccount:ajelprpohp7r8cl6gq5p:x": "invalid serviceAccount ID",
+		"system:everyone":                       "not a known system subject",
+	}
+	for member, expected := range invalid {
+		err := IamMember(member)
+		if assert.Error(t, err, member) {
+			assert.Contains(t, err.Error(), expected, member)
+		}
+	}
+}
+
+func TestIamRole(t *testing.T) {
+	for _, role := range []string{"admin", "resource-manager.clouds.owner", "ai.languageModels.user", "k8s.cluster-api.cluster-admin"} {
+		assert.NoError(t, IamRole(role), role)
+	}
+	for _, role := range []string{"", "admin ", "Admin", "storage..editor", "storage.editor.", "roles/admin"} {
+		assert.Error(t, IamRole(role), role)
+	}
+}
+
+func TestIamMemberValidator(t *testing.T) {
+	resp := &validator.StringResponse{}
+	IamMemberValidator().ValidateString(context.Background(), validator.StringRequest{
+		Path:        path.Root("member"),
+		ConfigValue: types.StringValue("serviceAcount:id"),
+	}, resp)
+	assert.True(t, resp.Diagnostics.HasError())
+
+	resp = &validator.StringResponse{}
+	IamMemberValidator().ValidateString(context.Background(), validator.StringRequest{
+		Path:        path.Root("member"),
+		ConfigValue: types.StringUnknown(),
+	}, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+}
+
+func TestIamCheckerCache(t *testing.T) {
+	c := &IamChecker{roles: make(map[string]error)}
+	calls := 0
+	check := func() error {
+		calls++
+		return nil
+	}
+	assert.NoError(t, c.cached(c.roles, "admin", check))
+	assert.NoError(t, c.cached(c.roles, "admin", check))
+	assert.Equal(t, 1, calls)
+
+	assert.NoError(t, c.CheckMember(context.Background(), "userAccount:id"))
+}
+
+func TestIamCheckerCacheErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           error
+		expectedCalls int
+	}{
+		{name: "not found", err: status.Error(codes.NotFound, "not found"), expectedCalls: 1},
+		{name: "invalid argument", err: status.Error(codes.InvalidArgument, "invalid"), expectedCalls: 1},
+		{name: "unavailable", err: status.Error(codes.Unavailable, "unavailable"), expectedCalls: 2},
+		{name: "deadline exceeded", err: context.DeadlineExceeded, expectedCalls: 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &IamChecker{roles: make(map[string]error)}
+			calls := 0
+			check := func() error {
+				calls++
+				return test.err
+			}
+			assert.ErrorIs(t, c.cached(c.roles, "admin", check), test.err)
+			assert.ErrorIs(t, c.cached(c.roles, "admin", check), test.err)
+			assert.Equal(t, test.expectedCalls, calls)
+		})
+	}
+}
@@ -0,0 +1,413 @@
+package examples
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+const providerName = "yandex"
+
+var (
+	// Meta-arguments of resource and data blocks, they are not a part of the provider schema
+	metaArguments  = map[string]bool{"count": true, "for_each": true, "depends_on": true, "provider": true}
+	resourceBlocks = map[string]bool{"lifecycle": true, "provisioner": true, "connection": true}
+	dataBlocks     = map[string]bool{"lifecycle": true}
+	providerMeta   = map[string]bool{"alias": true}
+)
+
+// Validator checks configuration examples against the provider schema.
+type Validator struct {
+	provider    *tfprotov6.Schema
+	resources   map[string]*tfprotov6.Schema
+	dataSources map[string]*tfprotov6.Schema
+}
+
+// NewValidator loads schema of the provider from the server.
+func NewValidator(ctx context.Context, server tfprotov6.ProviderServer) (*Validator, error) {
+	resp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("get provider schema: %w", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			return nil, fmt.Errorf("get provider schema: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	return &Validator{
+		provider:    resp.Provider,
+		resources:   resp.ResourceSchemas,
+		dataSources: resp.DataSourceSchemas,
+	}, nil
+}
+
+// ValidateDir validates all .tf files in the directory and its subdirectories.
+func (v *Validator) ValidateDir(dir string) (hcl.Diagnostics, int, error) {
+	var (
+		diags hcl.Diagnostics
+		files int
+	)
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".tf" {
+			return nil
+		}
+
+		files++
+		diags = append(diags, v.ValidateFile(path)...)
+		return nil
+	})
+
+	sort.SliceStable(diags, func(i, j int) bool {
+		if diags[i].Subject == nil || diags[j].Subject == nil {
+			return diags[i].Subject == nil && diags[j].Subject != nil
+		}
+		if diags[i].Subject.Filename != diags[j].Subject.Filename {
+			return diags[i].Subject.Filename < diags[j].Subject.Filename
+		}
+		return diags[i].Subject.Start.Byte < diags[j].Subject.Start.Byte
+	})
+	return diags, files, err
+}
+
+// ValidateFile parses the file and validates resources, data sources and provider configuration in it.
+// Blocks and objects elided with a `# ...` comment are not checked for missing arguments and blocks.
+func (v *Validator) ValidateFile(path string) hcl.Diagnostics {
+	file, diags := hclparse.NewParser().ParseHCLFile(path)
+	if diags.HasErrors() {
+		return diags
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return diags
+	}
+	el := findElisions(file.Bytes, path)
+
+	for _, block := range body.Blocks {
+		switch {
+		case block.Type == "resource" && len(block.Labels) == 2:
+			diags = append(diags, v.validateTopLevel(block, "resource", v.resources, resourceBlocks, el)...)
+		case block.Type == "data" && len(block.Labels) == 2:
+			diags = append(diags, v.validateTopLevel(block, "data source", v.dataSources, dataBlocks, el)...)
+		case block.Type == "provider" && len(block.Labels) == 1 && block.Labels[0] == providerName:
+			diags = append(diags, validateBody(block.Body, block.DefRange(), v.provider.Block, providerMeta, nil, el)...)
+		}
+	}
+	return diags
+}
+
+func (v *Validator) validateTopLevel(block *hclsyntax.Block, kind string, schemas map[string]*tfprotov6.Schema, metaBlocks map[string]bool, el elisions) hcl.Diagnostics {
+	typeName := block.Labels[0]
+	if !strings.HasPrefix(typeName, providerName+"_") {
+		// Resources of other providers are used in examples too
+		return nil
+	}
+
+	schema, ok := schemas[typeName]
+	if !ok {
+		return hcl.Diagnostics{{
+			Severity: hcl.DiagError,
+			Summary:  fmt.Sprintf("Unknown %s type", kind),
+			Detail:   fmt.Sprintf("The provider doesn't have %s %q.", kind, typeName),
+			Subject:  block.LabelRanges[0].Ptr(),
+		}}
+	}
+	return validateBody(block.Body, block.DefRange(), schema.Block, metaArguments, metaBlocks, el)
+}
+
+// elisions holds byte offsets of comments which elide a part of an example, e.g. `# ...`.
+type elisions []int
+
+func findElisions(src []byte, filename string) elisions {
+	tokens, _ := hclsyntax.LexConfig(src, filename, hcl.InitialPos)
+
+	var el elisions
+	for _, token := range tokens {
+		if token.Type != hclsyntax.TokenComment {
+			continue
+		}
+		text := strings.TrimSpace(string(token.Bytes))
+		for _, marker := range []string{"#", "//", "/*"} {
+			text = strings.TrimPrefix(text, marker)
+		}
+		if strings.HasPrefix(strings.TrimSpace(text), "...") {
+			el = append(el, token.Range.Start.Byte)
+		}
+	}
+	return el
+}
+
+// in reports whether rng has an elision which is not inside one of the nested ranges.
+func (el elisions) in(rng hcl.Range, nested []hcl.Range) bool {
+	for _, offset := range el {
+		if offset < rng.Start.Byte || offset >= rng.End.Byte {
+			continue
+		}
+		inNested := false
+		for _, n := range nested {
+			if offset >= n.Start.Byte && offset < n.End.Byte {
+				inNested = true
+				break
+			}
+		}
+		if !inNested {
+			return true
+		}
+	}
+	return false
+}
+
+// validateBody reports unsupported arguments and blocks, missing required arguments,
+// wrong number of nested blocks and values of wrong types. Missing arguments and blocks
+// are reported at rng, the header of the block, unless the body is elided.
+func validateBody(body *hclsyntax.Body, rng hcl.Range, schema *tfprotov6.SchemaBlock, metaAttrs, metaBlocks map[string]bool, el elisions) hcl.Diagnostics {
+	var diags hcl.Diagnostics
+
+	nested := make([]hcl.Range, 0, len(body.Blocks))
+	for _, block := range body.Blocks {
+		nested = append(nested, block.Range())
+	}
+	elided := el.in(body.SrcRange, nested)
+
+	attributes := make(map[string]*tfprotov6.SchemaAttribute, len(schema.Attributes))
+	for _, a := range schema.Attributes {
+		attributes[a.Name] = a
+	}
+	blockTypes := make(map[string]*tfprotov6.SchemaNestedBlock, len(schema.BlockTypes))
+	for _, b := range schema.BlockTypes {
+		blockTypes[b.TypeName] = b
+	}
+
+	for name, attr := range body.Attributes {
+		if metaAttrs[name] {
+			continue
+		}
+
+		a, ok := attributes[name]
+		if !ok {
+			detail := fmt.Sprintf("An argument named %q is not expected here.", name)
+			if _, ok := blockTypes[name]; ok {
+				detail += fmt.Sprintf(" Did you mean to define a block of type %q?", name)
+			}
+			diags = append(diags, errorf(attr.NameRange, "Unsupported argument", detail))
+			continue
+		}
+		diags = append(diags, validateAttribute(name, attr.Expr, attr.NameRange, a, el)...)
+	}
+
+	for _, a := range schema.Attributes {
+		if _, ok := body.Attributes[a.Name]; a.Required && !ok && !elided {
+			diags = append(diags, errorf(rng, "Missing required argument",
+				fmt.Sprintf("The argument %q is required, but no definition was found.", a.Name)))
+		}
+	}
+
+	var (
+		counts  = make(map[string]int)
+		dynamic = make(map[string]bool)
+	)
+	for _, block := range body.Blocks {
+		if metaBlocks[block.Type] {
+			continue
+		}
+
+		typeName, blockBody, typeRange := block.Type, block.Body, block.TypeRange
+		if block.Type == "dynamic" && len(block.Labels) == 1 {
+			typeName, blockBody, typeRange = block.Labels[0], dynamicContent(block), block.LabelRanges[0]
+			dynamic[typeName] = true
+		}
+
+		nested, ok := blockTypes[typeName]
+		if !ok {
+			detail := fmt.Sprintf("Blocks of type %q are not expected here.", typeName)
+			if _, ok := attributes[typeName]; ok {
+				detail += fmt.Sprintf(" Did you mean to define argument %q? If so, use the equals sign to assign it a value.", typeName)
+			}
+			diags = append(diags, errorf(typeRange, "Unsupported block type", detail))
+			continue
+		}
+
+		counts[typeName]++
+		if blockBody != nil {
+			diags = append(diags, validateBody(blockBody, block.DefRange(), nested.Block, nil, nil, el)...)
+		}
+	}
+
+	for _, nested := range schema.BlockTypes {
+		if dynamic[nested.TypeName] {
+			continue
+		}
+
+		count, maxItems := int64(counts[nested.TypeName]), nested.MaxItems
+		if nested.Nesting == tfprotov6.SchemaNestedBlockNestingModeSingle || nested.Nesting == tfprotov6.SchemaNestedBlockNestingModeGroup {
+			maxItems = 1
+		}
+		if count < nested.MinItems && !elided {
+			diags = append(diags, errorf(rng, "Insufficient blocks",
+				fmt.Sprintf("At least %d %q blocks are required.", nested.MinItems, nested.TypeName)))
+		}
+		if maxItems > 0 && count > maxItems {
+			diags = append(diags, errorf(rng, "Too many blocks",
+				fmt.Sprintf("No more than %d %q blocks are allowed.", maxItems, nested.TypeName)))
+		}
+	}
+
+	return diags
+}
+
+// dynamicContent returns body of the content block of the dynamic block.
+func dynamicContent(block *hclsyntax.Block) *hclsyntax.Body {
+	for _, b := range block.Body.Blocks {
+		if b.Type == "content" {
+			return b.Body
+		}
+	}
+	return nil
+}
+
+func validateAttribute(name string, expr hclsyntax.Expression, rng hcl.Range, a *tfprotov6.SchemaAttribute, el elisions) hcl.Diagnostics {
+	if a.Computed && !a.Optional && !a.Required {
+		return hcl.Diagnostics{errorf(rng, "Invalid configuration for read-only attribute",
+			fmt.Sprintf("Cannot set value for %q, it is computed by the provider.", name))}
+	}
+
+	if a.NestedType != nil {
+		return validateNested(expr, a.NestedType, el)
+	}
+	return validateValue(name, expr, a.Type)
+}
+
+// validateNested checks object constructors of nested attributes, values computed
+// from references and functions are not checked.
+func validateNested(expr hclsyntax.Expression, object *tfprotov6.SchemaObject, el elisions) hcl.Diagnostics {
+	var objects []*hclsyntax.ObjectConsExpr
+
+	switch object.Nesting {
+	case tfprotov6.SchemaObjectNestingModeSingle:
+		if obj, ok := expr.(*hclsyntax.ObjectConsExpr); ok {
+			objects = append(objects, obj)
+		}
+	case tfprotov6.SchemaObjectNestingModeList, tfprotov6.SchemaObjectNestingModeSet:
+		if tuple, ok := expr.(*hclsyntax.TupleConsExpr); ok {
+			for _, e := range tuple.Exprs {
+				if obj, ok := e.(*hclsyntax.ObjectConsExpr); ok {
+					objects = append(objects, obj)
+				}
+			}
+		}
+	case tfprotov6.SchemaObjectNestingModeMap:
+		if m, ok := expr.(*hclsyntax.ObjectConsExpr); ok {
+			for _, item := range m.Items {
+				if obj, ok := item.ValueExpr.(*hclsyntax.ObjectConsExpr); ok {
+					objects = append(objects, obj)
+				}
+			}
+		}
+	}
+
+	var diags hcl.Diagnostics
+	for _, obj := range objects {
+		diags = append(diags, validateObject(obj, object.Attributes, el)...)
+	}
+	return diags
+}
+
+func validateObject(obj *hclsyntax.ObjectConsExpr, schema []*tfprotov6.SchemaAttribute, el elisions) hcl.Diagnostics {
+	var diags hcl.Diagnostics
+
+	values := make([]hcl.Range, 0, len(obj.Items))
+	for _, item := range obj.Items {
+		values = append(values, item.ValueExpr.Range())
+	}
+	elided := el.in(obj.SrcRange, values)
+
+	attributes := make(map[string]*tfprotov6.SchemaAttribute, len(schema))
+	for _, a := range schema {
+		attributes[a.Name] = a
+	}
+
+	present := make(map[string]bool, len(obj.Items))
+	for _, item := range obj.Items {
+		key, keyDiags := item.KeyExpr.Value(nil)
+		if keyDiags.HasErrors() || !key.IsKnown() || key.IsNull() || key.Type() != cty.String {
+			continue
+		}
+
+		name := key.AsString()
+		present[name] = true
+		a, ok := attributes[name]
+		if !ok {
+			diags = append(diags, errorf(item.KeyExpr.Range(), "Unsupported argument",
+				fmt.Sprintf("An argument named %q is not expected here.", name)))
+			continue
+		}
+		diags = append(diags, validateAttribute(name, item.ValueExpr, item.KeyExpr.Range(), a, el)...)
+	}
+
+	for _, a := range schema {
+		if a.Required && !present[a.Name] && !elided {
+			diags = append(diags, errorf(obj.OpenRange, "Missing required argument",
+				fmt.Sprintf("The argument %q is required, but no definition was found.", a.Name)))
+		}
+	}
+	return diags
+}
+
+// validateValue checks that the constant value can be converted to the attribute type.
+func validateValue(name string, expr hclsyntax.Expression, attrType tftypes.Type) hcl.Diagnostics {
+	if attrType == nil {
+		return nil
+	}
+
+	val, valDiags := expr.Value(nil)
+	if valDiags.HasErrors() || !val.IsWhollyKnown() || val.IsNull() {
+		// The value refers to other objects, variables or functions
+		return nil
+	}
+
+	ty, err := ctyType(attrType)
+	if err != nil || ty == cty.DynamicPseudoType {
+		return nil
+	}
+
+	if _, err := convert.Convert(val, ty); err != nil {
+		return hcl.Diagnostics{errorf(expr.Range(), "Incorrect attribute value type",
+			fmt.Sprintf("Inappropriate value for attribute %q: %s.", name, err))}
+	}
+	return nil
+}
+
+func ctyType(t tftypes.Type) (cty.Type, error) {
+	raw, err := json.Marshal(t)
+	if err != nil {
+		return cty.NilType, err
+	}
+	return ctyjson.UnmarshalType(raw)
+}
+
+func errorf(rng hcl.Range, summary, detail string) *hcl.Diagnostic {
+	return &hcl.Diagnostic{
+		Severity: hcl.DiagError,
+		Summary:  summary,
+		Detail:   detail,
+		Subject:  rng.Ptr(),
+	}
+}
@@ -0,0 +1,218 @@
+package examples
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testValidator() *Validator {
+	instance := &tfprotov6.Schema{Block: &tfprotov6.SchemaBlock{
+		Attributes: []*tfprotov6.SchemaAttribute{
+			{Name: "id", Type: tftypes.String, Computed: true},
+			{Name: "name", Type: tftypes.String, Required: true},
+			{Name: "cores", Type: tftypes.Number, Optional: true},
+			{Name: "labels", Type: tftypes.Map{ElementType: tftypes.String}, Optional: true},
+			{Name: "policy", Optional: true, NestedType: &tfprotov6.SchemaObject{
+				Nesting: tfprotov6.SchemaObjectNestingModeSingle,
+				Attributes: []*tfprotov6.SchemaAttribute{
+					{Name: "enabled", Type: tftypes.Bool, Required: true},
+					{Name: "period", Type: tftypes.String, Optional: true},
+				},
+			}},
+		},
+		BlockTypes: []*tfprotov6.SchemaNestedBlock{
+			{
+				TypeName: "boot_disk",
+				Nesting:  tfprotov6.SchemaNestedBlockNestingModeList,
+				MinItems: 1,
+				MaxItems: 1,
+				Block: &tfprotov6.SchemaBlock{Attributes: []*tfprotov6.SchemaAttribute{
+					{Name: "disk_id", Type: tftypes.String, Required: true},
+				}},
+			},
+		},
+	}}
+
+	return &Validator{
+		provider:    &tfprotov6.Schema{Block: &tfprotov6.SchemaBlock{}},
+		resources:   map[string]*tfprotov6.Schema{"yandex_instance": instance},
+		dataSources: map[string]*tfprotov6.Schema{"yandex_instance": instance},
+	}
+}
+
+func TestValidateFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		example string
+		errors  []string
+	}{
+		{
+			name: "valid",
+			example: `
+resource "yandex_instance" "vm" {
+  name   = "vm"
+  cores  = 2
+  labels = { env = "test" }
+  policy = { enabled = true }
+
+  boot_disk {
+    disk_id = yandex_disk.boot.id
+  }
+}
+
+resource "other_instance" "vm" {
+  unknown = true
+}
+`,
+		},
+		{
+			name: "unknown resource type",
+			example: `
+resource "yandex_instanse" "vm" {
+  name = "vm"
+}
+`,
+			errors: []string{"Unknown resource type"},
+		},
+		{
+			name: "unsupported argument and block",
+			example: `
+resource "yandex_instance" "vm" {
+  name   = "vm"
+  memory = 2
+
+  boot_disk {
+    disk_id = "disk"
+  }
+
+  labels {
+    env = "test"
+  }
+}
+`,
+			errors: []string{"Unsupported argument", "Unsupported block type"},
+		},
+		{
+			name: "missing required argument and block",
+			example: `
+resource "yandex_instance" "vm" {
+  cores = 2
+}
+`,
+			errors: []string{"Missing required argument", "Insufficient blocks"},
+		},
+		{
+			name: "too many blocks",
+			example: `
+resource "yandex_instance" "vm" {
+  name = "vm"
+
+  boot_disk {
+    disk_id = "first"
+  }
+
+  boot_disk {
+    disk_id = "second"
+  }
+}
+`,
+			errors: []string{"Too many blocks"},
+		},
+		{
+			name: "read-only and wrong type",
+			example: `
+data "yandex_instance" "vm" {
+  id    = "vm"
+  name  = "vm"
+  cores = "two"
+
+  boot_disk {
+    disk_id = "disk"
+  }
+}
+`,
+			errors: []string{"Invalid configuration for read-only attribute", "Incorrect attribute value type"},
+		},
+		{
+			name: "nested attribute",
+			example: `
+resource "yandex_instance" "vm" {
+  name   = "vm"
+  policy = { period = "1h", unknown = 1 }
+
+  boot_disk {
+    disk_id = "disk"
+  }
+}
+`,
+			errors: []string{"Unsupported argument", "Missing required argument"},
+		},
+		{
+			name: "elided block",
+			example: `
+resource "yandex_instance" "vm" {
+  cores = 2
+  # ...
+}
+`,
+		},
+		{
+			name: "elided object",
+			example: `
+resource "yandex_instance" "vm" {
+  name = "vm"
+  policy = {
+    period = "1h"
+    // ...
+  }
+
+  boot_disk {
+    disk_id = "disk"
+  }
+}
+`,
+		},
+		{
+			name: "elision in nested block",
+			example: `
+resource "yandex_instance" "vm" {
+  cores = 2
+
+  boot_disk {
+    # ...
+  }
+}
+`,
+			errors: []string{"Missing required argument"},
+		},
+		{
+			name: "elided block is still checked for unsupported arguments",
+			example: `
+resource "yandex_instance" "vm" {
+  name_prefix = "vm"
+  # ...
+}
+`,
+			errors: []string{"Unsupported argument"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "example.tf")
+			require.NoError(t, os.WriteFile(path, []byte(tt.example), 0o644))
+
+			var summaries []string
+			for _, d := range testValidator().ValidateFile(path) {
+				summaries = append(summaries, d.Summary)
+			}
+			assert.ElementsMatch(t, tt.errors, summaries)
+		})
+	}
+}
@@ -0,0 +1,262 @@
+package schemadiff
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Kinds of changie changes, breaking changes are reported as warnings
+const (
+	KindWarning      = "WARNING"
+	KindFeatures     = "FEATURES"
+	KindEnhancements = "ENHANCEMENTS"
+)
+
+var kindsOrder = []string{KindWarning, KindFeatures, KindEnhancements}
+
+// Change is a difference between two dumps described as a changelog entry.
+type Change struct {
+	Breaking bool
+	Kind     string
+	// Body is the changelog line in changie format: "${service_name}: description"
+	Body string
+}
+
+type entity struct {
+	name  string
+	kind  string
+	isRes bool
+}
+
+// Compare classifies changes of resources and data sources between the old and the new dumps.
+func Compare(old, new *Dump) []Change {
+	var changes []Change
+	changes = append(changes, compareSchemas(old.Resources, new.Resources, "resource", true)...)
+	changes = append(changes, compareSchemas(old.DataSources, new.DataSources, "data source", false)...)
+	return changes
+}
+
+func compareSchemas(old, new map[string]*Schema, kind string, isResource bool) []Change {
+	var changes []Change
+
+	for _, name := range sortedKeys(old, new) {
+		e := entity{name: name, kind: kind, isRes: isResource}
+		oldSchema, newSchema := old[name], new[name]
+		switch {
+		case newSchema == nil:
+			changes = append(changes, e.breaking("%s `%s` is removed", kind, name))
+		case oldSchema == nil:
+			changes = append(changes, e.change(KindFeatures, "new %s `%s`", kind, name))
+		default:
+			changes = append(changes, e.compareAttributes(oldSchema, newSchema)...)
+			changes = append(changes, e.compareBlocks(oldSchema, newSchema)...)
+		}
+	}
+	return changes
+}
+
+func (e entity) compareAttributes(old, new *Schema) []Change {
+	var changes []Change
+
+	for _, path := range sortedKeys(old.Attributes, new.Attributes) {
+		oldAttr, newAttr := old.Attributes[path], new.Attributes[path]
+		switch {
+		case newAttr == nil:
+			if parentRemoved(path, new) {
+				continue
+			}
+			changes = append(changes, e.breaking("attribute `%s` of `%s` %s is removed", path, e.name, e.kind))
+			continue
+		case oldAttr == nil:
+			if newAttr.Required {
+				changes = append(changes, e.breaking("new required attribute `%s` in `%s` %s", path, e.name, e.kind))
+			} else if !parentRemoved(path, old) {
+				changes = append(changes, e.change(KindEnhancements, "new attribute `%s` in `%s` %s", path, e.name, e.kind))
+			}
+			continue
+		}
+
+		if oldAttr.Type != newAttr.Type {
+			changes = append(changes, e.breaking("type of attribute `%s` of `%s` %s is changed from `%s` to `%s`",
+				path, e.name, e.kind, oldAttr.Type, newAttr.Type))
+		}
+
+		oldSettable, newSettable := oldAttr.Required || oldAttr.Optional, newAttr.Required || newAttr.Optional
+		switch {
+		case oldSettable && !newSettable:
+			changes = append(changes, e.breaking("attribute `%s` of `%s` %s becomes computed and can't be set", path, e.name, e.kind))
+		case !oldAttr.Required && newAttr.Required:
+			changes = append(changes, e.breaking("attribute `%s` of `%s` %s becomes required", path, e.name, e.kind))
+		case !oldSettable && newSettable:
+			changes = append(changes, e.change(KindEnhancements, "attribute `%s` of `%s` %s can be set", path, e.name, e.kind))
+		case oldAttr.Required && !newAttr.Required:
+			changes = append(changes, e.change(KindEnhancements, "attribute `%s` of `%s` %s becomes optional", path, e.name, e.kind))
+		}
+
+		if e.isRes && !oldAttr.ForceNew && newAttr.ForceNew {
+			changes = append(changes, e.breaking("changing attribute `%s` of `%s` %s forces replacement", path, e.name, e.kind))
+		}
+		if e.isRes && oldAttr.ForceNew && !newAttr.ForceNew {
+			changes = append(changes, e.change(KindEnhancements, "attribute `%s` of `%s` %s can be updated without replacement", path, e.name, e.kind))
+		}
+		if !oldAttr.Sensitive && newAttr.Sensitive {
+			// Outputs referring the attribute must be marked as sensitive
+			changes = append(changes, e.breaking("attribute `%s` of `%s` %s becomes sensitive", path, e.name, e.kind))
+		}
+		if !oldAttr.Deprecated && newAttr.Deprecated {
+			changes = append(changes, e.change(KindWarning, "deprecate attribute `%s` of `%s` %s", path, e.name, e.kind))
+		}
+	}
+	return changes
+}
+
+func (e entity) compareBlocks(old, new *Schema) []Change {
+	var changes []Change
+
+	for _, path := range sortedKeys(old.Blocks, new.Blocks) {
+		oldBlock, newBlock := old.Blocks[path], new.Blocks[path]
+		switch {
+		case newBlock == nil:
+			if parentRemoved(path, new) {
+				continue
+			}
+			changes = append(changes, e.breaking("block `%s` of `%s` %s is removed", path, e.name, e.kind))
+			continue
+		case oldBlock == nil:
+			if newBlock.MinItems > 0 {
+				changes = append(changes, e.breaking("new required block `%s` in `%s` %s", path, e.name, e.kind))
+			} else if !parentRemoved(path, old) {
+				changes = append(changes, e.change(KindEnhancements, "new block `%s` in `%s` %s", path, e.name, e.kind))
+			}
+			continue
+		}
+
+		if oldBlock.Nesting != newBlock.Nesting {
+			changes = append(changes, e.breaking("nesting of block `%s` of `%s` %s is changed from %s to %s",
+				path, e.name, e.kind, oldBlock.Nesting, newBlock.Nesting))
+		}
+		if newBlock.MinItems > oldBlock.MinItems {
+			changes = append(changes, e.breaking("block `%s` of `%s` %s requires at least %d items", path, e.name, e.kind, newBlock.MinItems))
+		}
+		if newBlock.MaxItems > 0 && (oldBlock.MaxItems == 0 || newBlock.MaxItems < oldBlock.MaxItems) {
+			changes = append(changes, e.breaking("block `%s` of `%s` %s allows at most %d items", path, e.name, e.kind, newBlock.MaxItems))
+		}
+		if e.isRes && !oldBlock.ForceNew && newBlock.ForceNew {
+			changes = append(changes, e.breaking("changing block `%s` of `%s` %s forces replacement", path, e.name, e.kind))
+		}
+	}
+	return changes
+}
+
+// parentRemoved reports whether the attribute or block is nested into the block or attribute absent in the schema,
+// so only the top-most change is reported.
+func parentRemoved(path string, s *Schema) bool {
+	i := strings.LastIndex(path, ".")
+	if i < 0 {
+		return false
+	}
+	parent := path[:i]
+	_, isBlock := s.Blocks[parent]
+	_, isAttribute := s.Attributes[parent]
+	return !isBlock && !isAttribute
+}
+
+func (e entity) breaking(format string, args ...any) Change {
+	c := e.change(KindWarning, format, args...)
+	c.Breaking = true
+	return c
+}
+
+func (e entity) change(kind, format string, args ...any) Change {
+	return Change{
+		Kind: kind,
+		Body: fmt.Sprintf("%s: %s", Service(e.name), fmt.Sprintf(format, args...)),
+	}
+}
+
+// Service returns the service name used in changelog of the resource (e.g. postgresql for yandex_mdb_postgresql_cluster).
+func Service(name string) string {
+	parts := strings.Split(strings.TrimPrefix(name, providerName+"_"), "_")
+	if parts[0] == "mdb" && len(parts) > 1 {
+		return parts[1]
+	}
+	return parts[0]
+}
+
+// WriteReport writes changes grouped by kind in the format of CHANGELOG.md.
+func WriteReport(w io.Writer, changes []Change) error {
+	for _, kind := range kindsOrder {
+		var bodies []string
+		for _, c := range changes {
+			if c.Kind == kind {
+				bodies = append(bodies, c.Body)
+			}
+		}
+		if len(bodies) == 0 {
+			continue
+		}
+
+		if _, err := fmt.Fprintf(w, "%s:\n", kind); err != nil {
+			return err
+		}
+		for _, body := range bodies {
+			if _, err := fmt.Fprintf(w, "* %s\n", body); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type unreleased struct {
+	Kind string    `yaml:"kind"`
+	Body string    `yaml:"body"`
+	Time time.Time `yaml:"time"`
+}
+
+// WriteChangie writes changes as changie unreleased entries into the directory (e.g. .changes/unreleased).
+func WriteChangie(dir string, changes []Change, now time.Time) error {
+	for i, c := range changes {
+		// Entries are ordered by time in the changelog, so every entry gets its own second
+		t := now.Truncate(time.Microsecond).Add(time.Duration(i) * time.Second)
+		raw, err := yaml.Marshal(unreleased{
+			Kind: c.Kind,
+			Body: c.Body,
+			Time: t,
+		})
+		if err != nil {
+			return fmt.Errorf("marshal changie entry: %w", err)
+		}
+
+		path := filepath.Join(dir, fmt.Sprintf("%s-%s.yaml", c.Kind, t.Format("20060102-150405")))
+		if err := os.WriteFile(path, raw, 0644); err != nil {
+			return fmt.Errorf("write changie entry (%s): %w", path, err)
+		}
+	}
+	return nil
+}
+
+func sortedKeys[T any](maps ...map[string]T) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, m := range maps {
+		for k := range m {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
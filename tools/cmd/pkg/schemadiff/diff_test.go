@@ -0,0 +1,192 @@
+package schemadiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	const name = "yandex_compute_disk"
+
+	tests := []struct {
+		name       string
+		old, new   *Schema
+		dataSource bool
+		want       []Change
+	}{
+		{
+			name: "no changes",
+			old:  &Schema{Attributes: map[string]*Attribute{"name": {Type: "string", Optional: true}}},
+			new:  &Schema{Attributes: map[string]*Attribute{"name": {Type: "string", Optional: true}}},
+		},
+		{
+			name: "removed attribute",
+			old: &Schema{Attributes: map[string]*Attribute{
+				"name": {Type: "string", Optional: true},
+				"size": {Type: "number", Optional: true},
+			}},
+			new: &Schema{Attributes: map[string]*Attribute{"name": {Type: "string", Optional: true}}},
+			want: []Change{{
+				Breaking: true,
+				Kind:     KindWarning,
+				Body:     "compute: attribute `size` of `yandex_compute_disk` resource is removed",
+			}},
+		},
+		{
+			name: "removed block with nested attribute",
+			old: &Schema{
+				Attributes: map[string]*Attribute{"policy.enabled": {Type: "bool", Optional: true}},
+				Blocks:     map[string]*Block{"policy": {Nesting: "list"}},
+			},
+			new: &Schema{Attributes: map[string]*Attribute{}},
+			want: []Change{{
+				Breaking: true,
+				Kind:     KindWarning,
+				Body:     "compute: block `policy` of `yandex_compute_disk` resource is removed",
+			}},
+		},
+		{
+			name: "optional becomes required",
+			old:  &Schema{Attributes: map[string]*Attribute{"zone": {Type: "string", Optional: true}}},
+			new:  &Schema{Attributes: map[string]*Attribute{"zone": {Type: "string", Required: true}}},
+			want: []Change{{
+				Breaking: true,
+				Kind:     KindWarning,
+				Body:     "compute: attribute `zone` of `yandex_compute_disk` resource becomes required",
+			}},
+		},
+		{
+			name: "required becomes optional",
+			old:  &Schema{Attributes: map[string]*Attribute{"zone": {Type: "string", Required: true}}},
+			new:  &Schema{Attributes: map[string]*Attribute{"zone": {Type: "string", Optional: true}}},
+			want: []Change{{
+				Kind: KindEnhancements,
+				Body: "compute: attribute `zone` of `yandex_compute_disk` resource becomes optional",
+			}},
+		},
+		{
+			name: "new required attribute",
+			old:  &Schema{Attributes: map[string]*Attribute{}},
+			new:  &Schema{Attributes: map[string]*Attribute{"zone": {Type: "string", Required: true}}},
+			want: []Change{{
+				Breaking: true,
+				Kind:     KindWarning,
+				Body:     "compute: new required attribute `zone` in `yandex_compute_disk` resource",
+			}},
+		},
+		{
+			name: "new optional attribute",
+			old:  &Schema{Attributes: map[string]*Attribute{}},
+			new:  &Schema{Attributes: map[string]*Attribute{"zone": {Type: "string", Optional: true}}},
+			want: []Change{{
+				Kind: KindEnhancements,
+				Body: "compute: new attribute `zone` in `yandex_compute_disk` resource",
+			}},
+		},
+		{
+			name: "type change",
+			old:  &Schema{Attributes: map[string]*Attribute{"size": {Type: "string", Optional: true}}},
+			new:  &Schema{Attributes: map[string]*Attribute{"size": {Type: "number", Optional: true}}},
+			want: []Change{{
+				Breaking: true,
+				Kind:     KindWarning,
+				Body:     "compute: type of attribute `size` of `yandex_compute_disk` resource is changed from `string` to `number`",
+			}},
+		},
+		{
+			name: "attribute becomes computed",
+			old:  &Schema{Attributes: map[string]*Attribute{"size": {Type: "number", Optional: true}}},
+			new:  &Schema{Attributes: map[string]*Attribute{"size": {Type: "number", Computed: true}}},
+			want: []Change{{
+				Breaking: true,
+				Kind:     KindWarning,
+				Body:     "compute: attribute `size` of `yandex_compute_disk` resource becomes computed and can't be set",
+			}},
+		},
+		{
+			name: "force new added",
+			old:  &Schema{Attributes: map[string]*Attribute{"zone": {Type: "string", Optional: true}}},
+			new:  &Schema{Attributes: map[string]*Attribute{"zone": {Type: "string", Optional: true, ForceNew: true}}},
+			want: []Change{{
+				Breaking: true,
+				Kind:     KindWarning,
+				Body:     "compute: changing attribute `zone` of `yandex_compute_disk` resource forces replacement",
+			}},
+		},
+		{
+			name:       "force new added to data source",
+			old:        &Schema{Attributes: map[string]*Attribute{"zone": {Type: "string", Optional: true}}},
+			new:        &Schema{Attributes: map[string]*Attribute{"zone": {Type: "string", Optional: true, ForceNew: true}}},
+			dataSource: true,
+		},
+		{
+			name: "force new removed",
+			old:  &Schema{Attributes: map[string]*Attribute{"zone": {Type: "string", Optional: true, ForceNew: true}}},
+			new:  &Schema{Attributes: map[string]*Attribute{"zone": {Type: "string", Optional: true}}},
+			want: []Change{{
+				Kind: KindEnhancements,
+				Body: "compute: attribute `zone` of `yandex_compute_disk` resource can be updated without replacement",
+			}},
+		},
+		{
+			name: "block force new added",
+			old: &Schema{
+				Attributes: map[string]*Attribute{},
+				Blocks:     map[string]*Block{"policy": {Nesting: "list"}},
+			},
+			new: &Schema{
+				Attributes: map[string]*Attribute{},
+				Blocks:     map[string]*Block{"policy": {Nesting: "list", ForceNew: true}},
+			},
+			want: []Change{{
+				Breaking: true,
+				Kind:     KindWarning,
+				Body:     "compute: changing block `policy` of `yandex_compute_disk` resource forces replacement",
+			}},
+		},
+		{
+			name: "attribute becomes sensitive",
+			old:  &Schema{Attributes: map[string]*Attribute{"key": {Type: "string", Optional: true}}},
+			new:  &Schema{Attributes: map[string]*Attribute{"key": {Type: "string", Optional: true, Sensitive: true}}},
+			want: []Change{{
+				Breaking: true,
+				Kind:     KindWarning,
+				Body:     "compute: attribute `key` of `yandex_compute_disk` resource becomes sensitive",
+			}},
+		},
+		{
+			name: "attribute deprecated",
+			old:  &Schema{Attributes: map[string]*Attribute{"key": {Type: "string", Optional: true}}},
+			new:  &Schema{Attributes: map[string]*Attribute{"key": {Type: "string", Optional: true, Deprecated: true}}},
+			want: []Change{{
+				Kind: KindWarning,
+				Body: "compute: deprecate attribute `key` of `yandex_compute_disk` resource",
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := &Dump{Resources: map[string]*Schema{}, DataSources: map[string]*Schema{}}
+			new := &Dump{Resources: map[string]*Schema{}, DataSources: map[string]*Schema{}}
+			if tt.dataSource {
+				old.DataSources[name], new.DataSources[name] = tt.old, tt.new
+			} else {
+				old.Resources[name], new.Resources[name] = tt.old, tt.new
+			}
+
+			assert.Equal(t, tt.want, Compare(old, new))
+		})
+	}
+}
+
+func TestCompare_ResourceAddedAndRemoved(t *testing.T) {
+	old := &Dump{Resources: map[string]*Schema{"yandex_compute_disk": {}}}
+	new := &Dump{DataSources: map[string]*Schema{"yandex_mdb_mysql_cluster": {}}}
+
+	assert.Equal(t, []Change{
+		{Breaking: true, Kind: KindWarning, Body: "compute: resource `yandex_compute_disk` is removed"},
+		{Kind: KindFeatures, Body: "mysql: new data source `yandex_mdb_mysql_cluster`"},
+	}, Compare(old, new))
+}
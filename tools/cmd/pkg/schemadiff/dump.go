@@ -0,0 +1,287 @@
+package schemadiff
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/muxserver"
+	"github.com/yandex-cloud/terraform-provider-yandex/version"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+)
+
+const providerName = "yandex"
+
+// Dump is the combined schema of SDKv2 and framework resources and data sources.
+// Attributes and blocks are flattened, nested ones are named by dot separated path (e.g. boot_disk.disk_id).
+type Dump struct {
+	Version     string             `json:"version"`
+	Resources   map[string]*Schema `json:"resources"`
+	DataSources map[string]*Schema `json:"data_sources"`
+}
+
+type Schema struct {
+	Attributes map[string]*Attribute `json:"attributes"`
+	Blocks     map[string]*Block     `json:"blocks,omitempty"`
+}
+
+type Attribute struct {
+	// Type is the type constraint of the attribute (e.g. string, list(string)),
+	// or nesting mode of nested attributes (e.g. nested_list).
+	Type       string `json:"type"`
+	Required   bool   `json:"required,omitempty"`
+	Optional   bool   `json:"optional,omitempty"`
+	Computed   bool   `json:"computed,omitempty"`
+	Sensitive  bool   `json:"sensitive,omitempty"`
+	Deprecated bool   `json:"deprecated,omitempty"`
+	ForceNew   bool   `json:"force_new,omitempty"`
+}
+
+type Block struct {
+	Nesting  string `json:"nesting"`
+	MinItems int64  `json:"min_items,omitempty"`
+	MaxItems int64  `json:"max_items,omitempty"`
+	ForceNew bool   `json:"force_new,omitempty"`
+}
+
+// Load builds the dump of the provider schema. Types, required and computed flags are taken
+// from the protocol schema of the mux server, ForceNew of SDKv2 schema and RequiresReplace plan
+// modifiers of framework schema aren't a part of the protocol, so they are taken from the providers.
+func Load(ctx context.Context) (*Dump, error) {
+	serverFactory, err := muxserver.NewProviderServer(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create provider server: %w", err)
+	}
+
+	resp, err := serverFactory().GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("get provider schema: %w", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			return nil, fmt.Errorf("get provider schema: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	dump := &Dump{
+		Version:     version.ProviderVersion,
+		Resources:   make(map[string]*Schema, len(resp.ResourceSchemas)),
+		DataSources: make(map[string]*Schema, len(resp.DataSourceSchemas)),
+	}
+	for name, s := range resp.ResourceSchemas {
+		dump.Resources[name] = fromProtocol(s.Block)
+	}
+	for name, s := range resp.DataSourceSchemas {
+		dump.DataSources[name] = fromProtocol(s.Block)
+	}
+
+	for name, r := range yandex.NewSDKProvider().ResourcesMap {
+		if s, ok := dump.Resources[name]; ok {
+			s.sdkForceNew(r.Schema, "")
+		}
+	}
+
+	for _, newResource := range yandex_framework.NewFrameworkProvider().Resources(ctx) {
+		r := newResource()
+
+		metadata := resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerName}, &metadata)
+		s, ok := dump.Resources[metadata.TypeName]
+		if !ok {
+			continue
+		}
+
+		schemaResp := resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+		s.frameworkForceNew(ctx, schemaResp.Schema.Attributes, schemaResp.Schema.Blocks, "")
+	}
+
+	return dump, nil
+}
+
+func fromProtocol(block *tfprotov6.SchemaBlock) *Schema {
+	s := &Schema{
+		Attributes: make(map[string]*Attribute),
+		Blocks:     make(map[string]*Block),
+	}
+	s.addBlock(block, "")
+	return s
+}
+
+func (s *Schema) addBlock(block *tfprotov6.SchemaBlock, prefix string) {
+	for _, a := range block.Attributes {
+		s.addAttribute(a, prefix)
+	}
+	for _, b := range block.BlockTypes {
+		s.Blocks[prefix+b.TypeName] = &Block{
+			Nesting:  strings.ToLower(b.Nesting.String()),
+			MinItems: b.MinItems,
+			MaxItems: b.MaxItems,
+		}
+		s.addBlock(b.Block, prefix+b.TypeName+".")
+	}
+}
+
+func (s *Schema) addAttribute(a *tfprotov6.SchemaAttribute, prefix string) {
+	attr := &Attribute{
+		Required:   a.Required,
+		Optional:   a.Optional,
+		Computed:   a.Computed,
+		Sensitive:  a.Sensitive,
+		Deprecated: a.Deprecated,
+	}
+	s.Attributes[prefix+a.Name] = attr
+
+	if a.NestedType != nil {
+		attr.Type = "nested_" + strings.ToLower(a.NestedType.Nesting.String())
+		for _, nested := range a.NestedType.Attributes {
+			s.addAttribute(nested, prefix+a.Name+".")
+		}
+		return
+	}
+	attr.Type = typeString(a.Type)
+}
+
+// typeString returns the type in the syntax of terraform type constraints (e.g. list(string)).
+func typeString(t tftypes.Type) string {
+	switch typ := t.(type) {
+	case nil:
+		return ""
+	case tftypes.List:
+		return "list(" + typeString(typ.ElementType) + ")"
+	case tftypes.Set:
+		return "set(" + typeString(typ.ElementType) + ")"
+	case tftypes.Map:
+		return "map(" + typeString(typ.ElementType) + ")"
+	case tftypes.Tuple:
+		elems := make([]string, 0, len(typ.ElementTypes))
+		for _, e := range typ.ElementTypes {
+			elems = append(elems, typeString(e))
+		}
+		return "tuple([" + strings.Join(elems, ", ") + "])"
+	case tftypes.Object:
+		names := make([]string, 0, len(typ.AttributeTypes))
+		for name := range typ.AttributeTypes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		attrs := make([]string, 0, len(names))
+		for _, name := range names {
+			attrs = append(attrs, name+" = "+typeString(typ.AttributeTypes[name]))
+		}
+		return "object({" + strings.Join(attrs, ", ") + "})"
+	}
+
+	switch {
+	case t.Is(tftypes.String):
+		return "string"
+	case t.Is(tftypes.Number):
+		return "number"
+	case t.Is(tftypes.Bool):
+		return "bool"
+	case t.Is(tftypes.DynamicPseudoType):
+		return "any"
+	}
+	return t.String()
+}
+
+func (s *Schema) sdkForceNew(attributes map[string]*sdkschema.Schema, prefix string) {
+	for name, a := range attributes {
+		path := prefix + name
+		if attr, ok := s.Attributes[path]; ok {
+			attr.ForceNew = a.ForceNew
+		}
+		if block, ok := s.Blocks[path]; ok {
+			block.ForceNew = a.ForceNew
+		}
+		if elem, ok := a.Elem.(*sdkschema.Resource); ok {
+			s.sdkForceNew(elem.Schema, path+".")
+		}
+	}
+}
+
+func (s *Schema) frameworkForceNew(ctx context.Context, attributes map[string]schema.Attribute, blocks map[string]schema.Block, prefix string) {
+	for name, a := range attributes {
+		path := prefix + name
+		if attr, ok := s.Attributes[path]; ok {
+			attr.ForceNew = requiresReplace(ctx, a)
+		}
+
+		switch nested := a.(type) {
+		case schema.SingleNestedAttribute:
+			s.frameworkForceNew(ctx, nested.Attributes, nil, path+".")
+		case schema.ListNestedAttribute:
+			s.frameworkForceNew(ctx, nested.NestedObject.Attributes, nil, path+".")
+		case schema.SetNestedAttribute:
+			s.frameworkForceNew(ctx, nested.NestedObject.Attributes, nil, path+".")
+		case schema.MapNestedAttribute:
+			s.frameworkForceNew(ctx, nested.NestedObject.Attributes, nil, path+".")
+		}
+	}
+
+	for name, b := range blocks {
+		path := prefix + name
+		switch nested := b.(type) {
+		case schema.SingleNestedBlock:
+			s.setBlockForceNew(path, modifiersRequireReplace(ctx, nested.PlanModifiers))
+			s.frameworkForceNew(ctx, nested.Attributes, nested.Blocks, path+".")
+		case schema.ListNestedBlock:
+			s.setBlockForceNew(path, modifiersRequireReplace(ctx, nested.PlanModifiers))
+			s.frameworkForceNew(ctx, nested.NestedObject.Attributes, nested.NestedObject.Blocks, path+".")
+		case schema.SetNestedBlock:
+			s.setBlockForceNew(path, modifiersRequireReplace(ctx, nested.PlanModifiers))
+			s.frameworkForceNew(ctx, nested.NestedObject.Attributes, nested.NestedObject.Blocks, path+".")
+		}
+	}
+}
+
+func (s *Schema) setBlockForceNew(path string, forceNew bool) {
+	if block, ok := s.Blocks[path]; ok {
+		block.ForceNew = forceNew
+	}
+}
+
+func requiresReplace(ctx context.Context, attr schema.Attribute) bool {
+	switch a := attr.(type) {
+	case interface{ BoolPlanModifiers() []planmodifier.Bool }:
+		return modifiersRequireReplace(ctx, a.BoolPlanModifiers())
+	case interface{ Float64PlanModifiers() []planmodifier.Float64 }:
+		return modifiersRequireReplace(ctx, a.Float64PlanModifiers())
+	case interface{ Int64PlanModifiers() []planmodifier.Int64 }:
+		return modifiersRequireReplace(ctx, a.Int64PlanModifiers())
+	case interface{ NumberPlanModifiers() []planmodifier.Number }:
+		return modifiersRequireReplace(ctx, a.NumberPlanModifiers())
+	case interface{ StringPlanModifiers() []planmodifier.String }:
+		return modifiersRequireReplace(ctx, a.StringPlanModifiers())
+	case interface{ ListPlanModifiers() []planmodifier.List }:
+		return modifiersRequireReplace(ctx, a.ListPlanModifiers())
+	case interface{ MapPlanModifiers() []planmodifier.Map }:
+		return modifiersRequireReplace(ctx, a.MapPlanModifiers())
+	case interface{ SetPlanModifiers() []planmodifier.Set }:
+		return modifiersRequireReplace(ctx, a.SetPlanModifiers())
+	case interface{ ObjectPlanModifiers() []planmodifier.Object }:
+		return modifiersRequireReplace(ctx, a.ObjectPlanModifiers())
+	}
+	return false
+}
+
+// modifiersRequireReplace reports whether there is RequiresReplace plan modifier,
+// they are recognized by the type name or the description of the framework modifiers.
+func modifiersRequireReplace[T planmodifier.Describer](ctx context.Context, modifiers []T) bool {
+	for _, m := range modifiers {
+		typeName := strings.ToLower(fmt.Sprintf("%T", m))
+		if strings.Contains(typeName, "requiresreplace") || strings.Contains(m.Description(ctx), "destroy and recreate") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/tools/cmd/pkg/schemadiff"
+)
+
+const usage = `Usage:
+  schema-diff dump [-o schema.json]
+      dumps the schema of the provider built from the sources
+  schema-diff compare [-changie-dir .changes/unreleased] [-fail-on-breaking] old.json new.json
+      prints changes between two dumps in the format of CHANGELOG.md
+`
+
+// Dumps combined schema of SDKv2 and framework resources and compares dumps of two provider versions,
+// so breaking changes of the schema are found before the release.
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "dump":
+		dump(os.Args[2:])
+	case "compare":
+		compare(os.Args[2:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+}
+
+func dump(args []string) {
+	flags := flag.NewFlagSet("dump", flag.ExitOnError)
+	output := flags.String("o", "", "output file, stdout by default")
+	_ = flags.Parse(args)
+
+	dump, err := schemadiff.Load(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to load provider schema: %v", err)
+	}
+
+	raw, err := json.MarshalIndent(dump, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal schema: %v", err)
+	}
+	raw = append(raw, '\n')
+
+	if *output == "" {
+		_, _ = os.Stdout.Write(raw)
+		return
+	}
+	if err := os.WriteFile(*output, raw, 0644); err != nil {
+		log.Fatalf("Failed to write schema to %s: %v", *output, err)
+	}
+}
+
+func compare(args []string) {
+	flags := flag.NewFlagSet("compare", flag.ExitOnError)
+	changieDir := flags.String("changie-dir", "", "directory to write changie entries to (e.g. .changes/unreleased)")
+	failOnBreaking := flags.Bool("fail-on-breaking", false, "exit with non-zero code if there are breaking changes")
+	_ = flags.Parse(args)
+	if flags.NArg() != 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	oldDump, err := readDump(flags.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	newDump, err := readDump(flags.Arg(1))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	changes := schemadiff.Compare(oldDump, newDump)
+	if err := schemadiff.WriteReport(os.Stdout, changes); err != nil {
+		log.Fatalf("Failed to write report: %v", err)
+	}
+
+	if *changieDir != "" {
+		if err := schemadiff.WriteChangie(*changieDir, changes, time.Now()); err != nil {
+			log.Fatalf("Failed to write changie entries: %v", err)
+		}
+	}
+
+	breaking := 0
+	for _, c := range changes {
+		if c.Breaking {
+			breaking++
+		}
+	}
+	fmt.Printf("Compared %s and %s, found %d changes, %d of them are breaking\n",
+		versionOf(oldDump), versionOf(newDump), len(changes), breaking)
+	if *failOnBreaking && breaking > 0 {
+		os.Exit(1)
+	}
+}
+
+func readDump(path string) (*schemadiff.Dump, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read schema dump: %w", err)
+	}
+
+	dump := &schemadiff.Dump{}
+	if err := json.Unmarshal(raw, dump); err != nil {
+		return nil, fmt.Errorf("parse schema dump (%s): %w", path, err)
+	}
+	return dump, nil
+}
+
+func versionOf(dump *schemadiff.Dump) string {
+	if dump.Version == "" {
+		return "unknown version"
+	}
+	return dump.Version
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/muxserver"
+	"github.com/yandex-cloud/terraform-provider-yandex/tools/cmd/pkg/examples"
+)
+
+const defaultExamplesDir = "examples"
+
+// Validates configuration examples against the schema of the provider built from the sources,
+// so examples placed into docs are checked without terraform and network access.
+func main() {
+	flag.Parse()
+	examplesDir := flag.Arg(0)
+	if examplesDir == "" {
+		examplesDir = defaultExamplesDir
+	}
+
+	ctx := context.Background()
+	serverFactory, err := muxserver.NewProviderServer(ctx)
+	if err != nil {
+		log.Fatalf("Failed to create provider server: %v", err)
+	}
+
+	validator, err := examples.NewValidator(ctx, serverFactory())
+	if err != nil {
+		log.Fatalf("Failed to load provider schema: %v", err)
+	}
+
+	diags, files, err := validator.ValidateDir(examplesDir)
+	if err != nil {
+		log.Fatalf("Failed to validate examples in %s: %v", examplesDir, err)
+	}
+
+	errorsCount := 0
+	for _, d := range diags {
+		if d.Severity == hcl.DiagError {
+			errorsCount++
+		}
+		if d.Subject != nil {
+			fmt.Printf("%s:%d:%d: %s: %s\n", d.Subject.Filename, d.Subject.Start.Line, d.Subject.Start.Column, d.Summary, d.Detail)
+		} else {
+			fmt.Printf("%s: %s\n", d.Summary, d.Detail)
+		}
+	}
+
+	fmt.Printf("Validated %d example files, found %d errors\n", files, errorsCount)
+	if errorsCount > 0 {
+		os.Exit(1)
+	}
+}
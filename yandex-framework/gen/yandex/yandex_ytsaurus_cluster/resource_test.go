@@ -10,12 +10,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/ytsaurus/v1"
 	ytsaurusv1sdk "github.com/yandex-cloud/go-sdk/services/ytsaurus/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_ytsaurus_cluster", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name:         "yandex_ytsaurus_cluster",
 		F:            testSweepCluster,
 		Dependencies: []string{},
@@ -25,7 +26,7 @@ func init() {
 // TestMain - add sweepers flag to the go test command
 // important for sweepers run.
 func TestMain(m *testing.M) {
-	resource.TestMain(m)
+	sweeper.TestMain(m)
 }
 
 func testSweepCluster(_ string) error {
@@ -15,6 +15,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/mitchellh/go-homedir"
+	endpointpb "github.com/yandex-cloud/go-genproto/yandex/cloud/endpoint"
 	ycsdk "github.com/yandex-cloud/go-sdk"
 	"github.com/yandex-cloud/go-sdk/iamkey"
 	"github.com/yandex-cloud/go-sdk/pkg/idempotency"
@@ -22,11 +23,18 @@ import (
 	"github.com/yandex-cloud/go-sdk/pkg/retry/v1"
 	ycsdkv2 "github.com/yandex-cloud/go-sdk/v2"
 	"github.com/yandex-cloud/go-sdk/v2/credentials"
+	"github.com/yandex-cloud/go-sdk/v2/pkg/endpoints"
 	iamkeyv2 "github.com/yandex-cloud/go-sdk/v2/pkg/iamkey"
 	"github.com/yandex-cloud/go-sdk/v2/pkg/options"
+	"github.com/yandex-cloud/go-sdk/v2/pkg/transport"
+	endpointsdk "github.com/yandex-cloud/go-sdk/v2/services/endpoint"
+	endpointssdk "github.com/yandex-cloud/go-sdk/v2/services/endpoints"
 	"google.golang.org/grpc"
+	grpccreds "google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/cassette"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/config"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/logging"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/yqsdk"
@@ -51,6 +59,8 @@ type State struct {
 	StorageEndpoint                types.String `tfsdk:"storage_endpoint"`
 	YMQEndpoint                    types.String `tfsdk:"ymq_endpoint"`
 	Region                         types.String `tfsdk:"region_id"`
+	DefaultLabels                  types.Map    `tfsdk:"default_labels"`
+	IamOnlineValidation            types.Bool   `tfsdk:"iam_online_validation"`
 
 	// These storage access keys are optional and only used when
 	// storage data/resource doesn't have own access keys explicitly specified.
@@ -145,6 +155,16 @@ func (c *Config) InitAndValidate(ctx context.Context, terraformVersion string, s
 		retryOptions,
 	}
 
+	// Record or replay API traffic of acceptance tests, see package cassette.
+	rec, err := cassette.FromEnv()
+	if err != nil {
+		return err
+	}
+	if rec != nil {
+		log.Printf("[INFO] API traffic is in %s mode with cassette %s", rec.Mode(), rec.Path())
+		grpcOptions = append(grpcOptions, rec.DialOptions()...)
+	}
+
 	c.SDK, err = ycsdk.Build(ctx, *yandexSDKConfig, grpcOptions...)
 	if err != nil {
 		return err
@@ -155,6 +175,14 @@ func (c *Config) InitAndValidate(ctx context.Context, terraformVersion string, s
 		options.WithDiscoveryEndpoint(c.ProviderState.Endpoint.ValueString()),
 		options.WithCustomDialOptions(grpcOptions...),
 	}
+	if rec != nil {
+		// The stock discovery of SDK v2 bypasses the dial options, so the cassette wouldn't see it.
+		endpointsResolver, err := c.endpointsResolverV2(ctx, grpcOptions)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, options.WithEndpointsResolver(endpointsResolver))
+	}
 	if c.ProviderState.Plaintext.ValueBool() {
 		opts = append(opts, options.WithPlaintext())
 	}
@@ -184,6 +212,36 @@ func (c *Config) InitAndValidate(ctx context.Context, terraformVersion string, s
 	return err
 }
 
+// endpointsResolverV2 discovers the endpoints for SDK v2 the same way SDK v2 does, but over a connection
+// with the dial options, so the discovery is recorded and replayed by the cassette.
+func (c *Config) endpointsResolverV2(ctx context.Context, grpcOptions []grpc.DialOption) (endpoints.EndpointsResolver, error) {
+	transportCredentials := grpc.WithTransportCredentials(grpccreds.NewTLS(&tls.Config{
+		InsecureSkipVerify: c.ProviderState.Insecure.ValueBool(),
+	}))
+	if c.ProviderState.Plaintext.ValueBool() {
+		transportCredentials = grpc.WithTransportCredentials(insecure.NewCredentials())
+	}
+
+	conn := transport.NewSingleConnector(c.ProviderState.Endpoint.ValueString(), append([]grpc.DialOption{transportCredentials}, grpcOptions...)...)
+	resp, err := endpointsdk.NewApiEndpointClient(conn).List(ctx, &endpointpb.ListApiEndpointsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list endpoints: %w", err)
+	}
+
+	addresses := make(map[string]string, len(resp.GetEndpoints()))
+	for _, ep := range resp.GetEndpoints() {
+		addresses[ep.GetId()] = ep.GetAddress()
+	}
+
+	prefixes := make(endpoints.PrefixToEndpoint, len(endpointssdk.DynamicEndpoints))
+	for prefix, id := range endpointssdk.DynamicEndpoints {
+		if address, ok := addresses[id]; ok {
+			prefixes[prefix] = endpoints.NewEndpointParams(address)
+		}
+	}
+	return endpoints.NewPrefixEndpointsResolver(prefixes), nil
+}
+
 func (c *Config) Credentials(ctx context.Context) (ycsdk.Credentials, error) {
 	if c.ProviderState.ServiceAccountKeyFileOrContent.ValueString() != "" {
 		contents, _, err := pathOrContents(c.ProviderState.ServiceAccountKeyFileOrContent.ValueString())
@@ -0,0 +1,527 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"golang.org/x/exp/maps"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/defaultlabels"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+const effectiveLabelsAttribute = "effective_labels"
+
+var (
+	_ resource.ResourceWithConfigure        = &defaultLabelsResource{}
+	_ resource.ResourceWithImportState      = &defaultLabelsResource{}
+	_ resource.ResourceWithModifyPlan       = &defaultLabelsResource{}
+	_ resource.ResourceWithValidateConfig   = &defaultLabelsResource{}
+	_ resource.ResourceWithConfigValidators = &defaultLabelsResource{}
+	_ resource.ResourceWithUpgradeState     = &defaultLabelsResource{}
+	_ resource.ResourceWithMoveState        = &defaultLabelsResource{}
+)
+
+// withDefaultLabels wraps resources that have a configurable top-level `labels` map,
+// so that provider `default_labels` are merged into them.
+func withDefaultLabels(ctx context.Context, resources []func() resource.Resource) []func() resource.Resource {
+	for i, newResource := range resources {
+		if hasConfigurableLabels(ctx, newResource()) {
+			resources[i] = func() resource.Resource {
+				return &defaultLabelsResource{Resource: newResource()}
+			}
+		}
+	}
+	return resources
+}
+
+func hasConfigurableLabels(ctx context.Context, r resource.Resource) bool {
+	resp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	labels, ok := resp.Schema.Attributes["labels"].(schema.MapAttribute)
+	if !ok || !labels.Optional || !labels.ElementType.Equal(types.StringType) {
+		return false
+	}
+	_, ok = resp.Schema.Attributes[effectiveLabelsAttribute]
+	return !ok
+}
+
+// defaultLabelsResource plans configured labels of the wrapped resource merged with the default ones as `effective_labels`.
+// The wrapped resource receives the merged value in `labels` of the plan on create and update, so the API gets all labels
+// without any changes in the resource code. Default labels reported by the API are stripped from `labels`
+// unless they are configured, and `effective_labels` holds all of them.
+//
+// The wrapped resource works with its own schema, `effective_labels` is removed from any data passed to it.
+type defaultLabelsResource struct {
+	resource.Resource
+
+	defaults map[string]string
+
+	innerSchema *schema.Schema
+	outerSchema *schema.Schema
+}
+
+func (r *defaultLabelsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	r.Resource.Schema(ctx, req, resp)
+
+	attrs := maps.Clone(resp.Schema.Attributes)
+	attrs[effectiveLabelsAttribute] = schema.MapAttribute{
+		MarkdownDescription: common.ResourceDescriptions["effective_labels"],
+		ElementType:         types.StringType,
+		Computed:            true,
+	}
+	resp.Schema.Attributes = attrs
+}
+
+func (r *defaultLabelsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if inner, ok := r.Resource.(resource.ResourceWithConfigure); ok {
+		inner.Configure(ctx, req, resp)
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok || providerConfig.ProviderState.DefaultLabels.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(providerConfig.ProviderState.DefaultLabels.ElementsAs(ctx, &r.defaults, false)...)
+}
+
+func (r *defaultLabelsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !resp.Plan.Raw.IsNull() {
+		labels := r.plannedEffectiveLabels(ctx, req.Config, req.State, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(effectiveLabelsAttribute), labels)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	effectiveLabels := attributeOf(resp.Plan.Raw, effectiveLabelsAttribute)
+
+	if inner, ok := r.Resource.(resource.ResourceWithModifyPlan); ok {
+		innerReq := resource.ModifyPlanRequest{
+			Config:       r.innerConfig(ctx, req.Config, &resp.Diagnostics),
+			State:        r.innerState(ctx, req.State, &resp.Diagnostics),
+			Plan:         r.innerPlan(ctx, resp.Plan, &resp.Diagnostics),
+			ProviderMeta: req.ProviderMeta,
+			Private:      req.Private,
+		}
+		innerResp := resource.ModifyPlanResponse{
+			Plan:            innerReq.Plan,
+			RequiresReplace: resp.RequiresReplace,
+			Private:         resp.Private,
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		inner.ModifyPlan(ctx, innerReq, &innerResp)
+		resp.Diagnostics.Append(innerResp.Diagnostics...)
+		resp.RequiresReplace = innerResp.RequiresReplace
+		resp.Private = innerResp.Private
+		resp.Plan.Raw = r.outerPlan(ctx, innerResp.Plan.Raw, effectiveLabels, &resp.Diagnostics)
+	}
+}
+
+// plannedEffectiveLabels returns configured labels merged with the default ones. If labels were computed by the wrapped
+// resource and are not configured, labels from the state are used instead of an empty map.
+func (r *defaultLabelsResource) plannedEffectiveLabels(ctx context.Context, config tfsdk.Config, state tfsdk.State, diags *diag.Diagnostics) types.Map {
+	var configLabels, stateLabels, stateEffectiveLabels types.Map
+	diags.Append(config.GetAttribute(ctx, path.Root("labels"), &configLabels)...)
+	if !state.Raw.IsNull() {
+		diags.Append(state.GetAttribute(ctx, path.Root("labels"), &stateLabels)...)
+		diags.Append(state.GetAttribute(ctx, path.Root(effectiveLabelsAttribute), &stateEffectiveLabels)...)
+	}
+	if diags.HasError() || !mapFullyKnown(configLabels) {
+		return types.MapUnknown(types.StringType)
+	}
+
+	keepUnconfigured := r.schemas(ctx).inner.Attributes["labels"].IsComputed()
+
+	base := configLabels
+	if base.IsNull() && keepUnconfigured {
+		if state.Raw.IsNull() && len(r.defaults) == 0 {
+			return types.MapUnknown(types.StringType)
+		}
+		if !stateLabels.IsUnknown() {
+			base = stateLabels
+		}
+	}
+
+	var labels map[string]string
+	if !base.IsNull() {
+		diags.Append(base.ElementsAs(ctx, &labels, false)...)
+	}
+	merged := defaultlabels.Merge(r.defaults, labels)
+
+	// Keep the prior value if it has the same labels, so that null and empty maps do not produce a diff.
+	if !stateEffectiveLabels.IsNull() && !stateEffectiveLabels.IsUnknown() {
+		var prior map[string]string
+		diags.Append(stateEffectiveLabels.ElementsAs(ctx, &prior, false)...)
+		if maps.Equal(prior, merged) {
+			return stateEffectiveLabels
+		}
+	}
+	if len(merged) == 0 && configLabels.IsNull() {
+		return configLabels
+	}
+
+	value, d := types.MapValueFrom(ctx, types.StringType, merged)
+	diags.Append(d...)
+	return value
+}
+
+func mapFullyKnown(m types.Map) bool {
+	if m.IsUnknown() {
+		return false
+	}
+	for _, v := range m.Elements() {
+		if v.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *defaultLabelsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	innerReq := resource.CreateRequest{
+		Config:       r.innerConfig(ctx, req.Config, &resp.Diagnostics),
+		Plan:         r.innerMergedPlan(ctx, req.Plan, &resp.Diagnostics),
+		ProviderMeta: req.ProviderMeta,
+	}
+	innerResp := resource.CreateResponse{
+		State:   r.innerState(ctx, resp.State, &resp.Diagnostics),
+		Private: resp.Private,
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.Resource.Create(ctx, innerReq, &innerResp)
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Private = innerResp.Private
+	resp.State.Raw = r.outerState(ctx, innerResp.State.Raw, attributeOf(req.Plan.Raw, "labels"), &resp.Diagnostics)
+}
+
+func (r *defaultLabelsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	innerReq := resource.ReadRequest{
+		State:        r.innerState(ctx, req.State, &resp.Diagnostics),
+		Private:      req.Private,
+		ProviderMeta: req.ProviderMeta,
+	}
+	innerResp := resource.ReadResponse{
+		State:   r.innerState(ctx, resp.State, &resp.Diagnostics),
+		Private: resp.Private,
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.Resource.Read(ctx, innerReq, &innerResp)
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Private = innerResp.Private
+	resp.State.Raw = r.outerState(ctx, innerResp.State.Raw, attributeOf(req.State.Raw, "labels"), &resp.Diagnostics)
+}
+
+func (r *defaultLabelsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	innerReq := resource.UpdateRequest{
+		Config:       r.innerConfig(ctx, req.Config, &resp.Diagnostics),
+		Plan:         r.innerMergedPlan(ctx, req.Plan, &resp.Diagnostics),
+		State:        r.innerState(ctx, req.State, &resp.Diagnostics),
+		ProviderMeta: req.ProviderMeta,
+		Private:      req.Private,
+	}
+	innerResp := resource.UpdateResponse{
+		State:   r.innerState(ctx, resp.State, &resp.Diagnostics),
+		Private: resp.Private,
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.Resource.Update(ctx, innerReq, &innerResp)
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Private = innerResp.Private
+	resp.State.Raw = r.outerState(ctx, innerResp.State.Raw, attributeOf(req.Plan.Raw, "labels"), &resp.Diagnostics)
+}
+
+func (r *defaultLabelsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	innerReq := resource.DeleteRequest{
+		State:        r.innerState(ctx, req.State, &resp.Diagnostics),
+		ProviderMeta: req.ProviderMeta,
+		Private:      req.Private,
+	}
+	innerResp := resource.DeleteResponse{
+		State:   r.innerState(ctx, resp.State, &resp.Diagnostics),
+		Private: resp.Private,
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.Resource.Delete(ctx, innerReq, &innerResp)
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Private = innerResp.Private
+	resp.State.Raw = r.outerState(ctx, innerResp.State.Raw, attributeOf(req.State.Raw, "labels"), &resp.Diagnostics)
+}
+
+func (r *defaultLabelsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	inner, ok := r.Resource.(resource.ResourceWithImportState)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Resource Import Not Implemented",
+			"This resource does not support import. Please contact the provider developer for additional information.",
+		)
+		return
+	}
+
+	innerResp := resource.ImportStateResponse{
+		State:   r.innerState(ctx, resp.State, &resp.Diagnostics),
+		Private: resp.Private,
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inner.ImportState(ctx, req, &innerResp)
+	resp.Diagnostics.Append(innerResp.Diagnostics...)
+	resp.Private = innerResp.Private
+	resp.State.Raw = r.outerState(ctx, innerResp.State.Raw, tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil), &resp.Diagnostics)
+}
+
+func (r *defaultLabelsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	inner, ok := r.Resource.(resource.ResourceWithValidateConfig)
+	if !ok {
+		return
+	}
+
+	innerReq := resource.ValidateConfigRequest{
+		Config: r.innerConfig(ctx, req.Config, &resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	inner.ValidateConfig(ctx, innerReq, resp)
+}
+
+func (r *defaultLabelsResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	if inner, ok := r.Resource.(resource.ResourceWithConfigValidators); ok {
+		return inner.ConfigValidators(ctx)
+	}
+	return nil
+}
+
+func (r *defaultLabelsResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	inner, ok := r.Resource.(resource.ResourceWithUpgradeState)
+	if !ok {
+		return nil
+	}
+
+	upgraders := inner.UpgradeState(ctx)
+	for version, upgrader := range upgraders {
+		upgradeState := upgrader.StateUpgrader
+		upgrader.StateUpgrader = func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			innerResp := resource.UpgradeStateResponse{
+				State: r.innerState(ctx, resp.State, &resp.Diagnostics),
+			}
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			upgradeState(ctx, req, &innerResp)
+			resp.Diagnostics.Append(innerResp.Diagnostics...)
+
+			raw := innerResp.State.Raw
+			if innerResp.DynamicValue != nil {
+				var err error
+				raw, err = innerResp.DynamicValue.Unmarshal(r.schemas(ctx).inner.Type().TerraformType(ctx))
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to Upgrade Resource State", err.Error())
+					return
+				}
+			}
+			resp.State.Raw = r.outerState(ctx, raw, attributeOf(raw, "labels"), &resp.Diagnostics)
+		}
+		upgraders[version] = upgrader
+	}
+	return upgraders
+}
+
+func (r *defaultLabelsResource) MoveState(ctx context.Context) []resource.StateMover {
+	inner, ok := r.Resource.(resource.ResourceWithMoveState)
+	if !ok {
+		return nil
+	}
+
+	movers := inner.MoveState(ctx)
+	for i, mover := range movers {
+		moveState := mover.StateMover
+		mover.StateMover = func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			innerResp := resource.MoveStateResponse{
+				TargetState:   r.innerState(ctx, resp.TargetState, &resp.Diagnostics),
+				TargetPrivate: resp.TargetPrivate,
+			}
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			moveState(ctx, req, &innerResp)
+			resp.Diagnostics.Append(innerResp.Diagnostics...)
+			resp.TargetPrivate = innerResp.TargetPrivate
+			resp.TargetState.Raw = r.outerState(ctx, innerResp.TargetState.Raw, attributeOf(innerResp.TargetState.Raw, "labels"), &resp.Diagnostics)
+		}
+		movers[i] = mover
+	}
+	return movers
+}
+
+type defaultLabelsSchemas struct {
+	inner, outer schema.Schema
+}
+
+func (r *defaultLabelsResource) schemas(ctx context.Context) defaultLabelsSchemas {
+	if r.innerSchema == nil {
+		inner := resource.SchemaResponse{}
+		r.Resource.Schema(ctx, resource.SchemaRequest{}, &inner)
+		outer := resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, &outer)
+		r.innerSchema, r.outerSchema = &inner.Schema, &outer.Schema
+	}
+	return defaultLabelsSchemas{inner: *r.innerSchema, outer: *r.outerSchema}
+}
+
+func (r *defaultLabelsResource) innerConfig(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) tfsdk.Config {
+	return tfsdk.Config{Schema: r.schemas(ctx).inner, Raw: r.innerValue(ctx, config.Raw, diags)}
+}
+
+func (r *defaultLabelsResource) innerPlan(ctx context.Context, plan tfsdk.Plan, diags *diag.Diagnostics) tfsdk.Plan {
+	return tfsdk.Plan{Schema: r.schemas(ctx).inner, Raw: r.innerValue(ctx, plan.Raw, diags)}
+}
+
+func (r *defaultLabelsResource) innerState(ctx context.Context, state tfsdk.State, diags *diag.Diagnostics) tfsdk.State {
+	return tfsdk.State{Schema: r.schemas(ctx).inner, Raw: r.innerValue(ctx, state.Raw, diags)}
+}
+
+// innerValue converts a resource object to the schema of the wrapped resource.
+func (r *defaultLabelsResource) innerValue(ctx context.Context, value tftypes.Value, diags *diag.Diagnostics) tftypes.Value {
+	return convertObject(value, r.schemas(ctx).inner.Type().TerraformType(ctx), diags, func(attrs map[string]tftypes.Value) {
+		delete(attrs, effectiveLabelsAttribute)
+	})
+}
+
+// innerMergedPlan converts a plan to the schema of the wrapped resource with `labels` replaced by `effective_labels`,
+// so that the wrapped resource sends all labels to the API.
+func (r *defaultLabelsResource) innerMergedPlan(ctx context.Context, plan tfsdk.Plan, diags *diag.Diagnostics) tfsdk.Plan {
+	raw := convertObject(plan.Raw, r.schemas(ctx).inner.Type().TerraformType(ctx), diags, func(attrs map[string]tftypes.Value) {
+		if effective := attrs[effectiveLabelsAttribute]; effective.IsKnown() && !effective.IsNull() {
+			attrs["labels"] = effective
+		}
+		delete(attrs, effectiveLabelsAttribute)
+	})
+	return tfsdk.Plan{Schema: r.schemas(ctx).inner, Raw: raw}
+}
+
+// outerPlan converts a plan of the wrapped resource to the schema with `effective_labels`.
+func (r *defaultLabelsResource) outerPlan(ctx context.Context, value, effectiveLabels tftypes.Value, diags *diag.Diagnostics) tftypes.Value {
+	return convertObject(value, r.schemas(ctx).outer.Type().TerraformType(ctx), diags, func(attrs map[string]tftypes.Value) {
+		attrs[effectiveLabelsAttribute] = effectiveLabels
+	})
+}
+
+// outerState converts a state of the wrapped resource to the schema with `effective_labels`. All labels of the resource
+// are saved as `effective_labels`, and default labels are stripped from `labels` unless they are present in owned,
+// i.e. configured or saved in the prior state.
+func (r *defaultLabelsResource) outerState(ctx context.Context, value, owned tftypes.Value, diags *diag.Diagnostics) tftypes.Value {
+	return convertObject(value, r.schemas(ctx).outer.Type().TerraformType(ctx), diags, func(attrs map[string]tftypes.Value) {
+		labels := attrs["labels"]
+		attrs[effectiveLabelsAttribute] = labels
+		attrs["labels"] = r.stripDefaultLabels(labels, owned, diags)
+	})
+}
+
+func (r *defaultLabelsResource) stripDefaultLabels(labels, owned tftypes.Value, diags *diag.Diagnostics) tftypes.Value {
+	if len(r.defaults) == 0 || labels.IsNull() || !labels.IsFullyKnown() {
+		return labels
+	}
+
+	all, err := stringMap(labels)
+	if err == nil && owned.IsKnown() {
+		var known map[string]string
+		if known, err = stringMap(owned); err == nil {
+			stripped := defaultlabels.Strip(all, r.defaults, known)
+			if len(stripped) == len(all) {
+				return labels
+			}
+			if len(stripped) == 0 && owned.IsNull() {
+				return owned
+			}
+			values := make(map[string]tftypes.Value, len(stripped))
+			for k, v := range stripped {
+				values[k] = tftypes.NewValue(tftypes.String, v)
+			}
+			return tftypes.NewValue(labels.Type(), values)
+		}
+	}
+	if err != nil {
+		diags.AddError("Unable to Convert Resource Data", fmt.Sprintf("Unable to convert labels: %s", err))
+	}
+	return labels
+}
+
+// attributeOf returns a top-level attribute of a resource object, or a null map if the object is null or unknown.
+func attributeOf(value tftypes.Value, name string) tftypes.Value {
+	var attrs map[string]tftypes.Value
+	if value.IsNull() || !value.IsKnown() || value.As(&attrs) != nil {
+		return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil)
+	}
+	return attrs[name]
+}
+
+func stringMap(value tftypes.Value) (map[string]string, error) {
+	var values map[string]tftypes.Value
+	if err := value.As(&values); err != nil {
+		return nil, err
+	}
+	m := make(map[string]string, len(values))
+	for k, v := range values {
+		if v.IsNull() || !v.IsKnown() {
+			continue
+		}
+		var s string
+		if err := v.As(&s); err != nil {
+			return nil, err
+		}
+		m[k] = s
+	}
+	return m, nil
+}
+
+func convertObject(value tftypes.Value, typ tftypes.Type, diags *diag.Diagnostics, convert func(map[string]tftypes.Value)) tftypes.Value {
+	if value.IsNull() {
+		return tftypes.NewValue(typ, nil)
+	}
+	if !value.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue)
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := value.As(&attrs); err != nil {
+		diags.AddError("Unable to Convert Resource Data", fmt.Sprintf("Unable to convert resource object: %s", err))
+		return tftypes.NewValue(typ, nil)
+	}
+	// The map is shared with the value, so it must not be changed in place.
+	attrs = maps.Clone(attrs)
+	convert(attrs)
+
+	if err := tftypes.ValidateValue(typ, attrs); err != nil {
+		diags.AddError("Unable to Convert Resource Data", fmt.Sprintf("Unable to convert resource object: %s", err))
+		return tftypes.NewValue(typ, nil)
+	}
+	return tftypes.NewValue(typ, attrs)
+}
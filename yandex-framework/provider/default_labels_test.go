@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testLabelsModel struct {
+	ID     types.String `tfsdk:"id"`
+	Labels types.Map    `tfsdk:"labels"`
+}
+
+// testLabelsResource reports apiLabels as labels of the resource on read, if set.
+type testLabelsResource struct {
+	apiLabels map[string]string
+}
+
+func (r *testLabelsResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "yandex_test"
+}
+
+func (r *testLabelsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":     schema.StringAttribute{Computed: true},
+			"labels": schema.MapAttribute{Optional: true, ElementType: types.StringType},
+		},
+	}
+}
+
+func (r *testLabelsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan testLabelsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	plan.ID = types.StringValue("id")
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *testLabelsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state testLabelsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if r.apiLabels != nil {
+		var d diag.Diagnostics
+		state.Labels, d = types.MapValueFrom(ctx, types.StringType, r.apiLabels)
+		resp.Diagnostics.Append(d...)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *testLabelsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan testLabelsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *testLabelsResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+func testLabelsValue(labels map[string]string) tftypes.Value {
+	typ := tftypes.Map{ElementType: tftypes.String}
+	if labels == nil {
+		return tftypes.NewValue(typ, nil)
+	}
+	values := make(map[string]tftypes.Value, len(labels))
+	for k, v := range labels {
+		values[k] = tftypes.NewValue(tftypes.String, v)
+	}
+	return tftypes.NewValue(typ, values)
+}
+
+func testLabelsObject(s schema.Schema, id tftypes.Value, labels, effective tftypes.Value) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":               id,
+		"labels":           labels,
+		"effective_labels": effective,
+	})
+}
+
+func testDefaultLabelsPlan(t *testing.T, r *defaultLabelsResource, state tftypes.Value, configLabels map[string]string) tfsdk.Plan {
+	ctx := context.Background()
+	s := r.schemas(ctx).outer
+	unknownMap := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue)
+
+	config := testLabelsObject(s, tftypes.NewValue(tftypes.String, nil), testLabelsValue(configLabels), tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil))
+	planLabels := testLabelsValue(configLabels)
+
+	id := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	if state.IsNull() {
+		state = tftypes.NewValue(s.Type().TerraformType(ctx), nil)
+	} else {
+		var attrs map[string]tftypes.Value
+		require.NoError(t, state.As(&attrs))
+		id = attrs["id"]
+	}
+
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: s, Raw: config},
+		State:  tfsdk.State{Schema: s, Raw: state},
+		Plan:   tfsdk.Plan{Schema: s, Raw: testLabelsObject(s, id, planLabels, unknownMap)},
+	}
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+
+	r.ModifyPlan(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	return resp.Plan
+}
+
+func TestDefaultLabelsResourceCreate(t *testing.T) {
+	ctx := context.Background()
+	r := &defaultLabelsResource{
+		Resource: &testLabelsResource{},
+		defaults: map[string]string{"env": "prod", "team": "core"},
+	}
+
+	plan := testDefaultLabelsPlan(t, r, tftypes.Value{}, map[string]string{"app": "web", "env": "test"})
+
+	var labels, effective map[string]string
+	require.False(t, plan.GetAttribute(ctx, path.Root("labels"), &labels).HasError())
+	require.False(t, plan.GetAttribute(ctx, path.Root("effective_labels"), &effective).HasError())
+	configured := map[string]string{"app": "web", "env": "test"}
+	expected := map[string]string{"app": "web", "env": "test", "team": "core"}
+	assert.Equal(t, configured, labels)
+	assert.Equal(t, expected, effective)
+
+	req := resource.CreateRequest{
+		Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+		Plan:   plan,
+	}
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+	r.Create(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var id string
+	var stateLabels, stateEffective map[string]string
+	require.False(t, resp.State.GetAttribute(ctx, path.Root("id"), &id).HasError())
+	require.False(t, resp.State.GetAttribute(ctx, path.Root("labels"), &stateLabels).HasError())
+	require.False(t, resp.State.GetAttribute(ctx, path.Root("effective_labels"), &stateEffective).HasError())
+	assert.Equal(t, "id", id)
+	// The wrapped resource received all labels, and default labels are kept out of `labels`.
+	assert.Equal(t, configured, stateLabels)
+	assert.Equal(t, expected, stateEffective)
+}
+
+func TestDefaultLabelsResourceRead(t *testing.T) {
+	ctx := context.Background()
+	r := &defaultLabelsResource{
+		Resource: &testLabelsResource{apiLabels: map[string]string{"app": "web", "env": "prod", "team": "core"}},
+		defaults: map[string]string{"env": "prod", "team": "core"},
+	}
+	s := r.schemas(ctx).outer
+
+	state := testLabelsObject(s, tftypes.NewValue(tftypes.String, "id"),
+		testLabelsValue(map[string]string{"app": "web", "team": "core"}), testLabelsValue(nil))
+	req := resource.ReadRequest{State: tfsdk.State{Schema: s, Raw: state}}
+	resp := resource.ReadResponse{State: req.State}
+	r.Read(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var labels, effective map[string]string
+	require.False(t, resp.State.GetAttribute(ctx, path.Root("labels"), &labels).HasError())
+	require.False(t, resp.State.GetAttribute(ctx, path.Root("effective_labels"), &effective).HasError())
+	// `team` is kept, since it is present in the prior state.
+	assert.Equal(t, map[string]string{"app": "web", "team": "core"}, labels)
+	assert.Equal(t, map[string]string{"app": "web", "env": "prod", "team": "core"}, effective)
+}
+
+func TestDefaultLabelsResourcePlanRemovedLabels(t *testing.T) {
+	ctx := context.Background()
+	r := &defaultLabelsResource{
+		Resource: &testLabelsResource{},
+		defaults: map[string]string{"env": "prod"},
+	}
+	s := r.schemas(ctx).outer
+
+	state := testLabelsObject(s, tftypes.NewValue(tftypes.String, "id"),
+		testLabelsValue(map[string]string{"app": "web"}), testLabelsValue(map[string]string{"app": "web", "env": "prod"}))
+
+	plan := testDefaultLabelsPlan(t, r, state, nil)
+	var labels, effective map[string]string
+	require.False(t, plan.GetAttribute(ctx, path.Root("labels"), &labels).HasError())
+	require.False(t, plan.GetAttribute(ctx, path.Root("effective_labels"), &effective).HasError())
+	assert.Empty(t, labels)
+	assert.Equal(t, map[string]string{"env": "prod"}, effective)
+}
+
+func TestDefaultLabelsResourcePlanWithoutChanges(t *testing.T) {
+	r := &defaultLabelsResource{
+		Resource: &testLabelsResource{},
+		defaults: map[string]string{"env": "prod"},
+	}
+	s := r.schemas(context.Background()).outer
+
+	state := testLabelsObject(s, tftypes.NewValue(tftypes.String, "id"),
+		testLabelsValue(map[string]string{"app": "web"}), testLabelsValue(map[string]string{"app": "web", "env": "prod"}))
+
+	plan := testDefaultLabelsPlan(t, r, state, map[string]string{"app": "web"})
+	assert.True(t, plan.Raw.Equal(state), "unexpected plan: %s", plan.Raw)
+
+	r.defaults = nil
+	state = testLabelsObject(s, tftypes.NewValue(tftypes.String, "id"), testLabelsValue(nil), testLabelsValue(nil))
+	plan = testDefaultLabelsPlan(t, r, state, nil)
+	assert.True(t, plan.Raw.Equal(state), "unexpected plan: %s", plan.Raw)
+}
+
+func TestDefaultLabelsResourceSchema(t *testing.T) {
+	ctx := context.Background()
+	assert.True(t, hasConfigurableLabels(ctx, &testLabelsResource{}))
+	assert.False(t, hasConfigurableLabels(ctx, &defaultLabelsResource{Resource: &testLabelsResource{}}))
+
+	resources := withDefaultLabels(ctx, []func() resource.Resource{
+		func() resource.Resource { return &testLabelsResource{} },
+	})
+	require.IsType(t, &defaultLabelsResource{}, resources[0]())
+
+	s := (&defaultLabelsResource{Resource: &testLabelsResource{}}).schemas(ctx)
+	assert.False(t, s.outer.Attributes["labels"].IsComputed())
+	assert.True(t, s.outer.Attributes["effective_labels"].IsComputed())
+	assert.NotContains(t, s.inner.Attributes, "effective_labels")
+}
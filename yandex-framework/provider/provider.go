@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -34,6 +35,7 @@ import (
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/datasphere_project"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/datasphere_project_iam_binding"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/gitlab_instance"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/kms_decrypt"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/kubernetes_marketplace_helm_release"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_clickhouse_database"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_clickhouse_user"
@@ -99,6 +101,8 @@ type Provider struct {
 	config      provider_config.Config
 }
 
+var _ provider.ProviderWithEphemeralResources = (*Provider)(nil)
+
 func NewFrameworkProvider() provider.Provider {
 	return &Provider{}
 }
@@ -148,6 +152,15 @@ func (p *Provider) Schema(ctx context.Context, req provider.SchemaRequest, resp
 				Optional:    true,
 				Description: common.Descriptions["zone"],
 			},
+			"default_labels": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: common.Descriptions["default_labels"],
+			},
+			"iam_online_validation": schema.BoolAttribute{
+				Optional:    true,
+				Description: common.Descriptions["iam_online_validation"],
+			},
 			"token": schema.StringAttribute{
 				Optional:    true,
 				Sensitive:   true,
@@ -226,9 +239,9 @@ func setToDefaultBoolIfNeeded(field types.Bool, osEnvName string, defaultVal boo
 		env := os.Getenv(osEnvName)
 		v, err := strconv.ParseBool(env)
 		if err != nil {
-			return types.BoolValue(v)
+			return types.BoolValue(defaultVal)
 		}
-		return types.BoolValue(defaultVal)
+		return types.BoolValue(v)
 	}
 	return field
 }
@@ -278,10 +291,11 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	}
 	resp.ResourceData = &p.config
 	resp.DataSourceData = &p.config
+	resp.EphemeralResourceData = &p.config
 }
 
-func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
-	return append([]func() resource.Resource{
+func (p *Provider) Resources(ctx context.Context) []func() resource.Resource {
+	return withDefaultLabels(ctx, append([]func() resource.Resource{
 		func() resource.Resource {
 			return billing_cloud_binding.NewResource(
 				billing_cloud_binding.BindingServiceInstanceCloudType,
@@ -289,8 +303,12 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		},
 		datasphere_project.NewResource,
 		datasphere_project_iam_binding.NewIamBinding,
+		datasphere_project_iam_binding.NewIamMember,
+		datasphere_project_iam_binding.NewIamPolicy,
 		datasphere_community.NewResource,
 		datasphere_community_iam_binding.NewIamBinding,
+		datasphere_community_iam_binding.NewIamMember,
+		datasphere_community_iam_binding.NewIamPolicy,
 		mdb_clickhouse_database.NewResource,
 		mdb_clickhouse_user.NewResource,
 		mdb_greenplum_resource_group.NewResource,
@@ -299,14 +317,32 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		mdb_mongodb_user.NewResource,
 		mdb_opensearch_cluster.NewResource,
 		compute_disk_iam_binding.NewIamBinding,
+		compute_disk_iam_binding.NewIamMember,
+		compute_disk_iam_binding.NewIamPolicy,
 		compute_disk_placement_group_iam_binding.NewIamBinding,
+		compute_disk_placement_group_iam_binding.NewIamMember,
+		compute_disk_placement_group_iam_binding.NewIamPolicy,
 		compute_filesystem_iam_binding.NewIamBinding,
+		compute_filesystem_iam_binding.NewIamMember,
+		compute_filesystem_iam_binding.NewIamPolicy,
 		compute_gpu_cluster_iam_binding.NewIamBinding,
+		compute_gpu_cluster_iam_binding.NewIamMember,
+		compute_gpu_cluster_iam_binding.NewIamPolicy,
 		compute_image_iam_binding.NewIamBinding,
+		compute_image_iam_binding.NewIamMember,
+		compute_image_iam_binding.NewIamPolicy,
 		compute_instance_iam_binding.NewIamBinding,
+		compute_instance_iam_binding.NewIamMember,
+		compute_instance_iam_binding.NewIamPolicy,
 		compute_placement_group_iam_binding.NewIamBinding,
+		compute_placement_group_iam_binding.NewIamMember,
+		compute_placement_group_iam_binding.NewIamPolicy,
 		compute_snapshot_iam_binding.NewIamBinding,
+		compute_snapshot_iam_binding.NewIamMember,
+		compute_snapshot_iam_binding.NewIamPolicy,
 		compute_snapshot_schedule_iam_binding.NewIamBinding,
+		compute_snapshot_schedule_iam_binding.NewIamMember,
+		compute_snapshot_schedule_iam_binding.NewIamPolicy,
 		airflow_cluster.NewResource,
 		metastore_cluster.NewResource,
 		vpc_security_group_rule.NewResource,
@@ -327,11 +363,13 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		yq_yds_binding.NewResource,
 		storage_bucket_grant.NewResource,
 		storage_bucket_iam_binding.NewIamBinding,
+		storage_bucket_iam_binding.NewIamMember,
+		storage_bucket_iam_binding.NewIamPolicy,
 		storage_bucket_policy.NewResource,
 		mdb_sharded_postgresql_cluster.NewShardedPostgreSQLClusterResource,
 		mdb_sharded_postgresql_user.NewShardedPostgreSQLUserResource,
 		mdb_sharded_postgresql_database.NewShardedPostgreSQLDatabaseResource,
-	}, yandex_gen.GetProviderResources()...)
+	}, yandex_gen.GetProviderResources()...))
 }
 
 func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource {
@@ -362,6 +400,12 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 	}, yandex_gen.GetProviderDataSources()...)
 }
 
+func (p *Provider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		kms_decrypt.NewEphemeralResource,
+	}
+}
+
 func (p *Provider) GetConfig() provider_config.Config {
 	return p.config
 }
@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetToDefaultBoolIfNeeded(t *testing.T) {
+	cases := []struct {
+		name     string
+		field    types.Bool
+		env      string
+		expected bool
+	}{
+		{name: "unset without env", field: types.BoolNull(), expected: false},
+		{name: "unset with env", field: types.BoolNull(), env: "true", expected: true},
+		{name: "unset with invalid env", field: types.BoolNull(), env: "yes please", expected: false},
+		{name: "explicit false with env", field: types.BoolValue(false), env: "1", expected: false},
+		{name: "explicit true without env", field: types.BoolValue(true), expected: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Setenv("YC_INSECURE", c.env)
+			assert.Equal(t, types.BoolValue(c.expected), setToDefaultBoolIfNeeded(c.field, "YC_INSECURE", false))
+		})
+	}
+}
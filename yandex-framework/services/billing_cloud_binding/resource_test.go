@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/billing/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
 	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
@@ -32,7 +33,7 @@ func billingInstanceTestSecondBillingAccountId() string {
 const yandexBillingServiceInstanceBindingDefaultTimeout = 1 * time.Minute
 
 func init() {
-	resource.AddTestSweepers("yandex_billing_cloud_binding", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_billing_cloud_binding",
 		F:    testSweepBillingCloudBinding,
 		Dependencies: []string{
@@ -44,7 +45,7 @@ func init() {
 // TestMain - add sweepers flag to the go test command
 // important for sweepers run.
 func TestMain(m *testing.M) {
-	resource.TestMain(m)
+	sweeper.TestMain(m)
 }
 
 func testSweepBillingCloudBinding(_ string) error {
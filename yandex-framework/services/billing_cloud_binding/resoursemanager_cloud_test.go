@@ -8,8 +8,8 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -23,7 +23,7 @@ const (
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_resourcemanager_cloud", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name:         "yandex_resourcemanager_cloud",
 		F:            testSweepClouds,
 		Dependencies: []string{},
@@ -70,6 +70,57 @@ func TestAccComputeDisk_basicIamMember(t *testing.T) {
 	})
 }
 
+// TestAccComputeDisk_iamMemberAndPolicy replays testdata/cassettes/TestAccComputeDisk_iamMemberAndPolicy.json
+// with TF_ACC_REPLAY=1. The cassette is recorded against fakecloud, not the real API, so the replay pins
+// the requests of the provider but the responses are only as accurate as fakecloud is.
+func TestAccComputeDisk_iamMemberAndPolicy(t *testing.T) {
+	c := test.UseCassette(t)
+
+	var (
+		disk        compute.Disk
+		name        = c.Value("name", func() string { return acctest.RandomWithPrefix(test.TestPrefix()) })
+		ctx, cancel = context.WithTimeout(context.Background(), timeout)
+	)
+
+	defer cancel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckComputeDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeDiskWithIAMMemberResource(name, "viewer", "allAuthenticatedUsers"),
+				Check: resource.ComposeTestCheckFunc(
+					test.TestAccCheckComputeDiskExists("yandex_compute_disk.foobar", &disk, timeout),
+					test.TestAccCheckIamBindingExists(ctx, func() test.BindingsGetter {
+						cfg := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+						return cfg.SDK.Compute().Disk()
+					}, &disk, "viewer", []string{"system:allAuthenticatedUsers"}),
+				),
+			},
+			{
+				ResourceName: "yandex_compute_disk_iam_member.test-disk-member",
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return disk.Id + ",viewer,system:allAuthenticatedUsers", nil
+				},
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "disk_id",
+			},
+			{
+				Config: testAccComputeDiskWithIAMPolicy(name, "editor", "allAuthenticatedUsers"),
+				Check: resource.ComposeTestCheckFunc(
+					test.TestAccCheckIamBindingExists(ctx, func() test.BindingsGetter {
+						cfg := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+						return cfg.SDK.Compute().Disk()
+					}, &disk, "editor", []string{"system:allAuthenticatedUsers"}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeDiskDestroy(s *terraform.State) error {
 	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
 
@@ -115,3 +166,41 @@ resource "yandex_compute_disk_iam_binding" "test-disk-binding" {
 
 `, diskName, role, userID)
 }
+
+func testAccComputeDiskWithIAMMemberResource(diskName, role, userID string) string {
+	return fmt.Sprintf(`
+resource "yandex_compute_disk" "foobar" {
+  name = "%s"
+  size = 4
+  type = "network-hdd"
+}
+
+resource "yandex_compute_disk_iam_member" "test-disk-member" {
+  role    = "%s"
+  member  = "system:%s"
+  disk_id = yandex_compute_disk.foobar.id
+}
+`, diskName, role, userID)
+}
+
+func testAccComputeDiskWithIAMPolicy(diskName, role, userID string) string {
+	return fmt.Sprintf(`
+resource "yandex_compute_disk" "foobar" {
+  name = "%s"
+  size = 4
+  type = "network-hdd"
+}
+
+data "yandex_iam_policy" "disk" {
+  binding {
+    role    = "%s"
+    members = ["system:%s"]
+  }
+}
+
+resource "yandex_compute_disk_iam_policy" "test-disk-policy" {
+  policy_data = data.yandex_iam_policy.disk.policy_data
+  disk_id     = yandex_compute_disk.foobar.id
+}
+`, diskName, role, userID)
+}
@@ -25,6 +25,14 @@ func NewIamBinding() resource.Resource {
 	return accessbinding.NewIamBinding(newIAMUpdater())
 }
 
+func NewIamMember() resource.Resource {
+	return accessbinding.NewIamMember(newIAMUpdater())
+}
+
+func NewIamPolicy() resource.Resource {
+	return accessbinding.NewIamPolicy(newIAMUpdater())
+}
+
 func newIAMUpdater() accessbinding.ResourceIamUpdater {
 	return &IAMUpdater{}
 }
@@ -13,10 +13,11 @@ import (
 	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	//dataspheretest "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/tests/datasphere"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_datasphere_community", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name:         "yandex_datasphere_community",
 		F:            testSweepCommunity,
 		Dependencies: []string{},
@@ -26,7 +27,7 @@ func init() {
 // TestMain - add sweepers flag to the go test command
 // important for sweepers run.
 func TestMain(m *testing.M) {
-	resource.TestMain(m)
+	sweeper.TestMain(m)
 }
 
 func testSweepCommunity(_ string) error {
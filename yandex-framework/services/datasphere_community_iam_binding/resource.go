@@ -23,6 +23,14 @@ func NewIamBinding() resource.Resource {
 	return accessbinding.NewIamBinding(newCommunityIamUpdater())
 }
 
+func NewIamMember() resource.Resource {
+	return accessbinding.NewIamMember(newCommunityIamUpdater())
+}
+
+func NewIamPolicy() resource.Resource {
+	return accessbinding.NewIamPolicy(newCommunityIamUpdater())
+}
+
 func newCommunityIamUpdater() accessbinding.ResourceIamUpdater {
 	return &CommunityIAMUpdater{}
 }
@@ -15,11 +15,12 @@ import (
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 
 	//dataspheretest "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/tests/datasphere"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 	"google.golang.org/grpc/codes"
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_datasphere_project", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name:         "yandex_datasphere_project",
 		F:            testSweepProject,
 		Dependencies: []string{},
@@ -29,7 +30,7 @@ func init() {
 // TestMain - add sweepers flag to the go test command
 // important for sweepers run.
 func TestMain(m *testing.M) {
-	resource.TestMain(m)
+	sweeper.TestMain(m)
 }
 
 func testSweepProject(_ string) error {
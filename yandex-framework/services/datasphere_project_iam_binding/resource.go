@@ -23,6 +23,14 @@ func NewIamBinding() resource.Resource {
 	return accessbinding.NewIamBinding(newProjectIamUpdater())
 }
 
+func NewIamMember() resource.Resource {
+	return accessbinding.NewIamMember(newProjectIamUpdater())
+}
+
+func NewIamPolicy() resource.Resource {
+	return accessbinding.NewIamPolicy(newProjectIamUpdater())
+}
+
 func newProjectIamUpdater() accessbinding.ResourceIamUpdater {
 	return &ProjectIAMUpdater{}
 }
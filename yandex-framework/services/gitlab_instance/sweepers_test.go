@@ -8,8 +8,8 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/gitlab/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -22,7 +22,7 @@ const (
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_gitlab_instance", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_gitlab_instance",
 		F:    testSweepGitlabInstance,
 	})
@@ -31,7 +31,7 @@ func init() {
 // TestMain - add sweepers flag to the go test command
 // important for sweepers run.
 func TestMain(m *testing.M) {
-	resource.TestMain(m)
+	sweeper.TestMain(m)
 }
 
 func testSweepGitlabInstance(_ string) error {
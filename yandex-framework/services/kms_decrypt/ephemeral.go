@@ -0,0 +1,116 @@
+package kms_decrypt
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1"
+
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = (*kmsDecryptEphemeralResource)(nil)
+
+var pathCiphertext = path.Root("ciphertext")
+
+type kmsDecryptEphemeralResource struct {
+	providerConfig *provider_config.Config
+}
+
+type kmsDecryptModel struct {
+	KeyID      types.String `tfsdk:"key_id"`
+	Ciphertext types.String `tfsdk:"ciphertext"`
+	AadContext types.String `tfsdk:"aad_context"`
+	Plaintext  types.String `tfsdk:"plaintext"`
+}
+
+func NewEphemeralResource() ephemeral.EphemeralResource {
+	return &kmsDecryptEphemeralResource{}
+}
+
+func (r *kmsDecryptEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_decrypt"
+}
+
+func (r *kmsDecryptEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *kmsDecryptEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Decrypts ciphertext produced by a Yandex KMS symmetric key, e.g. by `yandex_kms_secret_ciphertext` or `yc kms symmetric-crypto encrypt`. For more information, see [the official documentation](https://yandex.cloud/docs/kms/concepts/).\n\n" +
+			"The plaintext is never stored in the plan or state. Ephemeral resources are supported by Terraform 1.10 and later.\n",
+		Attributes: map[string]schema.Attribute{
+			"key_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the symmetric KMS key to use for decryption.",
+				Required:            true,
+			},
+			"ciphertext": schema.StringAttribute{
+				MarkdownDescription: "Ciphertext to be decrypted, encoded with `standard` base64 alphabet as defined in RFC 4648 section 4.",
+				Required:            true,
+			},
+			"aad_context": schema.StringAttribute{
+				MarkdownDescription: "Additional authenticated data (AAD context) which was specified during encryption.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(8192),
+				},
+			},
+			"plaintext": schema.StringAttribute{
+				MarkdownDescription: "Decrypted plaintext.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *kmsDecryptEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data kmsDecryptModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(data.Ciphertext.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(pathCiphertext, "Invalid ciphertext", fmt.Sprintf("Error decoding ciphertext: %s", err))
+		return
+	}
+
+	decrypted, err := r.providerConfig.SDK.KMSCrypto().SymmetricCrypto().Decrypt(ctx, &kms.SymmetricDecryptRequest{
+		KeyId:      data.KeyID.ValueString(),
+		Ciphertext: ciphertext,
+		AadContext: []byte(data.AadContext.ValueString()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to decrypt data",
+			fmt.Sprintf("Error while requesting API to decrypt data with KMS symmetric key: %s", err),
+		)
+		return
+	}
+
+	data.Plaintext = types.StringValue(string(decrypted.Plaintext))
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
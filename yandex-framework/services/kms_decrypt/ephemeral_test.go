@@ -0,0 +1,44 @@
+package kms_decrypt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKMSDecryptEphemeralResourceSchema(t *testing.T) {
+	ctx := context.Background()
+	resp := &ephemeral.SchemaResponse{}
+	NewEphemeralResource().Schema(ctx, ephemeral.SchemaRequest{}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	require.False(t, resp.Schema.ValidateImplementation(ctx).HasError())
+
+	assert.True(t, resp.Schema.Attributes["plaintext"].IsSensitive())
+	assert.True(t, resp.Schema.Attributes["plaintext"].IsComputed())
+}
+
+func TestKMSDecryptEphemeralResourceInvalidCiphertext(t *testing.T) {
+	ctx := context.Background()
+	r := NewEphemeralResource()
+	schemaResp := &ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+
+	typ := schemaResp.Schema.Type().TerraformType(ctx)
+	config := tftypes.NewValue(typ, map[string]tftypes.Value{
+		"key_id":      tftypes.NewValue(tftypes.String, "key-id"),
+		"ciphertext":  tftypes.NewValue(tftypes.String, "not base64!"),
+		"aad_context": tftypes.NewValue(tftypes.String, nil),
+		"plaintext":   tftypes.NewValue(tftypes.String, nil),
+	})
+
+	resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, nil)}}
+	r.(*kmsDecryptEphemeralResource).Open(ctx, ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config}}, resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Invalid ciphertext", resp.Diagnostics[0].Summary())
+}
@@ -0,0 +1,56 @@
+package kms_decrypt_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/muxserver"
+)
+
+const typeName = "yandex_kms_decrypt"
+
+// TestKMSDecryptEphemeralResourceProviderServer opens the ephemeral resource through the server of the provider
+// binary, which terraform-plugin-go serves only if it implements the ephemeral resource methods.
+func TestKMSDecryptEphemeralResourceProviderServer(t *testing.T) {
+	ctx := context.Background()
+
+	serverFactory, err := muxserver.NewProviderServer(ctx)
+	require.NoError(t, err)
+
+	server, ok := serverFactory().(tfprotov6.ProviderServerWithEphemeralResources)
+	require.True(t, ok, "the provider server doesn't serve ephemeral resources")
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	schema, ok := schemaResp.EphemeralResourceSchemas[typeName]
+	require.True(t, ok, "%s is not in the provider schema", typeName)
+
+	typ := schema.ValueType()
+	config, err := tfprotov6.NewDynamicValue(typ, tftypes.NewValue(typ, map[string]tftypes.Value{
+		"key_id":      tftypes.NewValue(tftypes.String, "key-id"),
+		"ciphertext":  tftypes.NewValue(tftypes.String, "not base64!"),
+		"aad_context": tftypes.NewValue(tftypes.String, nil),
+		"plaintext":   tftypes.NewValue(tftypes.String, nil),
+	}))
+	require.NoError(t, err)
+
+	validateResp, err := server.ValidateEphemeralResourceConfig(ctx, &tfprotov6.ValidateEphemeralResourceConfigRequest{
+		TypeName: typeName,
+		Config:   &config,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, validateResp.Diagnostics)
+
+	openResp, err := server.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: typeName,
+		Config:   &config,
+	})
+	require.NoError(t, err)
+	require.Len(t, openResp.Diagnostics, 1)
+	assert.Equal(t, "Invalid ciphertext", openResp.Diagnostics[0].Summary)
+}
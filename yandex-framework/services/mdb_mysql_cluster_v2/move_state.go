@@ -0,0 +1,128 @@
+package mdb_mysql_cluster_v2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+const movedClusterSourceTypeName = "yandex_mdb_mysql_cluster"
+
+// movedCluster is the state of the SDKv2 yandex_mdb_mysql_cluster resource.
+// Users and databases were deprecated in it and are managed by separate resources, so they are not moved.
+type movedCluster struct {
+	Id                     string                             `json:"id"`
+	FolderId               string                             `json:"folder_id"`
+	NetworkId              string                             `json:"network_id"`
+	Name                   string                             `json:"name"`
+	Description            string                             `json:"description"`
+	Environment            string                             `json:"environment"`
+	Labels                 map[string]string                  `json:"labels"`
+	SecurityGroupIds       []string                           `json:"security_group_ids"`
+	DeletionProtection     bool                               `json:"deletion_protection"`
+	Version                string                             `json:"version"`
+	Resources              []mdbcommon.MovedResources         `json:"resources"`
+	BackupRetainPeriodDays int64                              `json:"backup_retain_period_days"`
+	BackupWindowStart      []mdbcommon.MovedBackupWindow      `json:"backup_window_start"`
+	MaintenanceWindow      []mdbcommon.MovedMaintenanceWindow `json:"maintenance_window"`
+	Hosts                  []movedHost                        `json:"host"`
+	Access                 []struct {
+		DataLens     bool `json:"data_lens"`
+		WebSql       bool `json:"web_sql"`
+		DataTransfer bool `json:"data_transfer"`
+	} `json:"access"`
+	PerformanceDiagnostics []struct {
+		Enabled                    bool  `json:"enabled"`
+		SessionsSamplingInterval   int64 `json:"sessions_sampling_interval"`
+		StatementsSamplingInterval int64 `json:"statements_sampling_interval"`
+	} `json:"performance_diagnostics"`
+	MySQLConfig map[string]string `json:"mysql_config"`
+}
+
+type movedHost struct {
+	Zone              string `json:"zone"`
+	SubnetId          string `json:"subnet_id"`
+	AssignPublicIp    bool   `json:"assign_public_ip"`
+	FQDN              string `json:"fqdn"`
+	Name              string `json:"name"`
+	ReplicationSource string `json:"replication_source"`
+}
+
+func (r *clusterResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{StateMover: moveClusterState},
+	}
+}
+
+// moveClusterState translates the state of yandex_mdb_mysql_cluster. Hosts are keyed by their `name`,
+// or by the first label of their FQDN if the name is not set.
+func moveClusterState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	var src movedCluster
+	if !mdbcommon.DecodeMovedState(req, movedClusterSourceTypeName, &src, &resp.Diagnostics) || resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := Cluster{
+		Id:                     types.StringValue(src.Id),
+		FolderId:               types.StringValue(src.FolderId),
+		NetworkId:              types.StringValue(src.NetworkId),
+		Name:                   types.StringValue(src.Name),
+		Description:            types.StringValue(src.Description),
+		Environment:            types.StringValue(src.Environment),
+		Labels:                 mdbcommon.FlattenMapString(ctx, src.Labels, &resp.Diagnostics),
+		SecurityGroupIds:       mdbcommon.FlattenSetString(ctx, src.SecurityGroupIds, &resp.Diagnostics),
+		DeletionProtection:     types.BoolValue(src.DeletionProtection),
+		Version:                types.StringValue(src.Version),
+		Resources:              mdbcommon.MoveResources(ctx, src.Resources, &resp.Diagnostics),
+		BackupRetainPeriodDays: types.Int64Value(src.BackupRetainPeriodDays),
+		BackupWindowStart:      mdbcommon.MoveBackupWindowStart(ctx, src.BackupWindowStart, &resp.Diagnostics),
+		MaintenanceWindow:      mdbcommon.MoveMaintenanceWindow(ctx, src.MaintenanceWindow, &resp.Diagnostics),
+		HostSpecs:              moveHosts(ctx, src.Hosts, &resp.Diagnostics),
+		MySQLConfig:            mdbcommon.MoveSettingsMap(ctx, src.MySQLConfig, NewMsSettingsMapType(), &resp.Diagnostics),
+		Access:                 types.ObjectNull(AccessAttrTypes),
+		PerformanceDiagnostics: types.ObjectNull(PerformanceDiagnosticsAttrTypes),
+	}
+
+	var d diag.Diagnostics
+	if len(src.Access) > 0 {
+		state.Access, d = types.ObjectValueFrom(ctx, AccessAttrTypes, Access{
+			DataLens:     types.BoolValue(src.Access[0].DataLens),
+			WebSql:       types.BoolValue(src.Access[0].WebSql),
+			DataTransfer: types.BoolValue(src.Access[0].DataTransfer),
+		})
+		resp.Diagnostics.Append(d...)
+	}
+	if len(src.PerformanceDiagnostics) > 0 {
+		state.PerformanceDiagnostics, d = types.ObjectValueFrom(ctx, PerformanceDiagnosticsAttrTypes, PerformanceDiagnostics{
+			Enabled:                    types.BoolValue(src.PerformanceDiagnostics[0].Enabled),
+			SessionsSamplingInterval:   types.Int64Value(src.PerformanceDiagnostics[0].SessionsSamplingInterval),
+			StatementsSamplingInterval: types.Int64Value(src.PerformanceDiagnostics[0].StatementsSamplingInterval),
+		})
+		resp.Diagnostics.Append(d...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, &state)...)
+}
+
+func moveHosts(ctx context.Context, hosts []movedHost, diags *diag.Diagnostics) types.Map {
+	specs := make(map[string]Host, len(hosts))
+	for _, h := range hosts {
+		specs[mdbcommon.MovedHostLabel(h.Name, h.FQDN)] = Host{
+			Zone:              types.StringValue(h.Zone),
+			SubnetId:          types.StringValue(h.SubnetId),
+			AssignPublicIp:    types.BoolValue(h.AssignPublicIp),
+			FQDN:              types.StringValue(h.FQDN),
+			ReplicationSource: types.StringValue(h.ReplicationSource),
+		}
+	}
+
+	m, d := types.MapValueFrom(ctx, hostType, specs)
+	diags.Append(d...)
+	return m
+}
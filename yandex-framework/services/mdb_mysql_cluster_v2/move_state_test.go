@@ -0,0 +1,109 @@
+package mdb_mysql_cluster_v2
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestYandexProvider_MDBMySQLClusterMoveState(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	raw, err := os.ReadFile("testdata/mysql_cluster_v1_state.json")
+	if err != nil {
+		t.Fatalf("failed to read recorded state: %s", err)
+	}
+
+	r := &clusterResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	req := resource.MoveStateRequest{
+		SourceTypeName:        movedClusterSourceTypeName,
+		SourceProviderAddress: "registry.terraform.io/yandex-cloud/yandex",
+		SourceRawState:        &tfprotov6.RawState{JSON: raw},
+	}
+	resp := &resource.MoveStateResponse{
+		TargetState: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+
+	moveClusterState(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics.Errors())
+	}
+
+	var cluster Cluster
+	if d := resp.TargetState.Get(ctx, &cluster); d.HasError() {
+		t.Fatalf("failed to read moved state: %v", d.Errors())
+	}
+
+	if cluster.Id.ValueString() != "c9qbmq5a3tcbcp4b7h9n" || cluster.Version.ValueString() != "8.0" || cluster.BackupRetainPeriodDays.ValueInt64() != 14 {
+		t.Errorf("unexpected cluster attributes: %s, %s, %s", cluster.Id, cluster.Version, cluster.BackupRetainPeriodDays)
+	}
+
+	if len(cluster.Labels.Elements()) != 0 || len(cluster.SecurityGroupIds.Elements()) != 0 {
+		t.Errorf("unexpected labels or security groups: %s, %s", cluster.Labels, cluster.SecurityGroupIds)
+	}
+
+	expectedHosts := types.MapValueMust(hostType, map[string]attr.Value{
+		"rc1a-fj6mq2ugn1ao1ng0": types.ObjectValueMust(hostType.AttrTypes, map[string]attr.Value{
+			"zone":               types.StringValue("ru-central1-a"),
+			"subnet_id":          types.StringValue("e9b3pu05gdvcq4lcdnpu"),
+			"assign_public_ip":   types.BoolValue(false),
+			"fqdn":               types.StringValue("rc1a-fj6mq2ugn1ao1ng0.mdb.yandexcloud.net"),
+			"replication_source": types.StringValue(""),
+		}),
+		"rc1d-n3a0mq7mfgcsu0pj": types.ObjectValueMust(hostType.AttrTypes, map[string]attr.Value{
+			"zone":               types.StringValue("ru-central1-d"),
+			"subnet_id":          types.StringValue("fl8u5qnmvnp3hv6ve7g5"),
+			"assign_public_ip":   types.BoolValue(false),
+			"fqdn":               types.StringValue("rc1d-n3a0mq7mfgcsu0pj.mdb.yandexcloud.net"),
+			"replication_source": types.StringValue(""),
+		}),
+	})
+	if !cluster.HostSpecs.Equal(expectedHosts) {
+		t.Errorf("unexpected hosts: expected %s, actual %s", expectedHosts, cluster.HostSpecs)
+	}
+
+	expectedMW := types.ObjectValueMust(MaintenanceWindowAttrTypes, map[string]attr.Value{
+		"type": types.StringValue("ANYTIME"),
+		"day":  types.StringNull(),
+		"hour": types.Int64Null(),
+	})
+	if !cluster.MaintenanceWindow.Equal(expectedMW) {
+		t.Errorf("unexpected maintenance window: expected %s, actual %s", expectedMW, cluster.MaintenanceWindow)
+	}
+
+	expectedAccess := types.ObjectValueMust(AccessAttrTypes, map[string]attr.Value{
+		"data_lens":     types.BoolValue(false),
+		"web_sql":       types.BoolValue(true),
+		"data_transfer": types.BoolValue(false),
+	})
+	if !cluster.Access.Equal(expectedAccess) {
+		t.Errorf("unexpected access: expected %s, actual %s", expectedAccess, cluster.Access)
+	}
+
+	if !cluster.PerformanceDiagnostics.IsNull() {
+		t.Errorf("unexpected performance diagnostics: expected null, actual %s", cluster.PerformanceDiagnostics)
+	}
+
+	expectedSettings := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"sql_mode":                   types.StringValue("ANSI_QUOTES,NO_BACKSLASH_ESCAPES"),
+		"max_connections":            types.StringValue("100"),
+		"innodb_print_all_deadlocks": types.StringValue("true"),
+	})
+	if !cluster.MySQLConfig.MapValue.Equal(expectedSettings) {
+		t.Errorf("unexpected mysql config: expected %s, actual %s", expectedSettings, cluster.MySQLConfig)
+	}
+}
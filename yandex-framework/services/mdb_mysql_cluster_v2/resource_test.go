@@ -31,6 +31,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mysql/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
 )
 
@@ -81,7 +82,7 @@ var (
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_mdb_mysql_cluster_v2", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_mdb_mysql_cluster_v2",
 		F:    testSweepMDBMySQLCluster,
 	})
@@ -90,7 +91,7 @@ func init() {
 // TestMain - add sweepers flag to the go test command
 // important for sweepers run.
 func TestMain(m *testing.M) {
-	resource.TestMain(m)
+	sweeper.TestMain(m)
 }
 
 func testSweepMDBMySQLCluster(_ string) error {
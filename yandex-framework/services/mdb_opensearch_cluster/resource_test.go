@@ -19,6 +19,7 @@ import (
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/opensearch/v1"
 	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
 
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	pc "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_opensearch_cluster/plancheck"
@@ -31,7 +32,7 @@ const (
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_mdb_opensearch_cluster", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_mdb_opensearch_cluster",
 		F:    testSweepMDBOpenSearchCluster,
 	})
@@ -40,7 +41,7 @@ func init() {
 // TestMain - add sweepers flag to the go test command
 // important for sweepers run.
 func TestMain(m *testing.M) {
-	resource.TestMain(m)
+	sweeper.TestMain(m)
 }
 
 func testSweepMDBOpenSearchCluster(_ string) error {
@@ -0,0 +1,177 @@
+package mdb_postgresql_cluster_v2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+const movedClusterSourceTypeName = "yandex_mdb_postgresql_cluster"
+
+// movedCluster is the state of the SDKv2 yandex_mdb_postgresql_cluster resource.
+// Users and databases were deprecated in it and are managed by separate resources, so they are not moved.
+type movedCluster struct {
+	Id                 string                             `json:"id"`
+	FolderId           string                             `json:"folder_id"`
+	NetworkId          string                             `json:"network_id"`
+	Name               string                             `json:"name"`
+	Description        string                             `json:"description"`
+	Environment        string                             `json:"environment"`
+	Labels             map[string]string                  `json:"labels"`
+	SecurityGroupIds   []string                           `json:"security_group_ids"`
+	DeletionProtection bool                               `json:"deletion_protection"`
+	Config             []movedConfig                      `json:"config"`
+	Hosts              []movedHost                        `json:"host"`
+	MaintenanceWindow  []mdbcommon.MovedMaintenanceWindow `json:"maintenance_window"`
+}
+
+type movedHost struct {
+	Zone              string `json:"zone"`
+	SubnetId          string `json:"subnet_id"`
+	AssignPublicIp    bool   `json:"assign_public_ip"`
+	FQDN              string `json:"fqdn"`
+	Name              string `json:"name"`
+	ReplicationSource string `json:"replication_source"`
+}
+
+type movedConfig struct {
+	Version                string                        `json:"version"`
+	Resources              []mdbcommon.MovedResources    `json:"resources"`
+	Autofailover           bool                          `json:"autofailover"`
+	BackupRetainPeriodDays int64                         `json:"backup_retain_period_days"`
+	BackupWindowStart      []mdbcommon.MovedBackupWindow `json:"backup_window_start"`
+	Access                 []struct {
+		DataLens     bool `json:"data_lens"`
+		WebSql       bool `json:"web_sql"`
+		Serverless   bool `json:"serverless"`
+		DataTransfer bool `json:"data_transfer"`
+	} `json:"access"`
+	PerformanceDiagnostics []struct {
+		Enabled                    bool  `json:"enabled"`
+		SessionsSamplingInterval   int64 `json:"sessions_sampling_interval"`
+		StatementsSamplingInterval int64 `json:"statements_sampling_interval"`
+	} `json:"performance_diagnostics"`
+	PoolerConfig []struct {
+		PoolingMode string `json:"pooling_mode"`
+		PoolDiscard bool   `json:"pool_discard"`
+	} `json:"pooler_config"`
+	DiskSizeAutoscaling []struct {
+		DiskSizeLimit           int64 `json:"disk_size_limit"`
+		PlannedUsageThreshold   int64 `json:"planned_usage_threshold"`
+		EmergencyUsageThreshold int64 `json:"emergency_usage_threshold"`
+	} `json:"disk_size_autoscaling"`
+	PostgresqlConfig map[string]string `json:"postgresql_config"`
+}
+
+func (r *clusterResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{StateMover: moveClusterState},
+	}
+}
+
+// moveClusterState translates the state of yandex_mdb_postgresql_cluster. Hosts are keyed by their `name`,
+// or by the first label of their FQDN if the name is not set.
+func moveClusterState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	var src movedCluster
+	if !mdbcommon.DecodeMovedState(req, movedClusterSourceTypeName, &src, &resp.Diagnostics) || resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := Cluster{
+		Id:                 types.StringValue(src.Id),
+		FolderId:           types.StringValue(src.FolderId),
+		NetworkId:          types.StringValue(src.NetworkId),
+		Name:               types.StringValue(src.Name),
+		Description:        types.StringValue(src.Description),
+		Environment:        types.StringValue(src.Environment),
+		Labels:             mdbcommon.FlattenMapString(ctx, src.Labels, &resp.Diagnostics),
+		SecurityGroupIds:   mdbcommon.FlattenSetString(ctx, src.SecurityGroupIds, &resp.Diagnostics),
+		DeletionProtection: types.BoolValue(src.DeletionProtection),
+		MaintenanceWindow:  mdbcommon.MoveMaintenanceWindow(ctx, src.MaintenanceWindow, &resp.Diagnostics),
+		HostSpecs:          moveHosts(ctx, src.Hosts, &resp.Diagnostics),
+		Config:             moveConfig(ctx, src.Config, &resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, &state)...)
+}
+
+func moveHosts(ctx context.Context, hosts []movedHost, diags *diag.Diagnostics) types.Map {
+	specs := make(map[string]Host, len(hosts))
+	for _, h := range hosts {
+		specs[mdbcommon.MovedHostLabel(h.Name, h.FQDN)] = Host{
+			Zone:              types.StringValue(h.Zone),
+			SubnetId:          types.StringValue(h.SubnetId),
+			AssignPublicIp:    types.BoolValue(h.AssignPublicIp),
+			FQDN:              types.StringValue(h.FQDN),
+			ReplicationSource: types.StringValue(h.ReplicationSource),
+		}
+	}
+
+	m, d := types.MapValueFrom(ctx, hostType, specs)
+	diags.Append(d...)
+	return m
+}
+
+func moveConfig(ctx context.Context, blocks []movedConfig, diags *diag.Diagnostics) types.Object {
+	if len(blocks) == 0 {
+		return types.ObjectNull(ConfigAttrTypes)
+	}
+	c := blocks[0]
+
+	cfg := Config{
+		Version:                types.StringValue(c.Version),
+		Resources:              mdbcommon.MoveResources(ctx, c.Resources, diags),
+		Autofailover:           types.BoolValue(c.Autofailover),
+		BackupRetainPeriodDays: types.Int64Value(c.BackupRetainPeriodDays),
+		BackupWindowStart:      mdbcommon.MoveBackupWindowStart(ctx, c.BackupWindowStart, diags),
+		PostgtgreSQLConfig:     mdbcommon.MoveSettingsMap(ctx, c.PostgresqlConfig, NewPgSettingsMapType(), diags),
+		Access:                 types.ObjectNull(AccessAttrTypes),
+		PerformanceDiagnostics: types.ObjectNull(PerformanceDiagnosticsAttrTypes),
+		PoolerConfig:           types.ObjectNull(PoolerConfigAttrTypes),
+		DiskSizeAutoscaling:    types.ObjectNull(DiskSizeAutoscalingAttrTypes),
+	}
+
+	var d diag.Diagnostics
+	if len(c.Access) > 0 {
+		cfg.Access, d = types.ObjectValueFrom(ctx, AccessAttrTypes, Access{
+			DataLens:     types.BoolValue(c.Access[0].DataLens),
+			WebSql:       types.BoolValue(c.Access[0].WebSql),
+			Serverless:   types.BoolValue(c.Access[0].Serverless),
+			DataTransfer: types.BoolValue(c.Access[0].DataTransfer),
+		})
+		diags.Append(d...)
+	}
+	if len(c.PerformanceDiagnostics) > 0 {
+		cfg.PerformanceDiagnostics, d = types.ObjectValueFrom(ctx, PerformanceDiagnosticsAttrTypes, PerformanceDiagnostics{
+			Enabled:                    types.BoolValue(c.PerformanceDiagnostics[0].Enabled),
+			SessionsSamplingInterval:   types.Int64Value(c.PerformanceDiagnostics[0].SessionsSamplingInterval),
+			StatementsSamplingInterval: types.Int64Value(c.PerformanceDiagnostics[0].StatementsSamplingInterval),
+		})
+		diags.Append(d...)
+	}
+	if len(c.PoolerConfig) > 0 {
+		cfg.PoolerConfig, d = types.ObjectValueFrom(ctx, PoolerConfigAttrTypes, PoolerConfig{
+			PoolingMode: types.StringValue(c.PoolerConfig[0].PoolingMode),
+			PoolDiscard: types.BoolValue(c.PoolerConfig[0].PoolDiscard),
+		})
+		diags.Append(d...)
+	}
+	if len(c.DiskSizeAutoscaling) > 0 {
+		cfg.DiskSizeAutoscaling, d = types.ObjectValueFrom(ctx, DiskSizeAutoscalingAttrTypes, DiskSizeAutoscaling{
+			DiskSizeLimit:           types.Int64Value(c.DiskSizeAutoscaling[0].DiskSizeLimit),
+			PlannedUsageThreshold:   types.Int64Value(c.DiskSizeAutoscaling[0].PlannedUsageThreshold),
+			EmergencyUsageThreshold: types.Int64Value(c.DiskSizeAutoscaling[0].EmergencyUsageThreshold),
+		})
+		diags.Append(d...)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, ConfigAttrTypes, cfg)
+	diags.Append(d...)
+	return obj
+}
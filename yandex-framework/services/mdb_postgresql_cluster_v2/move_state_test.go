@@ -0,0 +1,147 @@
+package mdb_postgresql_cluster_v2
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+func moveTestState(t *testing.T, sourceTypeName, path string) (*resource.MoveStateResponse, bool) {
+	t.Helper()
+	ctx := context.Background()
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read recorded state: %s", err)
+	}
+
+	r := &clusterResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	req := resource.MoveStateRequest{
+		SourceTypeName:        sourceTypeName,
+		SourceProviderAddress: "registry.terraform.io/yandex-cloud/yandex",
+		SourceRawState:        &tfprotov6.RawState{JSON: raw},
+	}
+	resp := &resource.MoveStateResponse{
+		TargetState: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+
+	moveClusterState(ctx, req, resp)
+	return resp, !resp.TargetState.Raw.IsNull()
+}
+
+func TestYandexProvider_MDBPostgresClusterMoveState(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	resp, moved := moveTestState(t, movedClusterSourceTypeName, "testdata/postgresql_cluster_v1_state.json")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics.Errors())
+	}
+	if !moved {
+		t.Fatal("state was not moved")
+	}
+
+	var cluster Cluster
+	if d := resp.TargetState.Get(ctx, &cluster); d.HasError() {
+		t.Fatalf("failed to read moved state: %v", d.Errors())
+	}
+
+	if cluster.Id.ValueString() != "c9q8ml85r1oh5bftssm5" || cluster.Name.ValueString() != "moved-cluster" || !cluster.DeletionProtection.ValueBool() {
+		t.Errorf("unexpected cluster attributes: %s, %s, %s", cluster.Id, cluster.Name, cluster.DeletionProtection)
+	}
+
+	expectedHosts := types.MapValueMust(hostType, map[string]attr.Value{
+		"rc1a-5o3ad8p6f2ee8br1": types.ObjectValueMust(hostType.AttrTypes, map[string]attr.Value{
+			"zone":               types.StringValue("ru-central1-a"),
+			"subnet_id":          types.StringValue("e9b3pu05gdvcq4lcdnpu"),
+			"assign_public_ip":   types.BoolValue(false),
+			"fqdn":               types.StringValue("rc1a-5o3ad8p6f2ee8br1.mdb.yandexcloud.net"),
+			"replication_source": types.StringValue(""),
+		}),
+		"replica": types.ObjectValueMust(hostType.AttrTypes, map[string]attr.Value{
+			"zone":               types.StringValue("ru-central1-b"),
+			"subnet_id":          types.StringValue("e2lbg9tpil2ko3hc0rcu"),
+			"assign_public_ip":   types.BoolValue(true),
+			"fqdn":               types.StringValue("rc1b-fs1ta9ekt2qbhj1b.mdb.yandexcloud.net"),
+			"replication_source": types.StringValue("rc1a-5o3ad8p6f2ee8br1.mdb.yandexcloud.net"),
+		}),
+	})
+	if !cluster.HostSpecs.Equal(expectedHosts) {
+		t.Errorf("unexpected hosts: expected %s, actual %s", expectedHosts, cluster.HostSpecs)
+	}
+
+	expectedMW := types.ObjectValueMust(mdbcommon.MaintenanceWindowType.AttrTypes, map[string]attr.Value{
+		"type": types.StringValue("WEEKLY"),
+		"day":  types.StringValue("SAT"),
+		"hour": types.Int64Value(12),
+	})
+	if !cluster.MaintenanceWindow.Equal(expectedMW) {
+		t.Errorf("unexpected maintenance window: expected %s, actual %s", expectedMW, cluster.MaintenanceWindow)
+	}
+
+	var cfg Config
+	if d := cluster.Config.As(ctx, &cfg, basetypes.ObjectAsOptions{}); d.HasError() {
+		t.Fatalf("failed to read moved config: %v", d.Errors())
+	}
+
+	if cfg.Version.ValueString() != "15" || !cfg.Autofailover.ValueBool() || cfg.BackupRetainPeriodDays.ValueInt64() != 7 {
+		t.Errorf("unexpected config attributes: %s, %s, %s", cfg.Version, cfg.Autofailover, cfg.BackupRetainPeriodDays)
+	}
+
+	expectedResources := types.ObjectValueMust(mdbcommon.ResourceType.AttrTypes, map[string]attr.Value{
+		"resource_preset_id": types.StringValue("s2.micro"),
+		"disk_size":          types.Int64Value(20),
+		"disk_type_id":       types.StringValue("network-ssd"),
+	})
+	if !cfg.Resources.Equal(expectedResources) {
+		t.Errorf("unexpected resources: expected %s, actual %s", expectedResources, cfg.Resources)
+	}
+
+	expectedPooler := types.ObjectValueMust(PoolerConfigAttrTypes, map[string]attr.Value{
+		"pooling_mode": types.StringValue("TRANSACTION"),
+		"pool_discard": types.BoolValue(false),
+	})
+	if !cfg.PoolerConfig.Equal(expectedPooler) {
+		t.Errorf("unexpected pooler config: expected %s, actual %s", expectedPooler, cfg.PoolerConfig)
+	}
+
+	if !cfg.DiskSizeAutoscaling.IsNull() {
+		t.Errorf("unexpected disk size autoscaling: expected null, actual %s", cfg.DiskSizeAutoscaling)
+	}
+
+	expectedSettings := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"max_connections":          types.StringValue("395"),
+		"enable_parallel_hash":     types.StringValue("true"),
+		"shared_preload_libraries": types.StringValue("SHARED_PRELOAD_LIBRARIES_AUTO_EXPLAIN,SHARED_PRELOAD_LIBRARIES_PG_HINT_PLAN"),
+	})
+	if !cfg.PostgtgreSQLConfig.MapValue.Equal(expectedSettings) {
+		t.Errorf("unexpected postgresql config: expected %s, actual %s", expectedSettings, cfg.PostgtgreSQLConfig)
+	}
+}
+
+func TestYandexProvider_MDBPostgresClusterMoveStateSkipsOtherResources(t *testing.T) {
+	t.Parallel()
+
+	resp, moved := moveTestState(t, "yandex_mdb_mysql_cluster", "testdata/postgresql_cluster_v1_state.json")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics.Errors())
+	}
+	if moved {
+		t.Error("state of another resource type must not be moved")
+	}
+}
@@ -0,0 +1,196 @@
+package mdb_redis_cluster_v2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+const movedClusterSourceTypeName = "yandex_mdb_redis_cluster"
+
+// movedCluster is the state of the SDKv2 yandex_mdb_redis_cluster resource.
+type movedCluster struct {
+	Id                 string                             `json:"id"`
+	FolderId           string                             `json:"folder_id"`
+	NetworkId          string                             `json:"network_id"`
+	Name               string                             `json:"name"`
+	Description        string                             `json:"description"`
+	Environment        string                             `json:"environment"`
+	CreatedAt          string                             `json:"created_at"`
+	Labels             map[string]string                  `json:"labels"`
+	SecurityGroupIds   []string                           `json:"security_group_ids"`
+	DeletionProtection bool                               `json:"deletion_protection"`
+	Sharded            bool                               `json:"sharded"`
+	TlsEnabled         bool                               `json:"tls_enabled"`
+	PersistenceMode    string                             `json:"persistence_mode"`
+	AnnounceHostnames  bool                               `json:"announce_hostnames"`
+	AuthSentinel       bool                               `json:"auth_sentinel"`
+	Resources          []mdbcommon.MovedResources         `json:"resources"`
+	MaintenanceWindow  []mdbcommon.MovedMaintenanceWindow `json:"maintenance_window"`
+	Config             []movedConfig                      `json:"config"`
+	Hosts              []movedHost                        `json:"host"`
+	Access             []struct {
+		DataLens bool `json:"data_lens"`
+		WebSql   bool `json:"web_sql"`
+	} `json:"access"`
+	DiskSizeAutoscaling []struct {
+		DiskSizeLimit           int64 `json:"disk_size_limit"`
+		PlannedUsageThreshold   int64 `json:"planned_usage_threshold"`
+		EmergencyUsageThreshold int64 `json:"emergency_usage_threshold"`
+	} `json:"disk_size_autoscaling"`
+}
+
+type movedHost struct {
+	Zone            string `json:"zone"`
+	ShardName       string `json:"shard_name"`
+	SubnetId        string `json:"subnet_id"`
+	FQDN            string `json:"fqdn"`
+	ReplicaPriority int64  `json:"replica_priority"`
+	AssignPublicIp  bool   `json:"assign_public_ip"`
+}
+
+type movedConfig struct {
+	Password                        string                        `json:"password"`
+	Timeout                         int64                         `json:"timeout"`
+	MaxmemoryPolicy                 string                        `json:"maxmemory_policy"`
+	NotifyKeyspaceEvents            string                        `json:"notify_keyspace_events"`
+	SlowlogLogSlowerThan            int64                         `json:"slowlog_log_slower_than"`
+	SlowlogMaxLen                   int64                         `json:"slowlog_max_len"`
+	Databases                       int64                         `json:"databases"`
+	MaxmemoryPercent                int64                         `json:"maxmemory_percent"`
+	ClientOutputBufferLimitNormal   string                        `json:"client_output_buffer_limit_normal"`
+	ClientOutputBufferLimitPubsub   string                        `json:"client_output_buffer_limit_pubsub"`
+	UseLuajit                       bool                          `json:"use_luajit"`
+	IoThreadsAllowed                bool                          `json:"io_threads_allowed"`
+	Version                         string                        `json:"version"`
+	LuaTimeLimit                    int64                         `json:"lua_time_limit"`
+	ReplBacklogSizePercent          int64                         `json:"repl_backlog_size_percent"`
+	ClusterRequireFullCoverage      bool                          `json:"cluster_require_full_coverage"`
+	ClusterAllowReadsWhenDown       bool                          `json:"cluster_allow_reads_when_down"`
+	ClusterAllowPubsubshardWhenDown bool                          `json:"cluster_allow_pubsubshard_when_down"`
+	LfuDecayTime                    int64                         `json:"lfu_decay_time"`
+	LfuLogFactor                    int64                         `json:"lfu_log_factor"`
+	TurnBeforeSwitchover            bool                          `json:"turn_before_switchover"`
+	AllowDataLoss                   bool                          `json:"allow_data_loss"`
+	BackupRetainPeriodDays          int64                         `json:"backup_retain_period_days"`
+	BackupWindowStart               []mdbcommon.MovedBackupWindow `json:"backup_window_start"`
+	ZsetMaxListpackEntries          int64                         `json:"zset_max_listpack_entries"`
+}
+
+func (r *redisClusterResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{StateMover: moveClusterState},
+	}
+}
+
+// moveClusterState translates the state of yandex_mdb_redis_cluster. Hosts are keyed by the first label of their FQDN.
+// The password is moved as is, because it can't be read from the API.
+func moveClusterState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	var src movedCluster
+	if !mdbcommon.DecodeMovedState(req, movedClusterSourceTypeName, &src, &resp.Diagnostics) || resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := Cluster{
+		ID:                  types.StringValue(src.Id),
+		ClusterID:           types.StringValue(src.Id),
+		FolderID:            types.StringValue(src.FolderId),
+		NetworkID:           types.StringValue(src.NetworkId),
+		Name:                types.StringValue(src.Name),
+		Description:         types.StringValue(src.Description),
+		Environment:         types.StringValue(src.Environment),
+		CreatedAt:           types.StringValue(src.CreatedAt),
+		Labels:              mdbcommon.FlattenMapString(ctx, src.Labels, &resp.Diagnostics),
+		SecurityGroupIDs:    mdbcommon.FlattenSetString(ctx, src.SecurityGroupIds, &resp.Diagnostics),
+		DeletionProtection:  types.BoolValue(src.DeletionProtection),
+		Sharded:             types.BoolValue(src.Sharded),
+		TlsEnabled:          types.BoolValue(src.TlsEnabled),
+		PersistenceMode:     types.StringValue(src.PersistenceMode),
+		AnnounceHostnames:   types.BoolValue(src.AnnounceHostnames),
+		AuthSentinel:        types.BoolValue(src.AuthSentinel),
+		Resources:           mdbcommon.MoveResources(ctx, src.Resources, &resp.Diagnostics),
+		MaintenanceWindow:   mdbcommon.MoveMaintenanceWindow(ctx, src.MaintenanceWindow, &resp.Diagnostics),
+		HostSpecs:           moveHosts(ctx, src.Hosts, &resp.Diagnostics),
+		Config:              moveConfig(ctx, src.Config, &resp.Diagnostics),
+		Access:              types.ObjectNull(AccessType.AttrTypes),
+		DiskSizeAutoscaling: types.ObjectNull(DiskSizeAutoscalingType.AttrTypes),
+	}
+
+	var d diag.Diagnostics
+	if len(src.Access) > 0 {
+		state.Access, d = types.ObjectValueFrom(ctx, AccessType.AttrTypes, Access{
+			DataLens: types.BoolValue(src.Access[0].DataLens),
+			WebSql:   types.BoolValue(src.Access[0].WebSql),
+		})
+		resp.Diagnostics.Append(d...)
+	}
+	if len(src.DiskSizeAutoscaling) > 0 {
+		state.DiskSizeAutoscaling, d = types.ObjectValueFrom(ctx, DiskSizeAutoscalingType.AttrTypes, DiskSizeAutoscaling{
+			DiskSizeLimit:           types.Int64Value(src.DiskSizeAutoscaling[0].DiskSizeLimit),
+			PlannedUsageThreshold:   types.Int64Value(src.DiskSizeAutoscaling[0].PlannedUsageThreshold),
+			EmergencyUsageThreshold: types.Int64Value(src.DiskSizeAutoscaling[0].EmergencyUsageThreshold),
+		})
+		resp.Diagnostics.Append(d...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, &state)...)
+}
+
+func moveHosts(ctx context.Context, hosts []movedHost, diags *diag.Diagnostics) types.Map {
+	specs := make(map[string]Host, len(hosts))
+	for _, h := range hosts {
+		specs[mdbcommon.MovedHostLabel("", h.FQDN)] = Host{
+			Zone:            types.StringValue(h.Zone),
+			ShardName:       types.StringValue(h.ShardName),
+			SubnetId:        types.StringValue(h.SubnetId),
+			FQDN:            types.StringValue(h.FQDN),
+			ReplicaPriority: types.Int64Value(h.ReplicaPriority),
+			AssignPublicIp:  types.BoolValue(h.AssignPublicIp),
+		}
+	}
+
+	m, d := types.MapValueFrom(ctx, HostType, specs)
+	diags.Append(d...)
+	return m
+}
+
+func moveConfig(ctx context.Context, blocks []movedConfig, diags *diag.Diagnostics) *Config {
+	if len(blocks) == 0 {
+		return nil
+	}
+	c := blocks[0]
+
+	return &Config{
+		Password:                        types.StringValue(c.Password),
+		Timeout:                         types.Int64Value(c.Timeout),
+		MaxmemoryPolicy:                 types.StringValue(c.MaxmemoryPolicy),
+		NotifyKeyspaceEvents:            types.StringValue(c.NotifyKeyspaceEvents),
+		SlowlogLogSlowerThan:            types.Int64Value(c.SlowlogLogSlowerThan),
+		SlowlogMaxLen:                   types.Int64Value(c.SlowlogMaxLen),
+		Databases:                       types.Int64Value(c.Databases),
+		MaxmemoryPercent:                types.Int64Value(c.MaxmemoryPercent),
+		ClientOutputBufferLimitNormal:   types.StringValue(c.ClientOutputBufferLimitNormal),
+		ClientOutputBufferLimitPubsub:   types.StringValue(c.ClientOutputBufferLimitPubsub),
+		UseLuajit:                       types.BoolValue(c.UseLuajit),
+		IoThreadsAllowed:                types.BoolValue(c.IoThreadsAllowed),
+		Version:                         types.StringValue(c.Version),
+		LuaTimeLimit:                    types.Int64Value(c.LuaTimeLimit),
+		ReplBacklogSizePercent:          types.Int64Value(c.ReplBacklogSizePercent),
+		ClusterRequireFullCoverage:      types.BoolValue(c.ClusterRequireFullCoverage),
+		ClusterAllowReadsWhenDown:       types.BoolValue(c.ClusterAllowReadsWhenDown),
+		ClusterAllowPubsubshardWhenDown: types.BoolValue(c.ClusterAllowPubsubshardWhenDown),
+		LfuDecayTime:                    types.Int64Value(c.LfuDecayTime),
+		LfuLogFactor:                    types.Int64Value(c.LfuLogFactor),
+		TurnBeforeSwitchover:            types.BoolValue(c.TurnBeforeSwitchover),
+		AllowDataLoss:                   types.BoolValue(c.AllowDataLoss),
+		BackupRetainPeriodDays:          types.Int64Value(c.BackupRetainPeriodDays),
+		BackupWindowStart:               mdbcommon.MoveBackupWindowStart(ctx, c.BackupWindowStart, diags),
+		ZsetMaxListpackEntries:          types.Int64Value(c.ZsetMaxListpackEntries),
+	}
+}
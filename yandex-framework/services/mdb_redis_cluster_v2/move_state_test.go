@@ -0,0 +1,112 @@
+package mdb_redis_cluster_v2_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_redis_cluster_v2"
+)
+
+func TestYandexProvider_MDBRedisClusterMoveState(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	raw, err := os.ReadFile("testdata/redis_cluster_v1_state.json")
+	if err != nil {
+		t.Fatalf("failed to read recorded state: %s", err)
+	}
+
+	r, ok := mdb_redis_cluster_v2.NewResource().(resource.ResourceWithMoveState)
+	if !ok {
+		t.Fatal("resource does not support moving state")
+	}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	req := resource.MoveStateRequest{
+		SourceTypeName:        "yandex_mdb_redis_cluster",
+		SourceProviderAddress: "registry.terraform.io/yandex-cloud/yandex",
+		SourceRawState:        &tfprotov6.RawState{JSON: raw},
+	}
+	resp := &resource.MoveStateResponse{
+		TargetState: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+
+	movers := r.MoveState(ctx)
+	if len(movers) != 1 {
+		t.Fatalf("unexpected number of state movers: %d", len(movers))
+	}
+	movers[0].StateMover(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics.Errors())
+	}
+
+	var cluster mdb_redis_cluster_v2.Cluster
+	if d := resp.TargetState.Get(ctx, &cluster); d.HasError() {
+		t.Fatalf("failed to read moved state: %v", d.Errors())
+	}
+
+	if cluster.ID.ValueString() != "c9q2ke4b1srfmhqh4cmb" || !cluster.ClusterID.Equal(cluster.ID) {
+		t.Errorf("unexpected cluster ids: %s, %s", cluster.ID, cluster.ClusterID)
+	}
+	if !cluster.Sharded.ValueBool() || !cluster.TlsEnabled.ValueBool() || cluster.PersistenceMode.ValueString() != "ON" {
+		t.Errorf("unexpected cluster attributes: %s, %s, %s", cluster.Sharded, cluster.TlsEnabled, cluster.PersistenceMode)
+	}
+
+	expectedHosts := types.MapValueMust(mdb_redis_cluster_v2.HostType, map[string]attr.Value{
+		"rc1a-7lb2f1ttfp1d0n2o": types.ObjectValueMust(mdb_redis_cluster_v2.HostType.AttrTypes, map[string]attr.Value{
+			"zone":             types.StringValue("ru-central1-a"),
+			"shard_name":       types.StringValue("first"),
+			"subnet_id":        types.StringValue("e9b3pu05gdvcq4lcdnpu"),
+			"fqdn":             types.StringValue("rc1a-7lb2f1ttfp1d0n2o.mdb.yandexcloud.net"),
+			"replica_priority": types.Int64Value(100),
+			"assign_public_ip": types.BoolValue(false),
+		}),
+		"rc1b-u4o9ib4orl7ev3ua": types.ObjectValueMust(mdb_redis_cluster_v2.HostType.AttrTypes, map[string]attr.Value{
+			"zone":             types.StringValue("ru-central1-b"),
+			"shard_name":       types.StringValue("second"),
+			"subnet_id":        types.StringValue("e2lbg9tpil2ko3hc0rcu"),
+			"fqdn":             types.StringValue("rc1b-u4o9ib4orl7ev3ua.mdb.yandexcloud.net"),
+			"replica_priority": types.Int64Value(100),
+			"assign_public_ip": types.BoolValue(true),
+		}),
+	})
+	if !cluster.HostSpecs.Equal(expectedHosts) {
+		t.Errorf("unexpected hosts: expected %s, actual %s", expectedHosts, cluster.HostSpecs)
+	}
+
+	expectedAutoscaling := types.ObjectValueMust(mdb_redis_cluster_v2.DiskSizeAutoscalingType.AttrTypes, map[string]attr.Value{
+		"disk_size_limit":           types.Int64Value(32),
+		"planned_usage_threshold":   types.Int64Value(70),
+		"emergency_usage_threshold": types.Int64Value(90),
+	})
+	if !cluster.DiskSizeAutoscaling.Equal(expectedAutoscaling) {
+		t.Errorf("unexpected disk size autoscaling: expected %s, actual %s", expectedAutoscaling, cluster.DiskSizeAutoscaling)
+	}
+	if !cluster.Access.IsNull() {
+		t.Errorf("unexpected access: expected null, actual %s", cluster.Access)
+	}
+
+	if cluster.Config == nil {
+		t.Fatal("config was not moved")
+	}
+	if cluster.Config.Password.ValueString() != "s3cr3t-passw0rd" {
+		t.Errorf("password was not moved: %s", cluster.Config.Password)
+	}
+	if cluster.Config.Version.ValueString() != "7.2" || cluster.Config.MaxmemoryPolicy.ValueString() != "ALLKEYS_LRU" || cluster.Config.Databases.ValueInt64() != 16 {
+		t.Errorf("unexpected config attributes: %s, %s, %s", cluster.Config.Version, cluster.Config.MaxmemoryPolicy, cluster.Config.Databases)
+	}
+	if cluster.Config.BackupWindowStart.IsNull() {
+		t.Error("backup window start was not moved")
+	}
+}
@@ -170,9 +170,9 @@ func TestYandexProvider_MDBSPQRClusterConfigExpand(t *testing.T) {
 				}),
 				Router: &ComponentConfig{
 					Config: NewSettingsMapValueMust(map[string]attr.Value{
-						"show_notice_messages": types.StringValue("true"),
-						//"default_route_behavior": types.StringValue("ALLOW"),
-						//"time_quantiles":                types.StringValue("0.95,0.99"),
+						"show_notice_messages":          types.StringValue("true"),
+						"default_route_behavior":        types.Int64Value(int64(spqr.RouterSettings_ALLOW)),
+						"time_quantiles":                types.StringValue("0.95,0.99"),
 						"prefer_same_availability_zone": types.StringValue("true"),
 					}),
 					Resources: types.ObjectValueMust(ResourcesAttrTypes, map[string]attr.Value{
@@ -210,9 +210,9 @@ func TestYandexProvider_MDBSPQRClusterConfigExpand(t *testing.T) {
 			&spqr.SpqrSpec{
 				Router: &spqr.SpqrSpec_Router{
 					Config: &spqr.RouterSettings{
-						ShowNoticeMessages: wrapperspb.Bool(true),
-						//DefaultRouteBehavior: sharded_postgresql.RouterSettings_ALLOW,
-						//TimeQuantiles:              []float64{0.95, 0.99},
+						ShowNoticeMessages:         wrapperspb.Bool(true),
+						DefaultRouteBehavior:       spqr.RouterSettings_ALLOW,
+						TimeQuantiles:              []float64{0.95, 0.99},
 						PreferSameAvailabilityZone: wrapperspb.Bool(true),
 					},
 					Resources: &spqr.Resources{
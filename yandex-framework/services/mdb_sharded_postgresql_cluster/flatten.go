@@ -218,7 +218,7 @@ func flattenComponentConfig(ctx context.Context, c any, diags *diag.Diagnostics)
 
 	}
 
-	settings, d := mdbcommon.NewSettingsMapValue(attrsPresent, &SettingsAttributeInfoProvider{})
+	settings, d := mdbcommon.NewSettingsMapValue(attrsPresent, attrProvider)
 	diags.Append(d...)
 	return settings
 }
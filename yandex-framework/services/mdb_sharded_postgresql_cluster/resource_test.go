@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/spqr/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
@@ -34,7 +35,7 @@ const (
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_mdb_sharded_postgresql_cluster", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_mdb_sharded_postgresql_cluster",
 		F:    testSweepMDBShardedPostgreSQLCluster,
 	})
@@ -43,7 +44,7 @@ func init() {
 // TestMain - add sweepers flag to the go test command
 // important for sweepers run.
 func TestMain(m *testing.M) {
-	resource.TestMain(m)
+	sweeper.TestMain(m)
 }
 
 func testSweepMDBShardedPostgreSQLCluster(_ string) error {
@@ -10,35 +10,7 @@ import (
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
 )
 
-type SettingsAttributeInfoProvider struct{}
-
-func (p *SettingsAttributeInfoProvider) GetSettingsEnumNames() map[string]map[int32]string {
-	return settingsEnumNames
-}
-
-func (p *SettingsAttributeInfoProvider) GetSettingsEnumValues() map[string]map[string]int32 {
-	return settingsEnumValues
-}
-
-func (p *SettingsAttributeInfoProvider) GetSetAttributes() map[string]struct{} {
-	return listAttributes
-}
-
-var settingsEnumNames = map[string]map[int32]string{
-	"log_level":              config.LogLevel_name,
-	"default_route_behavior": config.RouterSettings_DefaultRouteBehavior_name,
-}
-
-var settingsEnumValues = map[string]map[string]int32{
-	"log_level":              config.LogLevel_value,
-	"default_route_behavior": config.RouterSettings_DefaultRouteBehavior_value,
-}
-
-var listAttributes = map[string]struct{}{
-	"default_route_behavior": {},
-}
-
-var attrProvider = &SettingsAttributeInfoProvider{}
+var attrProvider = mdbcommon.NewProtoSettingsAttributeInfoProvider(&config.SpqrSpec{})
 
 func NewSettingsMapType() mdbcommon.SettingsMapType {
 	return mdbcommon.NewSettingsMapType(attrProvider)
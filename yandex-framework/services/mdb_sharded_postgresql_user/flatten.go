@@ -105,7 +105,7 @@ func flattenSettings(ctx context.Context, c any, diags *diag.Diagnostics) mdbcom
 
 	}
 
-	settings, d := mdbcommon.NewSettingsMapValue(attrsPresent, &SettingsAttributeInfoProvider{})
+	settings, d := mdbcommon.NewSettingsMapValue(attrsPresent, attrProvider)
 	diags.Append(d...)
 	return settings
 }
@@ -1,23 +1,8 @@
 package mdb_sharded_postgresql_user
 
-type SettingsAttributeInfoProvider struct{}
+import (
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/spqr/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
 
-var attrProvider = &SettingsAttributeInfoProvider{}
-
-var settingsEnumNames = map[string]map[int32]string{}
-
-var settingsEnumValues = map[string]map[string]int32{}
-
-var listAttributes = map[string]struct{}{}
-
-func (p *SettingsAttributeInfoProvider) GetSettingsEnumNames() map[string]map[int32]string {
-	return settingsEnumNames
-}
-
-func (p *SettingsAttributeInfoProvider) GetSettingsEnumValues() map[string]map[string]int32 {
-	return settingsEnumValues
-}
-
-func (p *SettingsAttributeInfoProvider) GetSetAttributes() map[string]struct{} {
-	return listAttributes
-}
+var attrProvider = mdbcommon.NewProtoSettingsAttributeInfoProvider(&spqr.UserSettings{})
@@ -25,6 +25,14 @@ func NewIamBinding() resource.Resource {
 	return accessbinding.NewIamBinding(newBucketIamUpdater())
 }
 
+func NewIamMember() resource.Resource {
+	return accessbinding.NewIamMember(newBucketIamUpdater())
+}
+
+func NewIamPolicy() resource.Resource {
+	return accessbinding.NewIamPolicy(newBucketIamUpdater())
+}
+
 func newBucketIamUpdater() accessbinding.ResourceIamUpdater {
 	return &BucketIAMUpdater{}
 }
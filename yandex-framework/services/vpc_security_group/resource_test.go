@@ -17,12 +17,13 @@ import (
 	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	//testvpc "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/tests/vpc"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const YandexVPCNetworkDefaultTimeout = 1 * time.Minute
 
 func init() {
-	resource.AddTestSweepers("yandex_vpc_security_group", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_vpc_security_group",
 		F:    testSweepVPCSecurityGroups,
 	})
@@ -31,7 +32,7 @@ func init() {
 // TestMain - add sweepers flag to the go test command
 // important for sweepers run.
 func TestMain(m *testing.M) {
-	resource.TestMain(m)
+	sweeper.TestMain(m)
 }
 
 func testSweepVPCSecurityGroups(_ string) error {
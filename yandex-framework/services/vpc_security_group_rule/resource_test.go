@@ -16,6 +16,7 @@ import (
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 
 	//testvpc "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/tests/vpc"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -25,7 +26,7 @@ import (
 const YandexVPCNetworkDefaultTimeout = 1 * time.Minute
 
 func init() {
-	resource.AddTestSweepers("yandex_vpc_security_group_rule", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_vpc_security_group_rule",
 		F:    testSweepVPCSecurityGroups,
 	})
@@ -34,7 +35,7 @@ func init() {
 // TestMain - add sweepers flag to the go test command
 // important for sweepers run.
 func TestMain(m *testing.M) {
-	resource.TestMain(m)
+	sweeper.TestMain(m)
 }
 
 func testSweepVPCSecurityGroups(_ string) error {
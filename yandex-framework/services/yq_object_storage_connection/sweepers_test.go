@@ -3,13 +3,13 @@ package yq_object_storage_connection_test
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
 	"github.com/ydb-platform/ydb-go-genproto/draft/protos/Ydb_FederatedQuery"
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_yq_object_storage_connection", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_yq_object_storage_connection",
 		F:    testSweepObjectStorageConnection,
 		Dependencies: []string{
@@ -17,7 +17,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("yandex_yq_object_storage_binding", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_yq_object_storage_binding",
 		F:    testSweepObjectStorageBinding,
 	})
@@ -26,7 +26,7 @@ func init() {
 // TestMain - add sweepers flag to the go test command
 // important for sweepers run.
 func TestMain(m *testing.M) {
-	resource.TestMain(m)
+	sweeper.TestMain(m)
 }
 
 func testSweepObjectStorageConnection(_ string) error {
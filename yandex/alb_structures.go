@@ -2688,3 +2688,59 @@ func invalidKeyError(name string, valid map[string]int32, got string) error {
 	}
 	return fmt.Errorf("expected %q to be one of %v, got %q", name, keys, got)
 }
+
+func albBackendGroupTargetGroupIDs(bg *apploadbalancer.BackendGroup) []string {
+	var result []string
+	seen := map[string]bool{}
+	for _, b := range albWeightedBackends(bg) {
+		for _, id := range b.GetTargetGroups().GetTargetGroupIds() {
+			if !seen[id] {
+				seen[id] = true
+				result = append(result, id)
+			}
+		}
+	}
+	return result
+}
+
+func flattenALBTargetStates(targetGroupID string, states []*apploadbalancer.TargetState) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(states))
+	for _, state := range states {
+		var zones []map[string]interface{}
+		for _, zone := range state.GetStatus().GetZoneStatuses() {
+			zones = append(zones, map[string]interface{}{
+				"zone_id":          zone.ZoneId,
+				"status":           zone.Status.String(),
+				"failed_active_hc": zone.FailedActiveHc,
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"target_group_id":      targetGroupID,
+			"ip_address":           state.GetTarget().GetIpAddress(),
+			"subnet_id":            state.GetTarget().GetSubnetId(),
+			"private_ipv4_address": state.GetTarget().GetPrivateIpv4Address(),
+			"zone_status":          zones,
+		})
+	}
+	return result
+}
+
+// albTargetStatesHealthy reports whether every target is HEALTHY in every
+// zone of the load balancer. Targets without zone statuses are not healthy yet.
+func albTargetStatesHealthy(states map[string][]*apploadbalancer.TargetState) bool {
+	for _, tgStates := range states {
+		for _, state := range tgStates {
+			zones := state.GetStatus().GetZoneStatuses()
+			if len(zones) == 0 {
+				return false
+			}
+			for _, zone := range zones {
+				if zone.Status != apploadbalancer.TargetState_HEALTHY {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
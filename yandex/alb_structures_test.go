@@ -1,6 +1,7 @@
 package yandex
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -504,3 +505,80 @@ func Test_flattenALBAutoscalePolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestALBTargetStatesHealthy(t *testing.T) {
+	t.Parallel()
+
+	state := func(statuses ...apploadbalancer.TargetState_Status) *apploadbalancer.TargetState {
+		hc := &apploadbalancer.TargetState_HealthcheckStatus{}
+		for i, s := range statuses {
+			hc.ZoneStatuses = append(hc.ZoneStatuses, &apploadbalancer.TargetState_ZoneHealthcheckStatus{
+				ZoneId: fmt.Sprintf("ru-central1-%c", 'a'+i),
+				Status: s,
+			})
+		}
+		return &apploadbalancer.TargetState{Status: hc}
+	}
+
+	assert.True(t, albTargetStatesHealthy(map[string][]*apploadbalancer.TargetState{
+		"tg1": {state(apploadbalancer.TargetState_HEALTHY, apploadbalancer.TargetState_HEALTHY)},
+	}))
+	assert.False(t, albTargetStatesHealthy(map[string][]*apploadbalancer.TargetState{
+		"tg1": {state(apploadbalancer.TargetState_HEALTHY)},
+		"tg2": {state(apploadbalancer.TargetState_HEALTHY, apploadbalancer.TargetState_PARTIALLY_HEALTHY)},
+	}))
+	assert.False(t, albTargetStatesHealthy(map[string][]*apploadbalancer.TargetState{
+		"tg1": {state()},
+	}))
+}
+
+func TestFlattenALBTargetStates(t *testing.T) {
+	t.Parallel()
+
+	states := []*apploadbalancer.TargetState{
+		{
+			Target: &apploadbalancer.Target{
+				AddressType: &apploadbalancer.Target_IpAddress{IpAddress: "10.0.0.1"},
+				SubnetId:    "subnet1",
+			},
+			Status: &apploadbalancer.TargetState_HealthcheckStatus{
+				ZoneStatuses: []*apploadbalancer.TargetState_ZoneHealthcheckStatus{
+					{ZoneId: "ru-central1-a", Status: apploadbalancer.TargetState_UNHEALTHY, FailedActiveHc: true},
+				},
+			},
+		},
+	}
+
+	require.Equal(t, []map[string]interface{}{
+		{
+			"target_group_id":      "tg1",
+			"ip_address":           "10.0.0.1",
+			"subnet_id":            "subnet1",
+			"private_ipv4_address": false,
+			"zone_status": []map[string]interface{}{
+				{"zone_id": "ru-central1-a", "status": "UNHEALTHY", "failed_active_hc": true},
+			},
+		},
+	}, flattenALBTargetStates("tg1", states))
+}
+
+func TestALBBackendGroupTargetGroupIDs(t *testing.T) {
+	t.Parallel()
+
+	bg := &apploadbalancer.BackendGroup{}
+	bg.SetHttp(&apploadbalancer.HttpBackendGroup{
+		Backends: []*apploadbalancer.HttpBackend{
+			{Name: "a", BackendType: &apploadbalancer.HttpBackend_TargetGroups{
+				TargetGroups: &apploadbalancer.TargetGroupsBackend{TargetGroupIds: []string{"tg1", "tg2"}},
+			}},
+			{Name: "b", BackendType: &apploadbalancer.HttpBackend_TargetGroups{
+				TargetGroups: &apploadbalancer.TargetGroupsBackend{TargetGroupIds: []string{"tg2", "tg3"}},
+			}},
+			{Name: "bucket", BackendType: &apploadbalancer.HttpBackend_StorageBucket{
+				StorageBucket: &apploadbalancer.StorageBucketBackend{Bucket: "bucket"},
+			}},
+		},
+	})
+
+	require.Equal(t, []string{"tg1", "tg2", "tg3"}, albBackendGroupTargetGroupIDs(bg))
+}
@@ -13,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/monitoring/alerting"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/storage/s3"
 
 	"github.com/google/uuid"
@@ -25,6 +26,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/cassette"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/config"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/logging"
 )
@@ -66,6 +68,12 @@ type Config struct {
 	SharedCredentialsFile string
 	Profile               string
 
+	// DefaultLabels are merged into labels of every resource that supports them.
+	DefaultLabels map[string]string
+
+	// IamOnlineValidation enables plan-time checks of IAM roles and members against the API.
+	IamOnlineValidation bool
+
 	// contextWithClientTraceID is a context that has client-trace-id in its metadata
 	// It is initialized from stopContext at the same time as ycsdk.SDK
 	contextWithClientTraceID context.Context
@@ -75,6 +83,8 @@ type Config struct {
 	sharedCredentials *SharedCredentials
 	defaultS3Client   *s3.Client
 	iamToken          *iamToken
+
+	monitoringAlertingClient alerting.Client
 }
 
 // this function return context with added client trace id
@@ -95,6 +105,11 @@ func (c *Config) ContextWithClientTraceID(ctx context.Context) context.Context {
 	return ctx
 }
 
+// MonitoringAlerting returns the client for Monitoring alerts and notification channels
+func (c *Config) MonitoringAlerting() alerting.Client {
+	return c.monitoringAlertingClient
+}
+
 // Client configures and returns a fully initialized Yandex Cloud sdk
 func (c *Config) initAndValidate(stopContext context.Context, terraformVersion string, sweeper bool) error {
 	c.contextWithClientTraceID = requestid.ContextWithClientTraceID(stopContext, uuid.New().String())
@@ -143,14 +158,31 @@ func (c *Config) initAndValidate(stopContext context.Context, terraformVersion s
 		return err
 	}
 
-	c.sdk, err = ycsdk.Build(c.contextWithClientTraceID, *yandexSDKConfig,
+	grpcOptions := []grpc.DialOption{
 		grpc.WithUserAgent(c.userAgent),
 		grpc.WithDefaultCallOptions(grpc.Header(&headerMD)),
 		grpc.WithUnaryInterceptor(interceptorChain),
-		retryOptions)
+		retryOptions,
+	}
+
+	// Record or replay API traffic of acceptance tests, see package cassette.
+	rec, err := cassette.FromEnv()
 	if err != nil {
 		return err
 	}
+	if rec != nil {
+		log.Printf("[INFO] API traffic is in %s mode with cassette %s", rec.Mode(), rec.Path())
+		grpcOptions = append(grpcOptions, rec.DialOptions()...)
+	}
+
+	c.sdk, err = ycsdk.Build(c.contextWithClientTraceID, *yandexSDKConfig, grpcOptions...)
+	if err != nil {
+		return err
+	}
+
+	c.monitoringAlertingClient = alerting.NewRESTClient(
+		alerting.EndpointFromAPI(c.Endpoint, c.Plaintext), c.userAgent, c.getIAMToken,
+	)
 
 	err = c.initSharedCredentials()
 	if err != nil {
@@ -0,0 +1,145 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+)
+
+func dataSourceYandexALBBackendGroupTargetStates() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the health status of targets in a backend group of a Yandex Application Load Balancer, reported per availability zone of the load balancer. For more information, see [the official documentation](https://yandex.cloud/docs/application-load-balancer/concepts/backend-group#health-checks).\n\nIf `target_group_id` is not set, states of all target groups used by the backend group are returned.\n",
+
+		Read: dataSourceYandexALBBackendGroupTargetStatesRead,
+		Schema: map[string]*schema.Schema{
+			"load_balancer_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the load balancer that routes traffic to the backend group.",
+				Required:    true,
+			},
+
+			"backend_group_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the backend group.",
+				Required:    true,
+			},
+
+			"target_group_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the target group to get states for.",
+				Optional:    true,
+			},
+
+			"healthy": {
+				Type:        schema.TypeBool,
+				Description: "`true` if every reported target is `HEALTHY` in all zones.",
+				Computed:    true,
+			},
+
+			"target_states": {
+				Type:        schema.TypeList,
+				Description: "Health states of the targets.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_group_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the target group the target belongs to.",
+							Computed:    true,
+						},
+						"ip_address": {
+							Type:        schema.TypeString,
+							Description: "IP address of the target.",
+							Computed:    true,
+						},
+						"subnet_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the subnet the target is connected to.",
+							Computed:    true,
+						},
+						"private_ipv4_address": {
+							Type:        schema.TypeBool,
+							Description: "`true` if the target is a private IPv4 address outside of Yandex Cloud subnets.",
+							Computed:    true,
+						},
+						"zone_status": {
+							Type:        schema.TypeList,
+							Description: "Health check status of the target in each availability zone of the load balancer.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"zone_id": {
+										Type:        schema.TypeString,
+										Description: "ID of the availability zone.",
+										Computed:    true,
+									},
+									"status": {
+										Type:        schema.TypeString,
+										Description: "Health status of the target in the zone: `HEALTHY`, `PARTIALLY_HEALTHY`, `UNHEALTHY`, `DRAINING` or `TIMEOUT`.",
+										Computed:    true,
+									},
+									"failed_active_hc": {
+										Type:        schema.TypeBool,
+										Description: "`true` if the target fails active health checks in the zone.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceYandexALBBackendGroupTargetStatesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+	lbID := d.Get("load_balancer_id").(string)
+	bgID := d.Get("backend_group_id").(string)
+
+	targetGroupIDs := []string{d.Get("target_group_id").(string)}
+	if targetGroupIDs[0] == "" {
+		bg, err := config.sdk.ApplicationLoadBalancer().BackendGroup().Get(ctx, &apploadbalancer.GetBackendGroupRequest{
+			BackendGroupId: bgID,
+		})
+		if err != nil {
+			return handleNotFoundError(err, d, fmt.Sprintf("Application Backend Group %q", bgID))
+		}
+		targetGroupIDs = albBackendGroupTargetGroupIDs(bg)
+	}
+
+	states, err := getALBTargetStates(ctx, config, lbID, bgID, targetGroupIDs)
+	if err != nil {
+		return err
+	}
+
+	var flStates []map[string]interface{}
+	for _, tgID := range targetGroupIDs {
+		flStates = append(flStates, flattenALBTargetStates(tgID, states[tgID])...)
+	}
+
+	d.SetId(lbID + "/" + bgID + "/" + d.Get("target_group_id").(string))
+	d.Set("healthy", albTargetStatesHealthy(states))
+
+	return d.Set("target_states", flStates)
+}
+
+func getALBTargetStates(ctx context.Context, config *Config, lbID, bgID string, targetGroupIDs []string) (map[string][]*apploadbalancer.TargetState, error) {
+	result := make(map[string][]*apploadbalancer.TargetState, len(targetGroupIDs))
+	for _, tgID := range targetGroupIDs {
+		resp, err := config.sdk.ApplicationLoadBalancer().LoadBalancer().GetTargetStates(ctx, &apploadbalancer.GetTargetStatesRequest{
+			LoadBalancerId: lbID,
+			BackendGroupId: bgID,
+			TargetGroupId:  tgID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error while requesting API to get target states of target group %q in Application Load Balancer %q: %w", tgID, lbID, err)
+		}
+		result[tgID] = resp.TargetStates
+	}
+	return result, nil
+}
@@ -0,0 +1,69 @@
+package yandex
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+)
+
+func dataSourceYandexDnsZoneFile() *schema.Resource {
+	return &schema.Resource{
+		Description: "Exports all record sets of a DNS Zone in the BIND zone file format. The result can be used with `yandex_dns_zone_records` to migrate or copy zones.",
+		Read:        dataSourceYandexDnsZoneFileRead,
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"dns_zone_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the DNS Zone.",
+				Required:    true,
+			},
+
+			"zone": {
+				Type:        schema.TypeString,
+				Description: resourceYandexDnsZone().Schema["zone"].Description,
+				Computed:    true,
+			},
+
+			"zone_file": {
+				Type:        schema.TypeString,
+				Description: "Zone contents in the BIND zone file format, including `SOA` and `NS` records.",
+				Computed:    true,
+			},
+
+			"records": {
+				Type:        schema.TypeList,
+				Description: "All record sets of the zone, with fully qualified names.",
+				Computed:    true,
+				Elem:        dnsZoneRecordSchema(true),
+			},
+		},
+	}
+}
+
+func dataSourceYandexDnsZoneFileRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sdk := getSDK(config)
+	id := d.Get("dns_zone_id").(string)
+
+	dnsZone, err := sdk.DNS().DnsZone().Get(config.Context(), &dns.GetDnsZoneRequest{
+		DnsZoneId: id,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DnsZone %q", id))
+	}
+
+	recordSets, err := listDnsZoneRecordSets(config.Context(), config, id)
+	if err != nil {
+		return fmt.Errorf("Error while listing record sets of DnsZone %q: %s", id, err)
+	}
+	sortDnsRecordSets(recordSets)
+
+	d.SetId(dnsZone.Id)
+	d.Set("zone", dnsZone.Zone)
+	d.Set("zone_file", formatDnsZoneFile(dnsZone.Zone, recordSets))
+
+	return d.Set("records", flattenDnsZoneRecordSets(recordSets))
+}
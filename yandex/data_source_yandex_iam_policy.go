@@ -29,9 +29,10 @@ var iamBinding = &schema.Schema{
 	Elem: &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"role": {
-				Type:        schema.TypeString,
-				Description: "The role/permission that will be granted to the members. See the [IAM Roles](https://yandex.cloud/docs/iam/concepts/access-control/roles) documentation for a complete list of roles.",
-				Required:    true,
+				Type:         schema.TypeString,
+				Description:  "The role/permission that will be granted to the members. See the [IAM Roles](https://yandex.cloud/docs/iam/concepts/access-control/roles) documentation for a complete list of roles.",
+				Required:     true,
+				ValidateFunc: validateIamRole,
 			},
 			"members": {
 				Type:        schema.TypeSet,
@@ -0,0 +1,126 @@
+package yandex
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	kms "github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1/asymmetricencryption"
+)
+
+func dataSourceYandexKMSAsymmetricEncryptionCiphertext() *schema.Resource {
+	return &schema.Resource{
+		Description: "Encrypts given plaintext with the public key of a Yandex KMS asymmetric encryption key. The plaintext is encrypted locally and is never sent to the API, the ciphertext can be decrypted only with the KMS key. For more information, see [the official documentation](https://yandex.cloud/docs/kms/concepts/asymmetric-encryption).\n\n" +
+			"~> Encryption is randomized, so the ciphertext changes on every read. Use it in places which are not compared between runs, e.g. outputs or artifacts of a pipeline.\n",
+
+		ReadContext: dataSourceYandexKMSAsymmetricEncryptionCiphertextRead,
+
+		Schema: map[string]*schema.Schema{
+			"key_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the asymmetric encryption KMS key to use for encryption.",
+				Required:    true,
+			},
+
+			"plaintext": {
+				Type:         schema.TypeString,
+				Description:  "Plaintext to be encrypted. Its maximum length depends on the key size.",
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+
+			"encryption_algorithm": {
+				Type:        schema.TypeString,
+				Description: "Encryption algorithm of the key.",
+				Computed:    true,
+			},
+
+			"public_key": {
+				Type:        schema.TypeString,
+				Description: "Public key in PEM format which was used for encryption.",
+				Computed:    true,
+			},
+
+			"ciphertext": {
+				Type:        schema.TypeString,
+				Description: "Resulting ciphertext, encoded with `standard` base64 alphabet as defined in RFC 4648 section 4.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceYandexKMSAsymmetricEncryptionCiphertextRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	keyID := d.Get("key_id").(string)
+
+	key, err := config.sdk.KMSAsymmetricEncryption().AsymmetricEncryptionKey().Get(ctx, &kms.GetAsymmetricEncryptionKeyRequest{
+		KeyId: keyID,
+	})
+	if err != nil {
+		return diag.Errorf("error while requesting API to get KMS asymmetric encryption key: %s", err)
+	}
+
+	publicKey, err := config.sdk.KMSAsymmetricEncryptionCrypto().AsymmetricEncryptionCrypto().GetPublicKey(ctx, &kms.AsymmetricGetPublicKeyRequest{
+		KeyId: keyID,
+	})
+	if err != nil {
+		return diag.Errorf("error while requesting API to get public key of KMS asymmetric encryption key: %s", err)
+	}
+
+	ciphertext, err := encryptWithKMSPublicKey(key.EncryptionAlgorithm, publicKey.PublicKey, []byte(d.Get("plaintext").(string)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%x", keyID, sha256.Sum256(ciphertext)))
+	d.Set("encryption_algorithm", key.EncryptionAlgorithm.String())
+	d.Set("public_key", publicKey.PublicKey)
+	d.Set("ciphertext", base64.StdEncoding.EncodeToString(ciphertext))
+
+	return nil
+}
+
+// encryptWithKMSPublicKey encrypts plaintext the same way as KMS does for the given algorithm,
+// so the ciphertext can be decrypted with AsymmetricEncryptionCryptoService.Decrypt.
+func encryptWithKMSPublicKey(algorithm kms.AsymmetricEncryptionAlgorithm, publicKeyPEM string, plaintext []byte) ([]byte, error) {
+	var hash crypto.Hash
+	switch algorithm {
+	case kms.AsymmetricEncryptionAlgorithm_RSA_2048_ENC_OAEP_SHA_256,
+		kms.AsymmetricEncryptionAlgorithm_RSA_3072_ENC_OAEP_SHA_256,
+		kms.AsymmetricEncryptionAlgorithm_RSA_4096_ENC_OAEP_SHA_256:
+		hash = crypto.SHA256
+	default:
+		return nil, fmt.Errorf("encryption algorithm %s is not supported", algorithm)
+	}
+
+	block, _ := pem.Decode([]byte(publicKeyPEM))
+	if block == nil {
+		return nil, fmt.Errorf("public key is not in PEM format")
+	}
+
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %s", err)
+	}
+	publicKey, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("public key of type %T is not an RSA key", parsed)
+	}
+
+	ciphertext, err := rsa.EncryptOAEP(hash.New(), rand.Reader, publicKey, plaintext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error encrypting plaintext: %s", err)
+	}
+	return ciphertext, nil
+}
@@ -0,0 +1,35 @@
+package yandex
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kms "github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1/asymmetricencryption"
+)
+
+func TestEncryptWithKMSPublicKey(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+	publicKeyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	ciphertext, err := encryptWithKMSPublicKey(kms.AsymmetricEncryptionAlgorithm_RSA_2048_ENC_OAEP_SHA_256, publicKeyPEM, []byte("secret"))
+	require.NoError(t, err)
+
+	plaintext, err := rsa.DecryptOAEP(crypto.SHA256.New(), nil, privateKey, ciphertext, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "secret", string(plaintext))
+
+	_, err = encryptWithKMSPublicKey(kms.AsymmetricEncryptionAlgorithm_ASYMMETRIC_ENCRYPTION_ALGORITHM_UNSPECIFIED, publicKeyPEM, []byte("secret"))
+	assert.ErrorContains(t, err, "is not supported")
+
+	_, err = encryptWithKMSPublicKey(kms.AsymmetricEncryptionAlgorithm_RSA_2048_ENC_OAEP_SHA_256, "not a key", []byte("secret"))
+	assert.ErrorContains(t, err, "PEM")
+}
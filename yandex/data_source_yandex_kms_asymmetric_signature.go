@@ -0,0 +1,81 @@
+package yandex
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	kms "github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1/asymmetricsignature"
+)
+
+func dataSourceYandexKMSAsymmetricSignature() *schema.Resource {
+	return &schema.Resource{
+		Description: "Signs a digest with a Yandex KMS asymmetric signature key. For more information, see [the official documentation](https://yandex.cloud/docs/kms/concepts/asymmetric-signature).\n\n" +
+			"The digest must be computed with the hash function of the key signature algorithm, e.g. with `sha256` for `RSA_2048_SIGN_PSS_SHA_256`. " +
+			"The signature can be verified with the `public_key` of the data source.\n\n" +
+			"~> Signatures of PSS and ECDSA algorithms are randomized, so the signature changes on every read.\n",
+
+		ReadContext: dataSourceYandexKMSAsymmetricSignatureRead,
+
+		Schema: map[string]*schema.Schema{
+			"key_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the asymmetric signature KMS key to use for signing.",
+				Required:    true,
+			},
+
+			"digest": {
+				Type:         schema.TypeString,
+				Description:  "Digest to be signed, encoded with `standard` base64 alphabet as defined in RFC 4648 section 4, e.g. the result of the `base64sha256` function.",
+				Required:     true,
+				ValidateFunc: validation.StringIsBase64,
+			},
+
+			"public_key": {
+				Type:        schema.TypeString,
+				Description: "Public key in PEM format which verifies the signature.",
+				Computed:    true,
+			},
+
+			"signature": {
+				Type:        schema.TypeString,
+				Description: "Resulting signature, encoded with `standard` base64 alphabet as defined in RFC 4648 section 4. RSA signatures are produced in accordance with RFC 8017, ECDSA signatures are DER-encoded as defined by RFC 3279.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceYandexKMSAsymmetricSignatureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	keyID := d.Get("key_id").(string)
+
+	digest, err := base64.StdEncoding.DecodeString(d.Get("digest").(string))
+	if err != nil {
+		return diag.Errorf("error decoding digest: %s", err)
+	}
+
+	resp, err := config.sdk.KMSAsymmetricSignatureCrypto().AsymmetricSignatureCrypto().SignHash(ctx, &kms.AsymmetricSignHashRequest{
+		KeyId: keyID,
+		Hash:  digest,
+	})
+	if err != nil {
+		return diag.Errorf("error while requesting API to sign digest with KMS asymmetric signature key: %s", err)
+	}
+
+	publicKey, err := config.sdk.KMSAsymmetricSignatureCrypto().AsymmetricSignatureCrypto().GetPublicKey(ctx, &kms.AsymmetricGetPublicKeyRequest{
+		KeyId: keyID,
+	})
+	if err != nil {
+		return diag.Errorf("error while requesting API to get public key of KMS asymmetric signature key: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%x", resp.KeyId, digest))
+	d.Set("public_key", publicKey.PublicKey)
+	d.Set("signature", base64.StdEncoding.EncodeToString(resp.Signature))
+
+	return nil
+}
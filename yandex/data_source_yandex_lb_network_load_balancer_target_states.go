@@ -0,0 +1,153 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+)
+
+func dataSourceYandexLBNetworkLoadBalancerTargetStates() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the health status of targets in target groups attached to a Yandex Network Load Balancer. For more information, see [the official documentation](https://yandex.cloud/docs/network-load-balancer/concepts/health-check).\n\nIf `target_group_id` is not set, states of all attached target groups are returned.\n",
+
+		Read: dataSourceYandexLBNetworkLoadBalancerTargetStatesRead,
+		Schema: map[string]*schema.Schema{
+			"network_load_balancer_id": {
+				Type:        schema.TypeString,
+				Description: "Network load balancer ID.",
+				Required:    true,
+			},
+
+			"target_group_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the attached target group to get states for.",
+				Optional:    true,
+			},
+
+			"healthy": {
+				Type:        schema.TypeBool,
+				Description: "`true` if every reported target is `HEALTHY`.",
+				Computed:    true,
+			},
+
+			"target_states": {
+				Type:        schema.TypeList,
+				Description: "Health states of the targets.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_group_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the target group the target belongs to.",
+							Computed:    true,
+						},
+						"subnet_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the subnet the target is connected to.",
+							Computed:    true,
+						},
+						"zone_id": {
+							Type:        schema.TypeString,
+							Description: "Availability zone of the target subnet.",
+							Computed:    true,
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Description: "IP address of the target.",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "Health status of the target: `INITIAL`, `HEALTHY`, `UNHEALTHY`, `DRAINING` or `INACTIVE`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceYandexLBNetworkLoadBalancerTargetStatesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+	nlbID := d.Get("network_load_balancer_id").(string)
+
+	targetGroupIDs := []string{d.Get("target_group_id").(string)}
+	if targetGroupIDs[0] == "" {
+		nlb, err := config.sdk.LoadBalancer().NetworkLoadBalancer().Get(ctx, &loadbalancer.GetNetworkLoadBalancerRequest{
+			NetworkLoadBalancerId: nlbID,
+		})
+		if err != nil {
+			return handleNotFoundError(err, d, fmt.Sprintf("network load balancer with ID %q", nlbID))
+		}
+
+		targetGroupIDs = targetGroupIDs[:0]
+		for _, atg := range nlb.AttachedTargetGroups {
+			targetGroupIDs = append(targetGroupIDs, atg.TargetGroupId)
+		}
+	}
+
+	states, err := getLBTargetStates(ctx, config, nlbID, targetGroupIDs)
+	if err != nil {
+		return err
+	}
+
+	zones := map[string]string{}
+	var flStates []map[string]interface{}
+	for _, tgID := range targetGroupIDs {
+		for _, state := range states[tgID] {
+			zoneID, err := lbSubnetZone(ctx, config, zones, state.SubnetId)
+			if err != nil {
+				return err
+			}
+			flStates = append(flStates, map[string]interface{}{
+				"target_group_id": tgID,
+				"subnet_id":       state.SubnetId,
+				"zone_id":         zoneID,
+				"address":         state.Address,
+				"status":          state.Status.String(),
+			})
+		}
+	}
+
+	d.SetId(nlbID + "/" + d.Get("target_group_id").(string))
+	d.Set("healthy", lbTargetStatesHealthy(states))
+
+	return d.Set("target_states", flStates)
+}
+
+func getLBTargetStates(ctx context.Context, config *Config, nlbID string, targetGroupIDs []string) (map[string][]*loadbalancer.TargetState, error) {
+	result := make(map[string][]*loadbalancer.TargetState, len(targetGroupIDs))
+	for _, tgID := range targetGroupIDs {
+		resp, err := config.sdk.LoadBalancer().NetworkLoadBalancer().GetTargetStates(ctx, &loadbalancer.GetTargetStatesRequest{
+			NetworkLoadBalancerId: nlbID,
+			TargetGroupId:         tgID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error while requesting API to get target states of target group %q in network load balancer %q: %s", tgID, nlbID, err)
+		}
+		result[tgID] = resp.TargetStates
+	}
+	return result, nil
+}
+
+func lbSubnetZone(ctx context.Context, config *Config, cache map[string]string, subnetID string) (string, error) {
+	if zoneID, ok := cache[subnetID]; ok {
+		return zoneID, nil
+	}
+
+	subnet, err := config.sdk.VPC().Subnet().Get(ctx, &vpc.GetSubnetRequest{
+		SubnetId: subnetID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("Error while requesting API to get subnet %q: %s", subnetID, err)
+	}
+
+	cache[subnetID] = subnet.ZoneId
+	return subnet.ZoneId, nil
+}
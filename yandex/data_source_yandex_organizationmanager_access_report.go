@@ -0,0 +1,385 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/exp/maps"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/containerregistry/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	k8s "github.com/yandex-cloud/go-genproto/yandex/cloud/k8s/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/containers/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/functions/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/ydb/v1"
+)
+
+// accessReportResourceType describes how to find resources of a type in a folder and how to get their access bindings.
+type accessReportResourceType struct {
+	list       func(ctx context.Context, config *Config, folderID string) ([]string, error)
+	newUpdater func(id string, config *Config) ResourceIamUpdater
+}
+
+var accessReportResourceTypes = map[string]accessReportResourceType{
+	"container_registry": {
+		list: func(ctx context.Context, config *Config, folderID string) ([]string, error) {
+			var ids []string
+			it := config.sdk.ContainerRegistry().Registry().RegistryIterator(ctx, &containerregistry.ListRegistriesRequest{FolderId: folderID})
+			for it.Next() {
+				ids = append(ids, it.Value().Id)
+			}
+			return ids, it.Error()
+		},
+		newUpdater: func(id string, config *Config) ResourceIamUpdater {
+			return &ContainerRegistryIamUpdater{registryID: id, Config: config}
+		},
+	},
+	"dns_zone": {
+		list: func(ctx context.Context, config *Config, folderID string) ([]string, error) {
+			var ids []string
+			it := config.sdk.DNS().DnsZone().DnsZoneIterator(ctx, &dns.ListDnsZonesRequest{FolderId: folderID})
+			for it.Next() {
+				ids = append(ids, it.Value().Id)
+			}
+			return ids, it.Error()
+		},
+		newUpdater: func(id string, config *Config) ResourceIamUpdater {
+			return &DnsZoneIamUpdater{dnsZoneId: id, Config: config}
+		},
+	},
+	"function": {
+		list: func(ctx context.Context, config *Config, folderID string) ([]string, error) {
+			var ids []string
+			it := config.sdk.Serverless().Functions().Function().FunctionIterator(ctx, &functions.ListFunctionsRequest{FolderId: folderID})
+			for it.Next() {
+				ids = append(ids, it.Value().Id)
+			}
+			return ids, it.Error()
+		},
+		newUpdater: func(id string, config *Config) ResourceIamUpdater {
+			return &FunctionIamUpdater{functionID: id, Config: config}
+		},
+	},
+	"kms_symmetric_key": {
+		list: func(ctx context.Context, config *Config, folderID string) ([]string, error) {
+			var ids []string
+			it := config.sdk.KMS().SymmetricKey().SymmetricKeyIterator(ctx, &kms.ListSymmetricKeysRequest{FolderId: folderID})
+			for it.Next() {
+				ids = append(ids, it.Value().Id)
+			}
+			return ids, it.Error()
+		},
+		newUpdater: func(id string, config *Config) ResourceIamUpdater {
+			return &KMSSymmetricKeyIamUpdater{symmetricKeyID: id, Config: config}
+		},
+	},
+	"kubernetes_cluster": {
+		list: func(ctx context.Context, config *Config, folderID string) ([]string, error) {
+			var ids []string
+			it := config.sdk.Kubernetes().Cluster().ClusterIterator(ctx, &k8s.ListClustersRequest{FolderId: folderID})
+			for it.Next() {
+				ids = append(ids, it.Value().Id)
+			}
+			return ids, it.Error()
+		},
+		newUpdater: func(id string, config *Config) ResourceIamUpdater {
+			return &KubernetesClusterIamUpdater{clusterID: id, Config: config}
+		},
+	},
+	"lockbox_secret": {
+		list: func(ctx context.Context, config *Config, folderID string) ([]string, error) {
+			var ids []string
+			it := config.sdk.LockboxSecret().Secret().SecretIterator(ctx, &lockbox.ListSecretsRequest{FolderId: folderID})
+			for it.Next() {
+				ids = append(ids, it.Value().Id)
+			}
+			return ids, it.Error()
+		},
+		newUpdater: func(id string, config *Config) ResourceIamUpdater {
+			return &LockboxSecretIamUpdater{secretId: id, Config: config}
+		},
+	},
+	"serverless_container": {
+		list: func(ctx context.Context, config *Config, folderID string) ([]string, error) {
+			var ids []string
+			it := config.sdk.Serverless().Containers().Container().ContainerIterator(ctx, &containers.ListContainersRequest{FolderId: folderID})
+			for it.Next() {
+				ids = append(ids, it.Value().Id)
+			}
+			return ids, it.Error()
+		},
+		newUpdater: func(id string, config *Config) ResourceIamUpdater {
+			return &ServerlessContainerIamUpdater{containerID: id, Config: config}
+		},
+	},
+	"service_account": {
+		list: func(ctx context.Context, config *Config, folderID string) ([]string, error) {
+			var ids []string
+			it := config.sdk.IAM().ServiceAccount().ServiceAccountIterator(ctx, &iam.ListServiceAccountsRequest{FolderId: folderID})
+			for it.Next() {
+				ids = append(ids, it.Value().Id)
+			}
+			return ids, it.Error()
+		},
+		newUpdater: func(id string, config *Config) ResourceIamUpdater {
+			return &ServiceAccountIamUpdater{serviceAccountID: id, Config: config}
+		},
+	},
+	"ydb_database": {
+		list: func(ctx context.Context, config *Config, folderID string) ([]string, error) {
+			var ids []string
+			it := config.sdk.YDB().Database().DatabaseIterator(ctx, &ydb.ListDatabasesRequest{FolderId: folderID})
+			for it.Next() {
+				ids = append(ids, it.Value().Id)
+			}
+			return ids, it.Error()
+		},
+		newUpdater: func(id string, config *Config) ResourceIamUpdater {
+			return &YDBDatabaseIamUpdater{databaseID: id, Config: config}
+		},
+	},
+}
+
+func accessReportResourceTypeNames() []string {
+	names := maps.Keys(accessReportResourceTypes)
+	sort.Strings(names)
+	return names
+}
+
+func dataSourceYandexOrganizationManagerAccessReport() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get a flat list of access bindings of the organization, all of its clouds and folders, and optionally of resources in these folders. It can be used to answer questions like \"who has the `admin` role anywhere in the organization\".\n\n~> The data source walks the whole organization, so reading it may take a long time and requires permissions to view access bindings of every walked resource.\n",
+
+		ReadContext: dataSourceYandexOrganizationManagerAccessReportRead,
+
+		Schema: map[string]*schema.Schema{
+			"organization_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the organization to build the report for. If value is omitted, the default provider organization ID is used.",
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"roles": {
+				Type:        schema.TypeSet,
+				Description: "Only report access bindings with these roles, e.g. `admin`.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+
+			"subjects": {
+				Type:        schema.TypeSet,
+				Description: "Only report access bindings of these subjects. A subject is either an ID or a member in the `{type}:{id}` format, e.g. `userAccount:some_user_id`.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+
+			"resource_types": {
+				Type:        schema.TypeSet,
+				Description: fmt.Sprintf("Types of resources in every folder whose access bindings are reported in addition to the organization, clouds and folders. Supported types: `%s`.", strings.Join(accessReportResourceTypeNames(), "`, `")),
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(accessReportResourceTypeNames(), false),
+				},
+				Set: schema.HashString,
+			},
+
+			"bindings": {
+				Type:        schema.TypeList,
+				Description: "Access bindings matching the filters.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subject": {
+							Type:        schema.TypeString,
+							Description: "Member the role is granted to, in the `{type}:{id}` format.",
+							Computed:    true,
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Description: "Granted role.",
+							Computed:    true,
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Description: "Type of the resource the role is granted on: `organization`, `cloud`, `folder` or one of `resource_types`.",
+							Computed:    true,
+						},
+						"resource_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the resource the role is granted on.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// accessReportBinding is a single flattened access binding.
+type accessReportBinding struct {
+	Subject      string
+	Role         string
+	ResourceType string
+	ResourceID   string
+}
+
+// accessReportFilter selects access bindings by roles and subjects. Empty sets match everything.
+type accessReportFilter struct {
+	roles    map[string]bool
+	subjects map[string]bool
+}
+
+func (f *accessReportFilter) match(b *access.AccessBinding) bool {
+	if len(f.roles) > 0 && !f.roles[b.RoleId] {
+		return false
+	}
+	if len(f.subjects) > 0 && !f.subjects[canonicalMember(b)] && !f.subjects[b.Subject.Id] {
+		return false
+	}
+	return true
+}
+
+type accessReportBuilder struct {
+	config   *Config
+	filter   accessReportFilter
+	bindings []accessReportBinding
+}
+
+func (r *accessReportBuilder) add(ctx context.Context, resourceType string, updater ResourceIamUpdater) error {
+	policy, err := updater.GetResourceIamPolicy(ctx)
+	if err != nil {
+		return fmt.Errorf("Error reading access bindings of %s: %w", updater.DescribeResource(), err)
+	}
+
+	for _, b := range policy.Bindings {
+		if !r.filter.match(b) {
+			continue
+		}
+		r.bindings = append(r.bindings, accessReportBinding{
+			Subject:      canonicalMember(b),
+			Role:         b.RoleId,
+			ResourceType: resourceType,
+			ResourceID:   updater.GetResourceID(),
+		})
+	}
+	return nil
+}
+
+func (r *accessReportBuilder) addFolder(ctx context.Context, folderID string, resourceTypes []string) error {
+	updater, err := newFolderIamUpdaterFromFolderID(folderID, r.config)
+	if err != nil {
+		return err
+	}
+	if err := r.add(ctx, "folder", updater); err != nil {
+		return err
+	}
+
+	for _, resourceType := range resourceTypes {
+		t := accessReportResourceTypes[resourceType]
+		ids, err := t.list(ctx, r.config, folderID)
+		if err != nil {
+			return fmt.Errorf("Error listing %s resources in folder %q: %w", resourceType, folderID, err)
+		}
+		for _, id := range ids {
+			if err := r.add(ctx, resourceType, t.newUpdater(id, r.config)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *accessReportBuilder) addCloud(ctx context.Context, cloudID string, resourceTypes []string) error {
+	if err := r.add(ctx, "cloud", &CloudIamUpdater{cloudID: cloudID, Config: r.config}); err != nil {
+		return err
+	}
+
+	it := r.config.sdk.ResourceManager().Folder().FolderIterator(ctx, &resourcemanager.ListFoldersRequest{CloudId: cloudID})
+	for it.Next() {
+		if err := r.addFolder(ctx, it.Value().Id, resourceTypes); err != nil {
+			return err
+		}
+	}
+	if err := it.Error(); err != nil {
+		return fmt.Errorf("Error listing folders of cloud %q: %w", cloudID, err)
+	}
+	return nil
+}
+
+func dataSourceYandexOrganizationManagerAccessReportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	organizationID, err := getOrganizationID(d, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceTypes := convertStringSet(d.Get("resource_types").(*schema.Set))
+	sort.Strings(resourceTypes)
+
+	r := &accessReportBuilder{
+		config: config,
+		filter: accessReportFilter{
+			roles:    stringSetToBoolMap(d.Get("roles").(*schema.Set)),
+			subjects: stringSetToBoolMap(d.Get("subjects").(*schema.Set)),
+		},
+	}
+
+	if err := r.add(ctx, "organization", &OrganizationIamUpdater{organizationID: organizationID, Config: config}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	it := config.sdk.ResourceManager().Cloud().CloudIterator(ctx, &resourcemanager.ListCloudsRequest{OrganizationId: organizationID})
+	for it.Next() {
+		if err := r.addCloud(ctx, it.Value().Id, resourceTypes); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := it.Error(); err != nil {
+		return diag.Errorf("Error listing clouds of organization %q: %s", organizationID, err)
+	}
+
+	if err := d.Set("bindings", flattenAccessReportBindings(r.bindings)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("organization_id", organizationID)
+	d.SetId(organizationID)
+
+	return nil
+}
+
+func flattenAccessReportBindings(bindings []accessReportBinding) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(bindings))
+	for _, b := range bindings {
+		result = append(result, map[string]interface{}{
+			"subject":       b.Subject,
+			"role":          b.Role,
+			"resource_type": b.ResourceType,
+			"resource_id":   b.ResourceID,
+		})
+	}
+	return result
+}
+
+func stringSetToBoolMap(s *schema.Set) map[string]bool {
+	m := make(map[string]bool, s.Len())
+	for _, v := range s.List() {
+		m[v.(string)] = true
+	}
+	return m
+}
@@ -0,0 +1,69 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+)
+
+func TestAccessReportFilter(t *testing.T) {
+	binding := &access.AccessBinding{
+		RoleId:  "admin",
+		Subject: &access.Subject{Type: "userAccount", Id: "user_id"},
+	}
+
+	tests := []struct {
+		name     string
+		filter   accessReportFilter
+		expected bool
+	}{
+		{
+			name:     "empty filter",
+			expected: true,
+		},
+		{
+			name:     "role matches",
+			filter:   accessReportFilter{roles: map[string]bool{"admin": true, "viewer": true}},
+			expected: true,
+		},
+		{
+			name:   "role does not match",
+			filter: accessReportFilter{roles: map[string]bool{"viewer": true}},
+		},
+		{
+			name:     "subject matches by member",
+			filter:   accessReportFilter{subjects: map[string]bool{"userAccount:user_id": true}},
+			expected: true,
+		},
+		{
+			name:     "subject matches by id",
+			filter:   accessReportFilter{roles: map[string]bool{"admin": true}, subjects: map[string]bool{"user_id": true}},
+			expected: true,
+		},
+		{
+			name:   "subject does not match",
+			filter: accessReportFilter{roles: map[string]bool{"admin": true}, subjects: map[string]bool{"serviceAccount:user_id": true}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.filter.match(binding))
+		})
+	}
+}
+
+func TestFlattenAccessReportBindings(t *testing.T) {
+	assert.Equal(t, []map[string]interface{}{}, flattenAccessReportBindings(nil))
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"subject":       "userAccount:user_id",
+			"role":          "admin",
+			"resource_type": "folder",
+			"resource_id":   "folder_id",
+		},
+	}, flattenAccessReportBindings([]accessReportBinding{
+		{Subject: "userAccount:user_id", Role: "admin", ResourceType: "folder", ResourceID: "folder_id"},
+	}))
+}
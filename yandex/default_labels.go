@@ -0,0 +1,173 @@
+package yandex
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/defaultlabels"
+)
+
+type crudContextFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics
+
+// hasConfigurableLabels reports whether the resource has a top-level `labels` map that can be set in the configuration.
+func hasConfigurableLabels(r *schema.Resource) bool {
+	s, ok := r.Schema["labels"]
+	if !ok || s.Type != schema.TypeMap || !s.Optional {
+		return false
+	}
+	_, ok = r.Schema["effective_labels"]
+	return !ok
+}
+
+// withDefaultLabels merges provider `default_labels` into labels of the resource.
+//
+// `labels` keeps the configured value, and the merged value is planned for `effective_labels`.
+// The resource code receives the merged value in `labels` on create and update, so the API gets all labels
+// without any changes in the resource code. Default labels reported by the API are stripped from `labels`
+// unless they are configured.
+func withDefaultLabels(r *schema.Resource) *schema.Resource {
+	keepUnconfigured := r.Schema["labels"].Computed
+
+	r.Schema["effective_labels"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Description: common.ResourceDescriptions["effective_labels"],
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+
+	if r.CustomizeDiff != nil {
+		r.CustomizeDiff = customdiff.All(r.CustomizeDiff, customizeDiffDefaultLabels(keepUnconfigured))
+	} else {
+		r.CustomizeDiff = customizeDiffDefaultLabels(keepUnconfigured)
+	}
+
+	r.Create = wrapDefaultLabels(r.Create, true)
+	r.Read = wrapDefaultLabels(r.Read, false)
+	r.Update = wrapDefaultLabels(r.Update, true)
+	r.CreateContext = wrapDefaultLabelsContext(r.CreateContext, true)
+	r.ReadContext = wrapDefaultLabelsContext(r.ReadContext, false)
+	r.UpdateContext = wrapDefaultLabelsContext(r.UpdateContext, true)
+	r.CreateWithoutTimeout = wrapDefaultLabelsContext(r.CreateWithoutTimeout, true)
+	r.ReadWithoutTimeout = wrapDefaultLabelsContext(r.ReadWithoutTimeout, false)
+	r.UpdateWithoutTimeout = wrapDefaultLabelsContext(r.UpdateWithoutTimeout, true)
+
+	return r
+}
+
+// customizeDiffDefaultLabels plans `effective_labels` as configured labels merged with the default ones.
+// If keepUnconfigured is set and labels are not configured, labels from the state are used instead
+// of an empty map, since the resource used to accept labels assigned outside of Terraform.
+func customizeDiffDefaultLabels(keepUnconfigured bool) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		rawConfig := d.GetRawConfig()
+		if rawConfig.IsNull() || !rawConfig.IsKnown() {
+			return nil
+		}
+
+		rawLabels := rawConfig.GetAttr("labels")
+		if !rawLabels.IsWhollyKnown() {
+			return d.SetNewComputed("effective_labels")
+		}
+
+		labels := ctyStringMap(rawLabels)
+		if rawLabels.IsNull() && keepUnconfigured {
+			if d.Id() == "" {
+				return d.SetNewComputed("effective_labels")
+			}
+			var err error
+			if labels, err = expandLabels(d.Get("labels")); err != nil {
+				return err
+			}
+		}
+
+		return d.SetNew("effective_labels", defaultlabels.Merge(meta.(*Config).DefaultLabels, labels))
+	}
+}
+
+func ctyStringMap(v cty.Value) map[string]string {
+	m := make(map[string]string)
+	if v.IsNull() {
+		return m
+	}
+	for k, val := range v.AsValueMap() {
+		if !val.IsNull() {
+			m[k] = val.AsString()
+		}
+	}
+	return m
+}
+
+// defaultLabelsApplier holds labels of the resource before a CRUD function is called.
+type defaultLabelsApplier struct {
+	defaults map[string]string
+	owned    map[string]string
+}
+
+// beforeCRUD remembers labels owned by the configuration or prior state. If merge is set,
+// `labels` is replaced with the merged value, so that the resource code sends all labels to the API.
+func beforeCRUD(d *schema.ResourceData, meta interface{}, merge bool) (*defaultLabelsApplier, error) {
+	owned, err := expandLabels(d.Get("labels"))
+	if err != nil {
+		return nil, err
+	}
+
+	a := &defaultLabelsApplier{defaults: meta.(*Config).DefaultLabels, owned: owned}
+	if merge && len(a.defaults) > 0 {
+		if err := d.Set("labels", defaultlabels.Merge(a.defaults, owned)); err != nil {
+			return nil, err
+		}
+	}
+	return a, nil
+}
+
+// afterCRUD saves labels of the resource as `effective_labels`, and strips default labels from `labels`.
+func (a *defaultLabelsApplier) afterCRUD(d *schema.ResourceData) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	labels, err := expandLabels(d.Get("labels"))
+	if err != nil {
+		return err
+	}
+	if err := d.Set("effective_labels", labels); err != nil {
+		return err
+	}
+	return d.Set("labels", defaultlabels.Strip(labels, a.defaults, a.owned))
+}
+
+func wrapDefaultLabels(f crudFunc, merge bool) crudFunc {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		a, err := beforeCRUD(d, meta, merge)
+		if err != nil {
+			return err
+		}
+		if err := f(d, meta); err != nil {
+			return errors.Join(err, a.afterCRUD(d))
+		}
+		return a.afterCRUD(d)
+	}
+}
+
+func wrapDefaultLabelsContext(f crudContextFunc, merge bool) crudContextFunc {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		a, err := beforeCRUD(d, meta, merge)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		diags := f(ctx, d, meta)
+		return append(diags, diag.FromErr(a.afterCRUD(d))...)
+	}
+}
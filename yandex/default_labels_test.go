@@ -0,0 +1,176 @@
+package yandex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDefaultLabelsResource(computed bool) *schema.Resource {
+	return withDefaultLabels(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: computed,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		Create: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Read:   func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Update: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Delete: func(d *schema.ResourceData, meta interface{}) error { return nil },
+	})
+}
+
+func testDefaultLabelsDiff(t *testing.T, r *schema.Resource, stateAttrs map[string]string, labels cty.Value) *terraform.InstanceDiff {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{})
+	if !labels.IsNull() {
+		raw := make(map[string]interface{})
+		for k, v := range ctyStringMap(labels) {
+			raw[k] = v
+		}
+		config = terraform.NewResourceConfigRaw(map[string]interface{}{"labels": raw})
+	}
+
+	state := &terraform.InstanceState{
+		ID:         "id",
+		Attributes: stateAttrs,
+		RawConfig:  cty.ObjectVal(map[string]cty.Value{"name": cty.NullVal(cty.String), "labels": labels, "effective_labels": cty.NullVal(cty.Map(cty.String))}),
+	}
+	meta := &Config{DefaultLabels: map[string]string{"env": "prod", "team": "core"}}
+
+	diff, err := r.Diff(context.Background(), state, config, meta)
+	require.NoError(t, err)
+	return diff
+}
+
+func TestDefaultLabelsDiff(t *testing.T) {
+	r := testDefaultLabelsResource(false)
+
+	t.Run("defaults are planned", func(t *testing.T) {
+		diff := testDefaultLabelsDiff(t, r, map[string]string{"id": "id"},
+			cty.MapVal(map[string]cty.Value{"app": cty.StringVal("web")}))
+		require.NotNil(t, diff)
+		assert.Equal(t, "web", diff.Attributes["labels.app"].New)
+		assert.NotContains(t, diff.Attributes, "labels.env")
+		assert.Equal(t, "prod", diff.Attributes["effective_labels.env"].New)
+		assert.Equal(t, "core", diff.Attributes["effective_labels.team"].New)
+		assert.Equal(t, "web", diff.Attributes["effective_labels.app"].New)
+	})
+
+	t.Run("no diff for default labels in state", func(t *testing.T) {
+		diff := testDefaultLabelsDiff(t, r, map[string]string{
+			"id":                    "id",
+			"labels.%":              "1",
+			"labels.app":            "web",
+			"effective_labels.%":    "3",
+			"effective_labels.app":  "web",
+			"effective_labels.env":  "prod",
+			"effective_labels.team": "core",
+		}, cty.MapVal(map[string]cty.Value{"app": cty.StringVal("web")}))
+		assert.Nil(t, diff)
+	})
+
+	t.Run("configured labels take precedence", func(t *testing.T) {
+		diff := testDefaultLabelsDiff(t, r, map[string]string{
+			"id":                    "id",
+			"effective_labels.%":    "2",
+			"effective_labels.env":  "prod",
+			"effective_labels.team": "core",
+		}, cty.MapVal(map[string]cty.Value{"env": cty.StringVal("test")}))
+		require.NotNil(t, diff)
+		assert.Equal(t, "test", diff.Attributes["labels.env"].New)
+		assert.Equal(t, "test", diff.Attributes["effective_labels.env"].New)
+		assert.NotContains(t, diff.Attributes, "effective_labels.team")
+	})
+
+	t.Run("removed labels produce a diff", func(t *testing.T) {
+		diff := testDefaultLabelsDiff(t, r, map[string]string{
+			"id":                    "id",
+			"labels.%":              "1",
+			"labels.old":            "value",
+			"effective_labels.%":    "3",
+			"effective_labels.env":  "prod",
+			"effective_labels.team": "core",
+			"effective_labels.old":  "value",
+		}, cty.NullVal(cty.Map(cty.String)))
+		require.NotNil(t, diff)
+		assert.True(t, diff.Attributes["labels.old"].NewRemoved)
+		assert.True(t, diff.Attributes["effective_labels.old"].NewRemoved)
+	})
+}
+
+func TestDefaultLabelsDiffKeepsUnconfiguredComputedLabels(t *testing.T) {
+	r := testDefaultLabelsResource(true)
+
+	diff := testDefaultLabelsDiff(t, r, map[string]string{
+		"id":                "id",
+		"labels.%":          "1",
+		"labels.managed-by": "service",
+	}, cty.NullVal(cty.Map(cty.String)))
+	require.NotNil(t, diff)
+	assert.NotContains(t, diff.Attributes, "labels.managed-by")
+	assert.Equal(t, "prod", diff.Attributes["effective_labels.env"].New)
+	assert.Equal(t, "service", diff.Attributes["effective_labels.managed-by"].New)
+}
+
+func TestDefaultLabelsApply(t *testing.T) {
+	var sent map[string]interface{}
+	r := withDefaultLabels(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			sent = d.Get("labels").(map[string]interface{})
+			d.SetId("id")
+			return nil
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			// The API reports all labels of the resource.
+			return d.Set("labels", map[string]string{"app": "web", "env": "prod", "team": "core"})
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error { return nil },
+	})
+	meta := &Config{DefaultLabels: map[string]string{"env": "prod", "team": "core"}}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"labels": map[string]interface{}{"app": "web"},
+	})
+	require.NoError(t, r.Create(d, meta))
+	assert.Equal(t, map[string]interface{}{"app": "web", "env": "prod", "team": "core"}, sent)
+	assert.Equal(t, map[string]interface{}{"app": "web"}, d.Get("labels"))
+	assert.Equal(t, map[string]interface{}{"app": "web", "env": "prod", "team": "core"}, d.Get("effective_labels"))
+
+	require.NoError(t, r.Read(d, meta))
+	assert.Equal(t, map[string]interface{}{"app": "web"}, d.Get("labels"))
+	assert.Equal(t, map[string]interface{}{"app": "web", "env": "prod", "team": "core"}, d.Get("effective_labels"))
+}
+
+func TestDefaultLabelsSchema(t *testing.T) {
+	assert.True(t, hasConfigurableLabels(&schema.Resource{Schema: map[string]*schema.Schema{
+		"labels": {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+	}}))
+	assert.False(t, hasConfigurableLabels(&schema.Resource{Schema: map[string]*schema.Schema{
+		"labels": {Type: schema.TypeMap, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+	}}))
+	assert.False(t, hasConfigurableLabels(testDefaultLabelsResource(false)))
+
+	r := testDefaultLabelsResource(false)
+	assert.False(t, r.Schema["labels"].Computed)
+	assert.True(t, r.Schema["effective_labels"].Computed)
+	require.NoError(t, r.InternalValidate(nil, true))
+}
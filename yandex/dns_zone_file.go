@@ -0,0 +1,294 @@
+package yandex
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+)
+
+const dnsZoneFileDefaultTTL = 600
+
+// parseDnsZoneFile parses a subset of the RFC 1035 master file format into record sets.
+// Supported are the $ORIGIN and $TTL directives, comments, multi-line records in
+// parentheses, omitted owners and the optional TTL and class fields. Relative names are
+// resolved against origin, which is the zone FQDN unless overridden by $ORIGIN.
+func parseDnsZoneFile(content, origin string) ([]*dns.RecordSet, error) {
+	lines, err := splitDnsZoneFileLines(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		owner      string
+		defaultTTL int64 = dnsZoneFileDefaultTTL
+		recordSets []*dns.RecordSet
+		index      = make(map[string]*dns.RecordSet)
+	)
+
+	for _, line := range lines {
+		tokens, err := tokenizeDnsZoneFileLine(line.text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", line.number, err)
+		}
+		if len(tokens) == 0 {
+			continue
+		}
+
+		switch strings.ToUpper(tokens[0]) {
+		case "$ORIGIN":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: $ORIGIN expects exactly one argument", line.number)
+			}
+			origin = dnsZoneRecordFQDN(tokens[1], origin)
+			continue
+		case "$TTL":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: $TTL expects exactly one argument", line.number)
+			}
+			ttl, ok := parseDnsZoneFileTTL(tokens[1])
+			if !ok {
+				return nil, fmt.Errorf("line %d: invalid $TTL value %q", line.number, tokens[1])
+			}
+			defaultTTL = ttl
+			continue
+		case "$INCLUDE", "$GENERATE":
+			return nil, fmt.Errorf("line %d: %s directive is not supported", line.number, tokens[0])
+		}
+
+		if !line.continuesOwner {
+			owner = dnsZoneRecordFQDN(tokens[0], origin)
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, fmt.Errorf("line %d: record has no owner name", line.number)
+		}
+
+		ttl := defaultTTL
+		for len(tokens) > 0 {
+			if v, ok := parseDnsZoneFileTTL(tokens[0]); ok {
+				ttl = v
+			} else if !isDnsZoneFileClass(tokens[0]) {
+				break
+			}
+			tokens = tokens[1:]
+		}
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("line %d: record must have a type and data", line.number)
+		}
+
+		rrType := strings.ToUpper(tokens[0])
+		data := strings.Join(tokens[1:], " ")
+
+		key := owner + " " + rrType
+		rs, ok := index[key]
+		if !ok {
+			rs = &dns.RecordSet{Name: owner, Type: rrType, Ttl: ttl}
+			index[key] = rs
+			recordSets = append(recordSets, rs)
+		}
+		rs.Data = append(rs.Data, data)
+	}
+
+	return recordSets, nil
+}
+
+// formatDnsZoneFile renders record sets in the master file format with names relative to origin.
+func formatDnsZoneFile(origin string, recordSets []*dns.RecordSet) string {
+	sorted := make([]*dns.RecordSet, len(recordSets))
+	copy(sorted, recordSets)
+	sortDnsRecordSets(sorted)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s\n", origin)
+	for _, rs := range sorted {
+		name := dnsZoneRecordRelativeName(rs.Name, origin)
+		for _, data := range rs.Data {
+			fmt.Fprintf(&b, "%s\t%d\tIN\t%s\t%s\n", name, rs.Ttl, rs.Type, data)
+		}
+	}
+	return b.String()
+}
+
+// dnsZoneRecordFQDN resolves a possibly relative record name against origin.
+func dnsZoneRecordFQDN(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return name
+	case origin == "":
+		return name
+	case origin == ".":
+		return name + "."
+	default:
+		return name + "." + origin
+	}
+}
+
+func dnsZoneRecordRelativeName(name, origin string) string {
+	if name == origin {
+		return "@"
+	}
+	if strings.HasSuffix(name, "."+origin) {
+		return strings.TrimSuffix(name, "."+origin)
+	}
+	return name
+}
+
+func sortDnsRecordSets(recordSets []*dns.RecordSet) {
+	sort.Slice(recordSets, func(i, j int) bool {
+		if recordSets[i].Name != recordSets[j].Name {
+			return recordSets[i].Name < recordSets[j].Name
+		}
+		return recordSets[i].Type < recordSets[j].Type
+	})
+}
+
+type dnsZoneFileLine struct {
+	number         int
+	text           string
+	continuesOwner bool
+}
+
+// splitDnsZoneFileLines strips comments and joins records spanning several lines in parentheses.
+func splitDnsZoneFileLines(content string) ([]dnsZoneFileLine, error) {
+	var (
+		result  []dnsZoneFileLine
+		current *dnsZoneFileLine
+		depth   int
+	)
+
+	for i, raw := range strings.Split(content, "\n") {
+		text := stripDnsZoneFileComment(strings.TrimRight(raw, "\r"))
+
+		if current == nil {
+			if strings.TrimSpace(text) == "" {
+				continue
+			}
+			current = &dnsZoneFileLine{
+				number:         i + 1,
+				continuesOwner: text[0] == ' ' || text[0] == '\t',
+			}
+		}
+
+		for _, r := range text {
+			switch r {
+			case '(':
+				depth++
+			case ')':
+				depth--
+			}
+		}
+		if depth < 0 {
+			return nil, fmt.Errorf("line %d: unbalanced parentheses", i+1)
+		}
+
+		current.text += " " + strings.NewReplacer("(", " ", ")", " ").Replace(text)
+		if depth == 0 {
+			result = append(result, *current)
+			current = nil
+		}
+	}
+
+	if current != nil {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", current.number)
+	}
+	return result, nil
+}
+
+func stripDnsZoneFileComment(line string) string {
+	quoted := false
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '"':
+			quoted = !quoted
+		case ';':
+			if !quoted {
+				return line[:i]
+			}
+		}
+	}
+	return line
+}
+
+// tokenizeDnsZoneFileLine splits a line by whitespace keeping quoted strings intact.
+func tokenizeDnsZoneFileLine(line string) ([]string, error) {
+	var (
+		tokens  []string
+		current strings.Builder
+		quoted  bool
+	)
+
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == '\\' && i+1 < len(line):
+			current.WriteByte(c)
+			current.WriteByte(line[i+1])
+			i++
+		case c == '"':
+			quoted = !quoted
+			current.WriteByte(c)
+		case (c == ' ' || c == '\t') && !quoted:
+			flush()
+		default:
+			current.WriteByte(c)
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unterminated quoted string")
+	}
+	flush()
+
+	return tokens, nil
+}
+
+// parseDnsZoneFileTTL parses a TTL given either in seconds or in BIND notation like "1h30m".
+func parseDnsZoneFileTTL(s string) (int64, bool) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return v, v >= 0
+	}
+
+	units := map[byte]int64{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+
+	var total, current int64
+	digits := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+			current = current*10 + int64(c-'0')
+			digits = true
+		default:
+			unit, ok := units[c|0x20]
+			if !ok || !digits {
+				return 0, false
+			}
+			total += current * unit
+			current, digits = 0, false
+		}
+	}
+	if digits || total == 0 {
+		return 0, false
+	}
+	return total, true
+}
+
+func isDnsZoneFileClass(s string) bool {
+	switch strings.ToUpper(s) {
+	case "IN", "CH", "HS", "CS":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+)
+
+func TestParseDnsZoneFile(t *testing.T) {
+	content := `
+$TTL 1h
+@       IN SOA ns1.yandexcloud.net. mx.cloud.yandex.net. (
+            1        ; serial
+            3600     ; refresh
+            600      ; retry
+            604800   ; expire
+            86400 )  ; minimum
+@       IN NS  ns1.yandexcloud.net.
+www     300 IN A 192.0.2.10
+        300 IN A 192.0.2.11
+api     CNAME www
+mail.example.com. IN 120 MX 10 mx1.example.com.
+txt     TXT "v=spf1 include:_spf.example.com ~all" ; spf
+$ORIGIN sub.example.com.
+host    A 192.0.2.20
+`
+
+	recordSets, err := parseDnsZoneFile(content, "example.com.")
+	require.NoError(t, err)
+	require.Equal(t, []*dns.RecordSet{
+		{Name: "example.com.", Type: "SOA", Ttl: 3600, Data: []string{"ns1.yandexcloud.net. mx.cloud.yandex.net. 1 3600 600 604800 86400"}},
+		{Name: "example.com.", Type: "NS", Ttl: 3600, Data: []string{"ns1.yandexcloud.net."}},
+		{Name: "www.example.com.", Type: "A", Ttl: 300, Data: []string{"192.0.2.10", "192.0.2.11"}},
+		{Name: "api.example.com.", Type: "CNAME", Ttl: 3600, Data: []string{"www"}},
+		{Name: "mail.example.com.", Type: "MX", Ttl: 120, Data: []string{"10 mx1.example.com."}},
+		{Name: "txt.example.com.", Type: "TXT", Ttl: 3600, Data: []string{`"v=spf1 include:_spf.example.com ~all"`}},
+		{Name: "host.sub.example.com.", Type: "A", Ttl: 3600, Data: []string{"192.0.2.20"}},
+	}, recordSets)
+}
+
+func TestParseDnsZoneFileErrors(t *testing.T) {
+	cases := map[string]string{
+		"unbalanced parentheses": "@ SOA ns1. mx. ( 1 2 3 4",
+		"missing data":           "www 300 IN A",
+		"unterminated quote":     `txt TXT "unterminated`,
+		"missing owner":          "  300 IN A 192.0.2.1",
+		"include directive":      "$INCLUDE other.zone",
+	}
+
+	for name, content := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseDnsZoneFile(content, "example.com.")
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestFormatDnsZoneFileRoundTrip(t *testing.T) {
+	recordSets := []*dns.RecordSet{
+		{Name: "www.example.com.", Type: "A", Ttl: 300, Data: []string{"192.0.2.10"}},
+		{Name: "example.com.", Type: "MX", Ttl: 600, Data: []string{"10 mx1.example.com."}},
+		{Name: "txt.example.com.", Type: "TXT", Ttl: 600, Data: []string{`"a b; c"`}},
+	}
+
+	content := formatDnsZoneFile("example.com.", recordSets)
+	require.Equal(t, "$ORIGIN example.com.\n"+
+		"@\t600\tIN\tMX\t10 mx1.example.com.\n"+
+		"txt\t600\tIN\tTXT\t\"a b; c\"\n"+
+		"www\t300\tIN\tA\t192.0.2.10\n", content)
+
+	parsed, err := parseDnsZoneFile(content, "other.com.")
+	require.NoError(t, err)
+	require.ElementsMatch(t, recordSets, parsed)
+}
+
+func TestDiffDnsZoneRecordSets(t *testing.T) {
+	current := []*dns.RecordSet{
+		{Name: "a.example.com.", Type: "A", Ttl: 300, Data: []string{"192.0.2.1", "192.0.2.2"}},
+		{Name: "b.example.com.", Type: "A", Ttl: 300, Data: []string{"192.0.2.3"}},
+		{Name: "c.example.com.", Type: "CNAME", Ttl: 300, Data: []string{"a.example.com."}},
+	}
+	desired := []*dns.RecordSet{
+		{Name: "a.example.com.", Type: "A", Ttl: 300, Data: []string{"192.0.2.2", "192.0.2.1"}},
+		{Name: "b.example.com.", Type: "A", Ttl: 600, Data: []string{"192.0.2.3"}},
+		{Name: "d.example.com.", Type: "TXT", Ttl: 300, Data: []string{`"new"`}},
+	}
+
+	deletions, replacements := diffDnsZoneRecordSets(current, desired)
+	require.Equal(t, []*dns.RecordSet{current[2]}, deletions)
+	require.Equal(t, []*dns.RecordSet{desired[1], desired[2]}, replacements)
+}
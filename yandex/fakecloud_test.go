@@ -0,0 +1,111 @@
+package yandex
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers/fakecloud"
+)
+
+// skipWithoutTerraform skips offline tests when the terraform binary is neither configured nor found in PATH,
+// instead of letting the test framework download it.
+func skipWithoutTerraform(t *testing.T) {
+	t.Helper()
+
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" {
+		return
+	}
+	if _, err := exec.LookPath("terraform"); err != nil {
+		t.Skip("terraform binary is not found, set TF_ACC_TERRAFORM_PATH to run the test")
+	}
+}
+
+func TestFakeCloud_ComputeInstance(t *testing.T) {
+	skipWithoutTerraform(t)
+	fakecloud.NewTestServer(t)
+
+	var network vpc.Network
+	var instance compute.Instance
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckComputeInstanceDestroy,
+			testAccCheckVPCNetworkDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeCloudComputeInstanceConfig("first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCNetworkExists("yandex_vpc_network.net", &network),
+					testAccCheckComputeInstanceExists("yandex_compute_instance.vm", &instance),
+					resource.TestCheckResourceAttr("yandex_compute_instance.vm", "folder_id", fakecloud.FolderID),
+					resource.TestCheckResourceAttr("yandex_compute_instance.vm", "status", "running"),
+					resource.TestCheckResourceAttr("yandex_compute_instance.vm", "labels.step", "first"),
+					resource.TestCheckResourceAttr("yandex_compute_instance.vm", "network_interface.0.ip_address", "10.2.0.3"),
+					resource.TestCheckResourceAttrPair("yandex_compute_instance.vm", "network_interface.0.subnet_id", "yandex_vpc_subnet.subnet", "id"),
+					resource.TestCheckResourceAttrPair("yandex_compute_instance.vm", "boot_disk.0.disk_id", "yandex_compute_disk.boot", "id"),
+					testAccCheckComputeInstanceLabel(&instance, "step", "first"),
+				),
+			},
+			{
+				Config: testFakeCloudComputeInstanceConfig("second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists("yandex_compute_instance.vm", &instance),
+					resource.TestCheckResourceAttr("yandex_compute_instance.vm", "labels.step", "second"),
+					testAccCheckComputeInstanceLabel(&instance, "step", "second"),
+				),
+			},
+			{
+				ResourceName:      "yandex_vpc_network.net",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testFakeCloudComputeInstanceConfig(step string) string {
+	return fmt.Sprintf(`
+resource "yandex_vpc_network" "net" {
+  name = "fakecloud-net"
+}
+
+resource "yandex_vpc_subnet" "subnet" {
+  network_id     = yandex_vpc_network.net.id
+  v4_cidr_blocks = ["10.2.0.0/16"]
+}
+
+resource "yandex_compute_disk" "boot" {
+  name = "fakecloud-boot"
+  size = 10
+}
+
+resource "yandex_compute_instance" "vm" {
+  name = "fakecloud-vm"
+
+  labels = {
+    step = "%s"
+  }
+
+  resources {
+    cores  = 2
+    memory = 2
+  }
+
+  boot_disk {
+    disk_id = yandex_compute_disk.boot.id
+  }
+
+  network_interface {
+    subnet_id = yandex_vpc_subnet.subnet.id
+  }
+}
+`, step)
+}
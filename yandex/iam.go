@@ -7,6 +7,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/accessbinding"
 )
 
 type PolicyDelta struct {
@@ -74,23 +75,15 @@ func iamPolicyReadModifySet(ctx context.Context, updater ResourceIamUpdater, mod
 	return nil
 }
 
-func iamPolicyReadModifyUpdate(ctx context.Context, updater ResourceIamUpdater, policyDelta *PolicyDelta) error {
-	mutexKey := updater.GetMutexKey()
-	mutexKV.Lock(mutexKey)
-	defer mutexKV.Unlock(mutexKey)
-
-	log.Printf("[DEBUG]: Retrieving access bindings for %s\n", updater.DescribeResource())
-
-	p, err := updater.GetResourceIamPolicy(ctx)
-	if err != nil {
-		return err
-	}
-
-	log.Printf("[DEBUG]: Retrieved access bindings for %s: %+v\n", updater.DescribeResource(), p)
+// iamDeltaBatcher coalesces concurrent `iam_member` changes of the same resource.
+var iamDeltaBatcher = accessbinding.NewDeltaBatcher(mutexKV, accessbinding.DefaultBatchWindow, accessbinding.UpdateAccessBindingsBatchSize)
 
+func iamPolicyReadModifyUpdate(ctx context.Context, updater ResourceIamUpdater, policyDelta *PolicyDelta) error {
 	log.Printf("[DEBUG]: Updating access bindings of %s with %+v\n", updater.DescribeResource(), policyDelta)
 
-	err = updater.UpdateResourceIamPolicy(ctx, policyDelta)
+	err := iamDeltaBatcher.Submit(ctx, updater.GetMutexKey(), policyDelta.Deltas, func(ctx context.Context, deltas []*access.AccessBindingDelta) error {
+		return updater.UpdateResourceIamPolicy(ctx, &PolicyDelta{Deltas: deltas})
+	})
 	if err != nil {
 		return fmt.Errorf("Error updating access bindings of %s: %w", updater.DescribeResource(), err)
 	}
@@ -17,10 +17,11 @@ import (
 
 var accessBindingSchema = map[string]*schema.Schema{
 	"role": {
-		Type:        schema.TypeString,
-		Required:    true,
-		ForceNew:    true,
-		Description: "The role that should be applied. See [roles catalog](https://yandex.cloud/docs/iam/roles-reference).",
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validateIamRole,
+		Description:  "The role that should be applied. See [roles catalog](https://yandex.cloud/docs/iam/roles-reference).",
 	},
 	"members": {
 		Type:        schema.TypeSet,
@@ -45,6 +46,7 @@ func resourceIamBinding(parentSpecificSchema map[string]*schema.Schema, newUpdat
 		ReadContext:   resourceAccessBindingRead(newUpdaterFunc, false),
 		UpdateContext: resourceAccessBindingUpdate(newUpdaterFunc),
 		DeleteContext: resourceAccessBindingDelete(newUpdaterFunc),
+		CustomizeDiff: customizeDiffIamOnlineValidation("members"),
 		Schema:        mergeSchemas(accessBindingSchema, parentSpecificSchema),
 	}
 
@@ -14,14 +14,16 @@ import (
 	"google.golang.org/grpc/codes"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
 )
 
 var IamMemberBaseSchema = map[string]*schema.Schema{
 	"role": {
-		Type:        schema.TypeString,
-		Required:    true,
-		ForceNew:    true,
-		Description: "The role that should be applied. See [roles catalog](https://yandex.cloud/docs/iam/roles-reference).",
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validateIamRole,
+		Description:  "The role that should be applied. See [roles catalog](https://yandex.cloud/docs/iam/roles-reference).",
 	},
 	"member": {
 		Type:         schema.TypeString,
@@ -39,13 +41,53 @@ var IamMemberBaseSchema = map[string]*schema.Schema{
 }
 
 func validateIamMember(i interface{}, k string) (s []string, es []error) {
-	chunks := strings.SplitN(i.(string), ":", 2)
-	if len(chunks) == 1 || chunks[0] == "" || chunks[1] == "" {
-		es = append(es, fmt.Errorf("expect 'member' value should be in TYPE:ID format, got '%v'", i.(string)))
+	if err := validate.IamMember(i.(string)); err != nil {
+		es = append(es, fmt.Errorf("invalid %s: %s", k, err))
+	}
+	return
+}
+
+func validateIamRole(i interface{}, k string) (s []string, es []error) {
+	if err := validate.IamRole(i.(string)); err != nil {
+		es = append(es, fmt.Errorf("invalid %s: %s", k, err))
 	}
 	return
 }
 
+// customizeDiffIamOnlineValidation verifies the role and members against the API if `iam_online_validation` is enabled in the provider.
+func customizeDiffIamOnlineValidation(membersKey string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		config := meta.(*Config)
+		if !config.IamOnlineValidation {
+			return nil
+		}
+		checker := validate.GetIamChecker(config.sdk)
+
+		if d.NewValueKnown("role") {
+			if err := checker.CheckRole(ctx, d.Get("role").(string)); err != nil {
+				return err
+			}
+		}
+
+		if !d.NewValueKnown(membersKey) {
+			return nil
+		}
+		var members []string
+		switch v := d.Get(membersKey).(type) {
+		case string:
+			members = []string{v}
+		case *schema.Set:
+			members = convertStringSet(v)
+		}
+		for _, member := range members {
+			if err := checker.CheckMember(ctx, member); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
 func iamMemberImport(resourceIDParser resourceIDParserFunc) schema.StateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 		if resourceIDParser == nil {
@@ -89,6 +131,8 @@ func resourceIamMember(parentSpecificSchema map[string]*schema.Schema, newUpdate
 		ReadContext:   resourceIamMemberRead(newUpdaterFunc),
 		DeleteContext: resourceIamMemberDelete(newUpdaterFunc),
 
+		CustomizeDiff: customizeDiffIamOnlineValidation("member"),
+
 		Schema:      mergeSchemas(IamMemberBaseSchema, parentSpecificSchema),
 		Description: "Member Resource Description.",
 	}
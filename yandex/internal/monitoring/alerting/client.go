@@ -0,0 +1,291 @@
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/monitoring/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const DefaultEndpoint = "https://monitoring.api.cloud.yandex.net/monitoring/v3"
+
+// EndpointFromAPI returns the Monitoring REST endpoint of the installation serving the gRPC API endpoint
+// (e.g. api.cloud.yandex.net:443), so the provider `endpoint` override applies to alerting as well.
+func EndpointFromAPI(apiEndpoint string, plaintext bool) string {
+	if apiEndpoint == "" {
+		return DefaultEndpoint
+	}
+
+	host := apiEndpoint
+	if h, _, err := net.SplitHostPort(apiEndpoint); err == nil {
+		host = h
+	}
+	scheme := "https"
+	if plaintext {
+		scheme = "http"
+	}
+	return fmt.Sprintf("%s://monitoring.%s/monitoring/v3", scheme, host)
+}
+
+//go:generate mockgen -destination=mocks/mock.go -package=mocks . Client
+
+// Client manages Monitoring alerts and notification channels.
+// Alerting is served by the Monitoring REST API only, so the resources work
+// through this interface instead of the gRPC SDK.
+type Client interface {
+	CreateAlert(ctx context.Context, alert *Alert) (*Alert, error)
+	GetAlert(ctx context.Context, alertID string) (*Alert, error)
+	UpdateAlert(ctx context.Context, alert *Alert) (*Alert, error)
+	DeleteAlert(ctx context.Context, alertID string) error
+
+	CreateChannel(ctx context.Context, channel *Channel) (*Channel, error)
+	GetChannel(ctx context.Context, channelID string) (*Channel, error)
+	UpdateChannel(ctx context.Context, channel *Channel) (*Channel, error)
+	DeleteChannel(ctx context.Context, channelID string) error
+}
+
+type Alert struct {
+	ID          string            `json:"id,omitempty"`
+	FolderID    string            `json:"folderId,omitempty"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Window and Delay use the protobuf JSON duration format, e.g. "300s".
+	Window     string            `json:"window,omitempty"`
+	Delay      string            `json:"delay,omitempty"`
+	Threshold  *Threshold        `json:"threshold,omitempty"`
+	Expression *Expression       `json:"expression,omitempty"`
+	Channels   []*ChannelBinding `json:"channels,omitempty"`
+	// Parametrization shares its model with dashboards.
+	Parametrization *monitoring.Parametrization `json:"-"`
+	CreatedAt       string                      `json:"createdAt,omitempty"`
+}
+
+type Threshold struct {
+	Query        string   `json:"query"`
+	Evaluation   string   `json:"evaluation,omitempty"`
+	Predicate    string   `json:"predicate"`
+	Warn         *float64 `json:"warn,omitempty"`
+	Alarm        *float64 `json:"alarm,omitempty"`
+	NoDataPolicy string   `json:"noDataPolicy,omitempty"`
+}
+
+type Expression struct {
+	Program      string `json:"program"`
+	NoDataPolicy string `json:"noDataPolicy,omitempty"`
+}
+
+type ChannelBinding struct {
+	ChannelID           string   `json:"channelId"`
+	NotifyAboutStatuses []string `json:"notifyAboutStatuses,omitempty"`
+	RepeatPeriod        string   `json:"repeatPeriod,omitempty"`
+}
+
+type Channel struct {
+	ID          string            `json:"id,omitempty"`
+	FolderID    string            `json:"folderId,omitempty"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Email       *EmailChannel     `json:"email,omitempty"`
+	Sms         *SmsChannel       `json:"sms,omitempty"`
+	Telegram    *TelegramChannel  `json:"telegram,omitempty"`
+	Webhook     *WebhookChannel   `json:"webhook,omitempty"`
+	CreatedAt   string            `json:"createdAt,omitempty"`
+}
+
+type EmailChannel struct {
+	Recipients []string `json:"recipients"`
+}
+
+type SmsChannel struct {
+	Recipients []string `json:"recipients"`
+}
+
+type TelegramChannel struct {
+	ChatID     int64    `json:"chatId,omitempty"`
+	Recipients []string `json:"recipients,omitempty"`
+}
+
+type WebhookChannel struct {
+	URL     string            `json:"url"`
+	Method  string            `json:"method,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+type alertAlias Alert
+
+type alertJSON struct {
+	*alertAlias
+	Parametrization json.RawMessage `json:"parametrization,omitempty"`
+}
+
+func (a *Alert) MarshalJSON() ([]byte, error) {
+	v := alertJSON{alertAlias: (*alertAlias)(a)}
+	if a.Parametrization != nil {
+		p, err := protojson.Marshal(a.Parametrization)
+		if err != nil {
+			return nil, err
+		}
+		v.Parametrization = p
+	}
+	return json.Marshal(v)
+}
+
+func (a *Alert) UnmarshalJSON(data []byte) error {
+	v := alertJSON{alertAlias: (*alertAlias)(a)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	a.Parametrization = nil
+	if len(v.Parametrization) != 0 && string(v.Parametrization) != "null" {
+		p := new(monitoring.Parametrization)
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(v.Parametrization, p); err != nil {
+			return err
+		}
+		a.Parametrization = p
+	}
+	return nil
+}
+
+// TokenFunc returns an IAM token used to authorize REST requests.
+type TokenFunc func(ctx context.Context) (string, error)
+
+type restClient struct {
+	endpoint   string
+	userAgent  string
+	token      TokenFunc
+	httpClient *http.Client
+}
+
+func NewRESTClient(endpoint, userAgent string, token TokenFunc) Client {
+	return &restClient{
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		userAgent:  userAgent,
+		token:      token,
+		httpClient: http.DefaultClient,
+	}
+}
+
+func (c *restClient) CreateAlert(ctx context.Context, alert *Alert) (*Alert, error) {
+	res := new(Alert)
+	err := c.do(ctx, http.MethodPost, "alerts", alert, res)
+	return res, err
+}
+
+func (c *restClient) GetAlert(ctx context.Context, alertID string) (*Alert, error) {
+	res := new(Alert)
+	err := c.do(ctx, http.MethodGet, "alerts/"+url.PathEscape(alertID), nil, res)
+	return res, err
+}
+
+func (c *restClient) UpdateAlert(ctx context.Context, alert *Alert) (*Alert, error) {
+	res := new(Alert)
+	err := c.do(ctx, http.MethodPatch, "alerts/"+url.PathEscape(alert.ID), alert, res)
+	return res, err
+}
+
+func (c *restClient) DeleteAlert(ctx context.Context, alertID string) error {
+	return c.do(ctx, http.MethodDelete, "alerts/"+url.PathEscape(alertID), nil, nil)
+}
+
+func (c *restClient) CreateChannel(ctx context.Context, channel *Channel) (*Channel, error) {
+	res := new(Channel)
+	err := c.do(ctx, http.MethodPost, "channels", channel, res)
+	return res, err
+}
+
+func (c *restClient) GetChannel(ctx context.Context, channelID string) (*Channel, error) {
+	res := new(Channel)
+	err := c.do(ctx, http.MethodGet, "channels/"+url.PathEscape(channelID), nil, res)
+	return res, err
+}
+
+func (c *restClient) UpdateChannel(ctx context.Context, channel *Channel) (*Channel, error) {
+	res := new(Channel)
+	err := c.do(ctx, http.MethodPatch, "channels/"+url.PathEscape(channel.ID), channel, res)
+	return res, err
+}
+
+func (c *restClient) DeleteChannel(ctx context.Context, channelID string) error {
+	return c.do(ctx, http.MethodDelete, "channels/"+url.PathEscape(channelID), nil, nil)
+}
+
+func (c *restClient) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+"/"+path, body)
+	if err != nil {
+		return err
+	}
+
+	token, err := c.token(ctx)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return status.Error(httpStatusToCode(resp.StatusCode),
+			fmt.Sprintf("%s %s: %s", method, path, strings.TrimSpace(string(respBody))))
+	}
+
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+	return json.Unmarshal(respBody, out)
+}
+
+// httpStatusToCode maps REST errors to gRPC codes, so callers can
+// handle them the same way as errors of the gRPC services.
+func httpStatusToCode(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
@@ -0,0 +1,79 @@
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/monitoring/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func testToken(context.Context) (string, error) {
+	return "t1.token", nil
+}
+
+func TestRESTClientCreateAlert(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/monitoring/v3/alerts", r.URL.Path)
+		require.Equal(t, "Bearer t1.token", r.Header.Get("Authorization"))
+		require.Equal(t, "test-agent", r.Header.Get("User-Agent"))
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		var payload map[string]interface{}
+		require.NoError(t, json.Unmarshal(body, &payload))
+		require.Equal(t, "cpu-high", payload["name"])
+		require.Equal(t, "300s", payload["window"])
+		require.Equal(t, map[string]interface{}{"selectors": "a=b"}, payload["parametrization"])
+
+		w.Write([]byte(`{"id":"alert1","name":"cpu-high","window":"300s","parametrization":{"selectors":"a=b"}}`))
+	}))
+	defer server.Close()
+
+	client := NewRESTClient(server.URL+"/monitoring/v3/", "test-agent", testToken)
+	alert, err := client.CreateAlert(context.Background(), &Alert{
+		Name:            "cpu-high",
+		Window:          "300s",
+		Parametrization: &monitoring.Parametrization{Selectors: "a=b"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "alert1", alert.ID)
+	require.Equal(t, "a=b", alert.Parametrization.GetSelectors())
+}
+
+func TestRESTClientErrorCodes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"channel not found"}`))
+	}))
+	defer server.Close()
+
+	client := NewRESTClient(server.URL, "test-agent", testToken)
+	_, err := client.GetChannel(context.Background(), "channel1")
+	require.Error(t, err)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestEndpointFromAPI(t *testing.T) {
+	cases := []struct {
+		api       string
+		plaintext bool
+		expected  string
+	}{
+		{"", false, DefaultEndpoint},
+		{"api.cloud.yandex.net:443", false, DefaultEndpoint},
+		{"api.cloudil.com", false, "https://monitoring.api.cloudil.com/monitoring/v3"},
+		{"localhost:8080", true, "http://monitoring.localhost/monitoring/v3"},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.expected, EndpointFromAPI(c.api, c.plaintext), c.api)
+	}
+}
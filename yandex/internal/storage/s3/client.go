@@ -10,6 +10,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/cassette"
 )
 
 const (
@@ -46,6 +48,18 @@ func NewClient(ctx context.Context, accessKey, secretKey, iamToken, url string)
 		return nil, fmt.Errorf("nor token, nor access and secret keys are specified")
 	}
 
+	rec, err := cassette.FromEnv()
+	if err != nil {
+		return nil, err
+	}
+	if rec != nil {
+		var next http.RoundTripper
+		if config.HTTPClient != nil {
+			next = config.HTTPClient.Transport
+		}
+		config.HTTPClient = &http.Client{Transport: rec.Transport(next)}
+	}
+
 	ssn, err := session.NewSession(config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to init session: %w", err)
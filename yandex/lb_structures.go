@@ -574,3 +574,25 @@ func getFirstElement(config map[string]interface{}, name string) (map[string]int
 	}
 	return nil, false
 }
+
+func lbTargetStatesHealthy(states map[string][]*loadbalancer.TargetState) bool {
+	for _, tgStates := range states {
+		for _, state := range tgStates {
+			if state.Status != loadbalancer.TargetState_HEALTHY {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func lbWaitForHealthyTargetGroups(d *schema.ResourceData) []string {
+	var result []string
+	for _, v := range d.Get("attached_target_group").(*schema.Set).List() {
+		atg := v.(map[string]interface{})
+		if wait, ok := atg["wait_for_healthy"].(bool); ok && wait {
+			result = append(result, atg["target_group_id"].(string))
+		}
+	}
+	return result
+}
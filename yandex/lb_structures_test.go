@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
 )
 
 func TestExpandLBListenerSpecValidation(t *testing.T) {
@@ -62,3 +63,44 @@ func TestExpandLBListenerSpecValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestLBTargetStatesHealthy(t *testing.T) {
+	healthy := &loadbalancer.TargetState{Address: "10.0.0.1", Status: loadbalancer.TargetState_HEALTHY}
+	initial := &loadbalancer.TargetState{Address: "10.0.0.2", Status: loadbalancer.TargetState_INITIAL}
+
+	require.True(t, lbTargetStatesHealthy(nil))
+	require.True(t, lbTargetStatesHealthy(map[string][]*loadbalancer.TargetState{
+		"tg1": {healthy},
+		"tg2": {healthy, healthy},
+	}))
+	require.False(t, lbTargetStatesHealthy(map[string][]*loadbalancer.TargetState{
+		"tg1": {healthy},
+		"tg2": {healthy, initial},
+	}))
+}
+
+func TestKeepLBWaitForHealthy(t *testing.T) {
+	raw := map[string]interface{}{
+		"attached_target_group": []interface{}{
+			map[string]interface{}{"target_group_id": "tg1", "wait_for_healthy": true},
+			map[string]interface{}{"target_group_id": "tg2"},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resourceYandexLBNetworkLoadBalancer().Schema, raw)
+	require.Equal(t, []string{"tg1"}, lbWaitForHealthyTargetGroups(d))
+
+	atgs, err := flattenLBAttachedTargetGroups(&loadbalancer.NetworkLoadBalancer{
+		AttachedTargetGroups: []*loadbalancer.AttachedTargetGroup{
+			{TargetGroupId: "tg1"},
+			{TargetGroupId: "tg2"},
+		},
+	})
+	require.NoError(t, err)
+
+	wait := map[string]bool{}
+	for _, v := range keepLBWaitForHealthy(atgs, []string{"tg1"}).List() {
+		atg := v.(map[string]interface{})
+		wait[atg["target_group_id"].(string)] = atg["wait_for_healthy"].(bool)
+	}
+	require.Equal(t, map[string]bool{"tg1": true, "tg2": false}, wait)
+}
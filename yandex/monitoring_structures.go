@@ -1462,3 +1462,132 @@ func flattenMonitoringTitleWidget(v *monitoring.TitleWidget) ([]map[string]inter
 
 	return []map[string]interface{}{m}, nil
 }
+
+// monitoringParametrizationSchema is shared by the dashboard and alert resources.
+func monitoringParametrizationSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"parameters": {
+					Type:        schema.TypeList,
+					Description: "Parameters.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"custom": {
+								Type:        schema.TypeList,
+								Description: "Custom values parameter. Oneof: label_values, custom, text.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"default_values": {
+											Type:        schema.TypeList,
+											Description: "Default value.",
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+											Optional: true,
+										},
+										"multiselectable": {
+											Type:        schema.TypeBool,
+											Description: "Specifies the multiselectable values of parameter.",
+											Optional:    true,
+										},
+										"values": {
+											Type:        schema.TypeList,
+											Description: "Parameter values.",
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+											Optional: true,
+										},
+									},
+								},
+								Optional: true,
+							},
+							"description": {
+								Type:        schema.TypeString,
+								Description: "Parameter description.",
+								Optional:    true,
+							},
+							"hidden": {
+								Type:        schema.TypeBool,
+								Description: "UI-visibility",
+								Optional:    true,
+							},
+							"label_values": {
+								Type:        schema.TypeList,
+								Description: "Label values parameter. Oneof: label_values, custom, text.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"default_values": {
+											Type:        schema.TypeList,
+											Description: "Default value.",
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+											Optional: true,
+										},
+										"folder_id": {
+											Type:        schema.TypeString,
+											Description: "Folder ID.",
+											Optional:    true,
+										},
+										"label_key": {
+											Type:        schema.TypeString,
+											Description: "Label key to list label values.",
+											Required:    true,
+										},
+										"multiselectable": {
+											Type:        schema.TypeBool,
+											Description: "Specifies the multiselectable values of parameter.",
+											Optional:    true,
+										},
+										"selectors": {
+											Type:        schema.TypeString,
+											Description: "Selectors to select metric label values.",
+											Optional:    true,
+										},
+									},
+								},
+								Optional: true,
+							},
+							"id": {
+								Type:        schema.TypeString,
+								Description: "Parameter identifier.",
+								Required:    true,
+							},
+							"text": {
+								Type:        schema.TypeList,
+								Description: "Text parameter. Oneof: label_values, custom, text.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"default_value": {
+											Type:        schema.TypeString,
+											Description: "Default value.",
+											Optional:    true,
+										},
+									},
+								},
+								Optional: true,
+							},
+							"title": {
+								Type:        schema.TypeString,
+								Description: "UI-visible title of the parameter.",
+								Optional:    true,
+							},
+						},
+					},
+					Optional: true,
+				},
+				"selectors": {
+					Type:        schema.TypeString,
+					Description: "Predefined parameters selector.",
+					Optional:    true,
+				},
+			},
+		},
+		Optional: true,
+		Computed: true,
+	}
+}
@@ -67,6 +67,17 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 				Optional:    true,
 				Description: common.Descriptions["zone"],
 			},
+			"default_labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: common.Descriptions["default_labels"],
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"iam_online_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: common.Descriptions["iam_online_validation"],
+			},
 			"token": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -141,6 +152,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"yandex_alb_backend_group":                                dataSourceYandexALBBackendGroup(),
+			"yandex_alb_backend_group_target_states":                  dataSourceYandexALBBackendGroupTargetStates(),
 			"yandex_alb_http_router":                                  dataSourceYandexALBHTTPRouter(),
 			"yandex_alb_load_balancer":                                dataSourceYandexALBLoadBalancer(),
 			"yandex_alb_target_group":                                 dataSourceYandexALBTargetGroup(),
@@ -169,6 +181,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_compute_snapshot_schedule":                        dataSourceYandexComputeSnapshotSchedule(),
 			"yandex_dataproc_cluster":                                 dataSourceYandexDataprocCluster(),
 			"yandex_dns_zone":                                         dataSourceYandexDnsZone(),
+			"yandex_dns_zone_file":                                    dataSourceYandexDnsZoneFile(),
 			"yandex_serverless_eventrouter_bus":                       dataSourceYandexServerlessEventrouterBus(),
 			"yandex_serverless_eventrouter_connector":                 dataSourceYandexServerlessEventrouterConnector(),
 			"yandex_serverless_eventrouter_rule":                      dataSourceYandexServerlessEventrouterRule(),
@@ -188,6 +201,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_kubernetes_cluster":                               dataSourceYandexKubernetesCluster(),
 			"yandex_kubernetes_node_group":                            dataSourceYandexKubernetesNodeGroup(),
 			"yandex_lb_network_load_balancer":                         dataSourceYandexLBNetworkLoadBalancer(),
+			"yandex_lb_network_load_balancer_target_states":           dataSourceYandexLBNetworkLoadBalancerTargetStates(),
 			"yandex_lb_target_group":                                  dataSourceYandexLBTargetGroup(),
 			"yandex_loadtesting_agent":                                dataSourceYandexLoadtestingAgent(),
 			"yandex_lockbox_secret":                                   dataSourceYandexLockboxSecret(),
@@ -195,6 +209,8 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_kms_symmetric_key":                                dataSourceYandexKMSSymmetricKey(),
 			"yandex_kms_asymmetric_encryption_key":                    dataSourceYandexKMSAsymmetricEncryptionKey(),
 			"yandex_kms_asymmetric_signature_key":                     dataSourceYandexKMSAsymmetricSignatureKey(),
+			"yandex_kms_asymmetric_encryption_ciphertext":             dataSourceYandexKMSAsymmetricEncryptionCiphertext(),
+			"yandex_kms_asymmetric_signature":                         dataSourceYandexKMSAsymmetricSignature(),
 			"yandex_logging_group":                                    dataSourceYandexLoggingGroup(),
 			"yandex_mdb_clickhouse_cluster":                           dataSourceYandexMDBClickHouseCluster(),
 			"yandex_mdb_elasticsearch_cluster":                        dataSourceYandexMDBElasticsearchCluster(),
@@ -214,6 +230,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_mdb_sqlserver_cluster":                            dataSourceYandexMDBSQLServerCluster(),
 			"yandex_monitoring_dashboard":                             dataSourceYandexMonitoringDashboard(),
 			"yandex_message_queue":                                    dataSourceYandexMessageQueue(),
+			"yandex_organizationmanager_access_report":                dataSourceYandexOrganizationManagerAccessReport(),
 			"yandex_organizationmanager_group":                        dataSourceYandexOrganizationManagerGroup(),
 			"yandex_organizationmanager_os_login_settings":            dataSourceYandexOrganizationManagerOsLoginSettings(),
 			"yandex_organizationmanager_saml_federation":              dataSourceYandexOrganizationManagerSamlFederation(),
@@ -243,6 +260,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_alb_http_router":                                   resourceYandexALBHTTPRouter(),
 			"yandex_alb_load_balancer":                                 resourceYandexALBLoadBalancer(),
 			"yandex_alb_target_group":                                  resourceYandexALBTargetGroup(),
+			"yandex_alb_traffic_shift":                                 resourceYandexALBTrafficShift(),
 			"yandex_alb_virtual_host":                                  addPassthroughImport(withALBVirtualHostID(resourceYandexALBVirtualHost())),
 			"yandex_api_gateway":                                       resourceYandexApiGateway(),
 			"yandex_audit_trails_trail":                                resourceYandexAuditTrailsTrail(),
@@ -275,6 +293,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_dns_zone_iam_binding":                              resourceYandexDnsZoneIAMBinding(),
 			"yandex_dns_recordset":                                     resourceYandexDnsRecordSet(),
 			"yandex_dns_zone":                                          resourceYandexDnsZone(),
+			"yandex_dns_zone_records":                                  resourceYandexDnsZoneRecords(),
 			"yandex_serverless_eventrouter_bus":                        resourceYandexServerlessEventrouterBus(),
 			"yandex_serverless_eventrouter_connector":                  resourceYandexServerlessEventrouterConnector(),
 			"yandex_serverless_eventrouter_rule":                       resourceYandexServerlessEventrouterRule(),
@@ -288,6 +307,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_iam_service_account_iam_member":                    resourceYandexIAMServiceAccountIAMMember(),
 			"yandex_iam_service_account_iam_policy":                    resourceYandexIAMServiceAccountIAMPolicy(),
 			"yandex_iam_service_account_key":                           resourceYandexIAMServiceAccountKey(),
+			"yandex_iam_service_account_key_rotation":                  resourceYandexIAMServiceAccountKeyRotation(),
 			"yandex_iam_service_account_static_access_key":             resourceYandexIAMServiceAccountStaticAccessKey(),
 			"yandex_iam_workload_identity_federated_credential":        resourceYandexIAMWorkloadIdentityFederatedCredential(),
 			"yandex_iam_workload_identity_oidc_federation":             resourceYandexIAMWorkloadIdentityOidcFederation(),
@@ -313,11 +333,14 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_lb_target_group":                                   resourceYandexLBTargetGroup(),
 			"yandex_loadtesting_agent":                                 resourceYandexLoadtestingAgent(),
 			"yandex_lockbox_secret":                                    resourceYandexLockboxSecret(),
+			"yandex_lockbox_secret_rotation":                           resourceYandexLockboxSecretRotation(),
 			"yandex_lockbox_secret_version":                            resourceYandexLockboxSecretVersion(),
 			"yandex_lockbox_secret_version_hashed":                     resourceYandexLockboxSecretVersionHashed(),
 			"yandex_lockbox_secret_iam_binding":                        resourceYandexLockboxSecretIAMBinding(),
 			"yandex_lockbox_secret_iam_member":                         resourceYandexLockboxSecretIAMMember(),
+			"yandex_logging_export":                                    resourceYandexLoggingExport(),
 			"yandex_logging_group":                                     resourceYandexLoggingGroup(),
+			"yandex_logging_sink":                                      resourceYandexLoggingSink(),
 			"yandex_mdb_clickhouse_cluster":                            resourceYandexMDBClickHouseCluster(),
 			"yandex_mdb_elasticsearch_cluster":                         resourceYandexMDBElasticsearchCluster(),
 			"yandex_mdb_greenplum_cluster":                             resourceYandexMDBGreenplumCluster(),
@@ -335,7 +358,9 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_mdb_redis_cluster":                                 resourceYandexMDBRedisCluster(),
 			"yandex_mdb_sqlserver_cluster":                             resourceYandexMDBSQLServerCluster(),
 			"yandex_message_queue":                                     resourceYandexMessageQueue(),
+			"yandex_monitoring_alert":                                  resourceYandexMonitoringAlert(),
 			"yandex_monitoring_dashboard":                              resourceYandexMonitoringDashboard(),
+			"yandex_monitoring_notification_channel":                   resourceYandexMonitoringNotificationChannel(),
 			"yandex_organizationmanager_organization_iam_binding":      resourceYandexOrganizationManagerOrganizationIAMBinding(),
 			"yandex_organizationmanager_organization_iam_member":       resourceYandexOrganizationManagerOrganizationIAMMember(),
 			"yandex_organizationmanager_saml_federation":               resourceYandexOrganizationManagerSamlFederation(),
@@ -380,6 +405,12 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 		},
 	}
 
+	for _, r := range provider.ResourcesMap {
+		if hasConfigurableLabels(r) {
+			withDefaultLabels(r)
+		}
+	}
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		return providerConfigure(ctx, d, provider, emptyFolder, false)
 	}
@@ -430,14 +461,21 @@ func setToDefaultIfNeeded(field string, osEnvName string, defaultVal string) str
 	return field
 }
 
-func setToDefaultBoolIfNeeded(osEnvName string, defaultVal bool) bool {
-	field := os.Getenv(osEnvName)
-	v, err := strconv.ParseBool(field)
-	if err != nil {
-		return v
-	} else {
-		return defaultVal
+// setToDefaultBoolIfNeeded returns the value of the attribute if it is set in the configuration, even to false,
+// and the value of the environment variable otherwise.
+func setToDefaultBoolIfNeeded(d *schema.ResourceData, attr string, osEnvName string) bool {
+	raw := d.GetRawConfig()
+	if raw.IsNull() {
+		// The configuration has no raw value, e.g. in unit tests, so false can't be told apart from unset
+		if d.Get(attr).(bool) {
+			return true
+		}
+	} else if v := raw.GetAttr(attr); v.IsKnown() && !v.IsNull() {
+		return v.True()
 	}
+
+	v, err := strconv.ParseBool(os.Getenv(osEnvName))
+	return err == nil && v
 }
 
 // testConfig is used to avoid using StopContext duo to tests are run in parallel and context is cancelled randomly in tests
@@ -459,14 +497,21 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		YMQAccessKey:                   setToDefaultIfNeeded(d.Get("ymq_access_key").(string), "YC_MESSAGE_QUEUE_ACCESS_KEY", ""),
 		YMQSecretKey:                   setToDefaultIfNeeded(d.Get("ymq_secret_key").(string), "YC_MESSAGE_QUEUE_SECRET_KEY", ""),
 
-		Plaintext:             setToDefaultBoolIfNeeded("YC_PLAINTEXT", d.Get("plaintext").(bool)),
-		Insecure:              setToDefaultBoolIfNeeded("YC_INSECURE", d.Get("insecure").(bool)),
+		Plaintext:             setToDefaultBoolIfNeeded(d, "plaintext", "YC_PLAINTEXT"),
+		Insecure:              setToDefaultBoolIfNeeded(d, "insecure", "YC_INSECURE"),
 		MaxRetries:            d.Get("max_retries").(int),
 		SharedCredentialsFile: d.Get("shared_credentials_file").(string),
 		Profile:               d.Get("profile").(string),
+		IamOnlineValidation:   d.Get("iam_online_validation").(bool),
 		userAgent:             p.UserAgent("terraform-provider-yandex", version.ProviderVersion),
 	}
 
+	defaultLabels, err := expandLabels(d.Get("default_labels"))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.DefaultLabels = defaultLabels
+
 	if len(config.Profile) == 0 {
 		config.Profile = "default"
 	}
@@ -8,9 +8,11 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	terraform2 "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers/fakecloud"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -169,6 +171,69 @@ func TestProviderDefaultValues(t *testing.T) {
 	}
 }
 
+func TestProviderBoolValuesFromEnv(t *testing.T) {
+	// The fake cloud is reached in plaintext only, so configuring the provider fails fast if YC_PLAINTEXT is ignored.
+	fakecloud.NewTestServer(t)
+	// Storage is not served, and its client fails to initialize with a custom CA bundle of AWS SDK.
+	t.Setenv("AWS_CA_BUNDLE", "")
+	t.Setenv("YC_INSECURE", "1")
+
+	cases := []struct {
+		name     string
+		config   map[string]cty.Value
+		insecure bool
+	}{
+		{
+			name:     "unset",
+			insecure: true,
+		},
+		{
+			name:     "explicit false",
+			config:   map[string]cty.Value{"insecure": cty.False},
+			insecure: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			testProvider := NewSDKProvider()
+			diags := testProvider.Configure(context.Background(), testProviderConfig(testProvider, c.config))
+			for _, d := range diags {
+				if d.Severity == diag.Error {
+					t.Fatalf("error configuring provider: %s", d.Summary)
+				}
+			}
+
+			conf := testProvider.Meta().(*Config)
+			assert.True(t, conf.Plaintext, "YC_PLAINTEXT is not respected")
+			assert.Equal(t, c.insecure, conf.Insecure)
+		})
+	}
+}
+
+// testProviderConfig builds the provider configuration with the raw value, so attributes set to false
+// can be told apart from unset ones.
+func testProviderConfig(p *schema.Provider, attrs map[string]cty.Value) *terraform2.ResourceConfig {
+	configSchema := schema.InternalMap(p.Schema).CoreConfigSchema()
+
+	values := map[string]cty.Value{
+		"token": cty.StringVal("any_string_like_a_oauth"),
+		"zone":  cty.StringVal("ru-central1-a"),
+	}
+	for name, v := range attrs {
+		values[name] = v
+	}
+	for name, ty := range configSchema.ImpliedType().AttributeTypes() {
+		if _, ok := values[name]; !ok {
+			values[name] = cty.NullVal(ty)
+		}
+	}
+	config := terraform2.NewResourceConfigShimmed(cty.ObjectVal(values), configSchema)
+	// The raw value is set by the plugin server, the shim doesn't set it
+	config.CtyValue = cty.ObjectVal(values)
+	return config
+}
+
 func TestProviderOrganizationId(t *testing.T) {
 	// save OS env vars
 	envVars := []string{"YC_ORGANIZATION_ID"}
@@ -6,10 +6,12 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"google.golang.org/grpc/codes"
 )
 
 const yandexALBBackendGroupDefaultTimeout = 5 * time.Minute
@@ -207,6 +209,22 @@ func resourceYandexALBBackendGroup() *schema.Resource {
 				},
 			},
 
+			"wait_for_healthy": {
+				Type:        schema.TypeList,
+				Description: "Wait until all targets of the backend group are reported as `HEALTHY` by the specified load balancer before finishing update. Waiting is limited by the `update` timeout.\n\n~> Waiting is skipped on create, since the load balancer can't route traffic to the backend group before it is created.\n",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"load_balancer_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the load balancer that reports health of the targets.",
+							Required:    true,
+						},
+					},
+				},
+			},
+
 			"created_at": {
 				Type:        schema.TypeString,
 				Description: common.ResourceDescriptions["created_at"],
@@ -700,10 +718,52 @@ func resourceYandexALBBackendGroupUpdate(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error updating Application Backend Group %q: %w", d.Id(), err)
 	}
 
+	if err := waitForALBBackendGroupHealthy(ctx, config, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Finished updating Application Backend Group %q", d.Id())
 	return resourceYandexALBBackendGroupRead(d, meta)
 }
 
+// waitForALBBackendGroupHealthy runs on update only: load balancers depend on their backend groups,
+// so no load balancer routes traffic to a backend group being created.
+func waitForALBBackendGroupHealthy(ctx context.Context, config *Config, d *schema.ResourceData, timeout time.Duration) error {
+	if d.Get("wait_for_healthy.#").(int) == 0 {
+		return nil
+	}
+	lbID := d.Get("wait_for_healthy.0.load_balancer_id").(string)
+
+	bg, err := config.sdk.ApplicationLoadBalancer().BackendGroup().Get(ctx, &apploadbalancer.GetBackendGroupRequest{
+		BackendGroupId: d.Id(),
+	})
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to get Application Backend Group %q: %w", d.Id(), err)
+	}
+	targetGroupIDs := albBackendGroupTargetGroupIDs(bg)
+
+	log.Printf("[DEBUG] Waiting for targets of Application Backend Group %q to become healthy", d.Id())
+	err = retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		states, err := getALBTargetStates(ctx, config, lbID, d.Id(), targetGroupIDs)
+		if err != nil {
+			// The load balancer may not have picked up the backend group yet.
+			if isStatusWithCode(err, codes.NotFound) || isStatusWithCode(err, codes.FailedPrecondition) {
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+		if !albTargetStatesHealthy(states) {
+			return retry.RetryableError(fmt.Errorf("targets of Application Backend Group %q are not healthy yet", d.Id()))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error while waiting for targets of Application Backend Group %q to become healthy: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
 func resourceYandexALBBackendGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Deleting Application Backend Group %q", d.Id())
 	config := meta.(*Config)
@@ -19,12 +19,14 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const albBGResource = "yandex_alb_backend_group.test-bg"
 
 func init() {
-	resource.AddTestSweepers("yandex_alb_backend_group", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_alb_backend_group",
 		F:    testSweepALBBackendGroups,
 		Dependencies: []string{
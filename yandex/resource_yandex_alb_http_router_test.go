@@ -13,12 +13,14 @@ import (
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const albRouterResource = "yandex_alb_http_router.test-router"
 
 func init() {
-	resource.AddTestSweepers("yandex_alb_http_router", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_alb_http_router",
 		F:    testSweepALBHTTPRouters,
 		Dependencies: []string{
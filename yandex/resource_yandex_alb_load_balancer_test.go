@@ -20,12 +20,14 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const albLoadBalancerResource = "yandex_alb_load_balancer.test-balancer"
 
 func init() {
-	resource.AddTestSweepers("yandex_alb_load_balancer", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name:         "yandex_alb_load_balancer",
 		F:            testSweepALBLoadBalancers,
 		Dependencies: []string{},
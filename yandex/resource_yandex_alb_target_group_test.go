@@ -15,12 +15,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const albTGResource = "yandex_alb_target_group.test-tg"
 
 func init() {
-	resource.AddTestSweepers("yandex_alb_target_group", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_alb_target_group",
 		F:    testSweepALBTargetGroups,
 		Dependencies: []string{
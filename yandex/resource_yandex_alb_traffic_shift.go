@@ -0,0 +1,398 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+)
+
+const yandexALBTrafficShiftDefaultTimeout = 30 * time.Minute
+
+// yandexALBTrafficShiftRollbackTimeout is the deadline of the rollback, which runs after the shift context is done on timeout.
+const yandexALBTrafficShiftRollbackTimeout = 5 * time.Minute
+
+func resourceYandexALBTrafficShift() *schema.Resource {
+	return &schema.Resource{
+		Description: "Shifts traffic between two backends of an Application Load Balancer backend group in steps within a single apply. " +
+			"After every step target health of the new backend is checked, and the shift is aborted when too many targets are unhealthy. " +
+			"For more information about backend weights, see [the official documentation](https://yandex.cloud/docs/application-load-balancer/concepts/backend-group).\n\n" +
+			"~> The resource changes `weight` of the backends in the `yandex_alb_backend_group`. Add `weight` of the affected backends to `ignore_changes` of the backend group to avoid a diff after the shift.\n\n" +
+			"~> Destroying the resource does not change the backend weights.\n",
+		Create: resourceYandexALBTrafficShiftCreate,
+		Read:   resourceYandexALBTrafficShiftRead,
+		Delete: resourceYandexALBTrafficShiftDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexALBTrafficShiftDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"backend_group_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the backend group which backends weights are shifted.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"load_balancer_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the load balancer which routes traffic to the backend group. It is used to get target health of the backends.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"from_backend": {
+				Type:        schema.TypeString,
+				Description: "Name of the backend which traffic is shifted from.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"to_backend": {
+				Type:        schema.TypeString,
+				Description: "Name of the backend which traffic is shifted to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"steps": {
+				Type:        schema.TypeList,
+				Description: "Percentages of traffic routed to `to_backend` on each step, e.g. `[10, 50, 100]`. The rest of the traffic is routed to `from_backend`.",
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+			},
+
+			"step_interval": {
+				Type:             schema.TypeString,
+				Description:      "Time to wait after each step before checking target health, e.g. `5m`.",
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "1m",
+				ValidateFunc:     validateParsableValue(time.ParseDuration),
+				DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
+			},
+
+			"max_unhealthy_targets": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum number of unhealthy targets of `to_backend` allowed after each step. The shift is aborted when it is exceeded.",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"rollback_on_failure": {
+				Type:        schema.TypeBool,
+				Description: "Restore the backend weights which were set before the shift when it is aborted.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+			},
+
+			"triggers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary map of values that, when changed, will run the shift again.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"from_weight": {
+				Type:        schema.TypeInt,
+				Description: "Current weight of `from_backend`.",
+				Computed:    true,
+			},
+
+			"to_weight": {
+				Type:        schema.TypeInt,
+				Description: "Current weight of `to_backend`.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceYandexALBTrafficShiftCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	interval, err := time.ParseDuration(d.Get("step_interval").(string))
+	if err != nil {
+		return fmt.Errorf("Error parsing step_interval: %w", err)
+	}
+
+	var steps []int
+	for _, v := range d.Get("steps").([]interface{}) {
+		steps = append(steps, v.(int))
+	}
+
+	plan := albTrafficShiftPlan{
+		steps:        steps,
+		interval:     interval,
+		maxUnhealthy: d.Get("max_unhealthy_targets").(int),
+		rollback:     d.Get("rollback_on_failure").(bool),
+	}
+
+	target := &albBackendGroupShiftTarget{
+		config:         config,
+		backendGroupID: d.Get("backend_group_id").(string),
+		loadBalancerID: d.Get("load_balancer_id").(string),
+		fromBackend:    d.Get("from_backend").(string),
+		toBackend:      d.Get("to_backend").(string),
+	}
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	log.Printf("[DEBUG] Shifting traffic of Application Backend Group %q from %q to %q", target.backendGroupID, target.fromBackend, target.toBackend)
+
+	if err := runALBTrafficShift(ctx, target, plan, sleepWithContext); err != nil {
+		return fmt.Errorf("Error shifting traffic of Application Backend Group %q: %w", target.backendGroupID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", target.backendGroupID, target.fromBackend, target.toBackend))
+
+	log.Printf("[DEBUG] Finished shifting traffic of Application Backend Group %q", target.backendGroupID)
+	return resourceYandexALBTrafficShiftRead(d, meta)
+}
+
+func resourceYandexALBTrafficShiftRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	bg, err := config.sdk.ApplicationLoadBalancer().BackendGroup().Get(config.Context(), &apploadbalancer.GetBackendGroupRequest{
+		BackendGroupId: d.Get("backend_group_id").(string),
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Application Backend Group %q", d.Get("backend_group_id").(string)))
+	}
+
+	from, to, err := findALBTrafficShiftBackends(bg, d.Get("from_backend").(string), d.Get("to_backend").(string))
+	if err != nil {
+		log.Printf("[WARN] Removing traffic shift %q from state: %s", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("from_weight", getWeight(from.GetBackendWeight()))
+	d.Set("to_weight", getWeight(to.GetBackendWeight()))
+	return nil
+}
+
+func resourceYandexALBTrafficShiftDelete(d *schema.ResourceData, _ interface{}) error {
+	log.Printf("[DEBUG] Removing traffic shift %q from state, backend weights are left unchanged", d.Id())
+	return nil
+}
+
+type albTrafficShiftPlan struct {
+	steps        []int
+	interval     time.Duration
+	maxUnhealthy int
+	rollback     bool
+}
+
+// albTrafficShiftTarget is a pair of backends which traffic is shifted between.
+type albTrafficShiftTarget interface {
+	Weights(ctx context.Context) (from, to int64, err error)
+	SetWeights(ctx context.Context, from, to int64) error
+	UnhealthyTargets(ctx context.Context) (int, error)
+}
+
+// runALBTrafficShift applies the plan steps one by one checking target health after each of them.
+func runALBTrafficShift(ctx context.Context, target albTrafficShiftTarget, plan albTrafficShiftPlan, sleep func(context.Context, time.Duration) error) error {
+	initialFrom, initialTo, err := target.Weights(ctx)
+	if err != nil {
+		return err
+	}
+
+	abort := func(cause error) error {
+		if !plan.rollback {
+			return cause
+		}
+		log.Printf("[WARN] Rolling back traffic shift to weights %d/%d: %s", initialFrom, initialTo, cause)
+		rollbackCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), yandexALBTrafficShiftRollbackTimeout)
+		defer cancel()
+		if err := target.SetWeights(rollbackCtx, initialFrom, initialTo); err != nil {
+			return fmt.Errorf("%w; rollback failed: %w", cause, err)
+		}
+		return fmt.Errorf("%w; weights were rolled back to %d/%d", cause, initialFrom, initialTo)
+	}
+
+	for i, percent := range plan.steps {
+		log.Printf("[DEBUG] Traffic shift step %d/%d: %d%% to the new backend", i+1, len(plan.steps), percent)
+
+		if err := target.SetWeights(ctx, int64(100-percent), int64(percent)); err != nil {
+			return abort(fmt.Errorf("step %d: %w", i+1, err))
+		}
+
+		if err := sleep(ctx, plan.interval); err != nil {
+			return abort(fmt.Errorf("step %d: %w", i+1, err))
+		}
+
+		unhealthy, err := target.UnhealthyTargets(ctx)
+		if err != nil {
+			return abort(fmt.Errorf("step %d: error getting target states: %w", i+1, err))
+		}
+		if unhealthy > plan.maxUnhealthy {
+			return abort(fmt.Errorf("step %d: %d targets are unhealthy, at most %d allowed", i+1, unhealthy, plan.maxUnhealthy))
+		}
+	}
+
+	return nil
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type albWeightedBackend interface {
+	GetName() string
+	GetBackendWeight() *wrappers.Int64Value
+	SetBackendWeight(*wrappers.Int64Value)
+	GetTargetGroups() *apploadbalancer.TargetGroupsBackend
+}
+
+func albWeightedBackends(bg *apploadbalancer.BackendGroup) []albWeightedBackend {
+	var backends []albWeightedBackend
+	for _, b := range bg.GetHttp().GetBackends() {
+		backends = append(backends, b)
+	}
+	for _, b := range bg.GetGrpc().GetBackends() {
+		backends = append(backends, b)
+	}
+	for _, b := range bg.GetStream().GetBackends() {
+		backends = append(backends, b)
+	}
+	return backends
+}
+
+func findALBTrafficShiftBackends(bg *apploadbalancer.BackendGroup, fromName, toName string) (from, to albWeightedBackend, err error) {
+	for _, b := range albWeightedBackends(bg) {
+		switch b.GetName() {
+		case fromName:
+			from = b
+		case toName:
+			to = b
+		}
+	}
+
+	if from == nil {
+		return nil, nil, fmt.Errorf("backend %q not found in backend group %q", fromName, bg.Id)
+	}
+	if to == nil {
+		return nil, nil, fmt.Errorf("backend %q not found in backend group %q", toName, bg.Id)
+	}
+	return from, to, nil
+}
+
+type albBackendGroupShiftTarget struct {
+	config         *Config
+	backendGroupID string
+	loadBalancerID string
+	fromBackend    string
+	toBackend      string
+}
+
+func (t *albBackendGroupShiftTarget) get(ctx context.Context) (*apploadbalancer.BackendGroup, albWeightedBackend, albWeightedBackend, error) {
+	bg, err := t.config.sdk.ApplicationLoadBalancer().BackendGroup().Get(ctx, &apploadbalancer.GetBackendGroupRequest{
+		BackendGroupId: t.backendGroupID,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	from, to, err := findALBTrafficShiftBackends(bg, t.fromBackend, t.toBackend)
+	return bg, from, to, err
+}
+
+func (t *albBackendGroupShiftTarget) Weights(ctx context.Context) (int64, int64, error) {
+	_, from, to, err := t.get(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	return int64(getWeight(from.GetBackendWeight())), int64(getWeight(to.GetBackendWeight())), nil
+}
+
+func (t *albBackendGroupShiftTarget) SetWeights(ctx context.Context, fromWeight, toWeight int64) error {
+	bg, from, to, err := t.get(ctx)
+	if err != nil {
+		return err
+	}
+
+	from.SetBackendWeight(&wrappers.Int64Value{Value: fromWeight})
+	to.SetBackendWeight(&wrappers.Int64Value{Value: toWeight})
+
+	req := &apploadbalancer.UpdateBackendGroupRequest{
+		BackendGroupId: bg.Id,
+		Name:           bg.Name,
+		Description:    bg.Description,
+		Labels:         bg.Labels,
+	}
+	switch {
+	case bg.GetHttp() != nil:
+		req.SetHttp(bg.GetHttp())
+	case bg.GetGrpc() != nil:
+		req.SetGrpc(bg.GetGrpc())
+	case bg.GetStream() != nil:
+		req.SetStream(bg.GetStream())
+	}
+
+	op, err := t.config.sdk.WrapOperation(t.config.sdk.ApplicationLoadBalancer().BackendGroup().Update(ctx, req))
+	if err != nil {
+		return fmt.Errorf("error while requesting API to update backend weights: %w", err)
+	}
+	return op.Wait(ctx)
+}
+
+func (t *albBackendGroupShiftTarget) UnhealthyTargets(ctx context.Context) (int, error) {
+	_, _, to, err := t.get(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	unhealthy := 0
+	for _, tgID := range to.GetTargetGroups().GetTargetGroupIds() {
+		resp, err := t.config.sdk.ApplicationLoadBalancer().LoadBalancer().GetTargetStates(ctx, &apploadbalancer.GetTargetStatesRequest{
+			LoadBalancerId: t.loadBalancerID,
+			BackendGroupId: t.backendGroupID,
+			TargetGroupId:  tgID,
+		})
+		if err != nil {
+			return 0, err
+		}
+		unhealthy += countUnhealthyALBTargets(resp.GetTargetStates())
+	}
+	return unhealthy, nil
+}
+
+func countUnhealthyALBTargets(states []*apploadbalancer.TargetState) int {
+	count := 0
+	for _, state := range states {
+		for _, zone := range state.GetStatus().GetZoneStatuses() {
+			if zone.GetStatus() == apploadbalancer.TargetState_UNHEALTHY {
+				count++
+				break
+			}
+		}
+	}
+	return count
+}
@@ -0,0 +1,104 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+)
+
+type fakeALBTrafficShiftTarget struct {
+	from, to  int64
+	history   []string
+	unhealthy []int
+	checks    int
+}
+
+func (f *fakeALBTrafficShiftTarget) Weights(context.Context) (int64, int64, error) {
+	return f.from, f.to, nil
+}
+
+func (f *fakeALBTrafficShiftTarget) SetWeights(ctx context.Context, from, to int64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	f.from, f.to = from, to
+	f.history = append(f.history, fmt.Sprintf("%d/%d", from, to))
+	return nil
+}
+
+func (f *fakeALBTrafficShiftTarget) UnhealthyTargets(context.Context) (int, error) {
+	n := f.unhealthy[f.checks]
+	f.checks++
+	return n, nil
+}
+
+func noSleep(context.Context, time.Duration) error {
+	return nil
+}
+
+func TestRunALBTrafficShift(t *testing.T) {
+	target := &fakeALBTrafficShiftTarget{from: 1, to: 0, unhealthy: []int{0, 1, 0}}
+	plan := albTrafficShiftPlan{steps: []int{10, 50, 100}, maxUnhealthy: 1, rollback: true}
+
+	err := runALBTrafficShift(context.Background(), target, plan, noSleep)
+	require.NoError(t, err)
+	require.Equal(t, []string{"90/10", "50/50", "0/100"}, target.history)
+}
+
+func TestRunALBTrafficShiftRollback(t *testing.T) {
+	target := &fakeALBTrafficShiftTarget{from: 1, to: 0, unhealthy: []int{0, 2}}
+	plan := albTrafficShiftPlan{steps: []int{10, 50, 100}, maxUnhealthy: 1, rollback: true}
+
+	err := runALBTrafficShift(context.Background(), target, plan, noSleep)
+	require.ErrorContains(t, err, "step 2: 2 targets are unhealthy")
+	require.ErrorContains(t, err, "rolled back to 1/0")
+	require.Equal(t, []string{"90/10", "50/50", "1/0"}, target.history)
+}
+
+func TestRunALBTrafficShiftNoRollback(t *testing.T) {
+	target := &fakeALBTrafficShiftTarget{from: 100, to: 0, unhealthy: []int{3}}
+	plan := albTrafficShiftPlan{steps: []int{25, 100}, rollback: false}
+
+	err := runALBTrafficShift(context.Background(), target, plan, noSleep)
+	require.Error(t, err)
+	require.Equal(t, []string{"75/25"}, target.history)
+}
+
+func TestRunALBTrafficShiftRollbackOnTimeout(t *testing.T) {
+	target := &fakeALBTrafficShiftTarget{from: 1, to: 0}
+	plan := albTrafficShiftPlan{steps: []int{10, 100}, rollback: true}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	expire := func(context.Context, time.Duration) error {
+		cancel()
+		return context.DeadlineExceeded
+	}
+
+	err := runALBTrafficShift(ctx, target, plan, expire)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.ErrorContains(t, err, "rolled back to 1/0")
+	require.Equal(t, []string{"90/10", "1/0"}, target.history)
+}
+
+func TestCountUnhealthyALBTargets(t *testing.T) {
+	zone := func(statuses ...apploadbalancer.TargetState_Status) *apploadbalancer.TargetState {
+		hc := &apploadbalancer.TargetState_HealthcheckStatus{}
+		for _, s := range statuses {
+			hc.ZoneStatuses = append(hc.ZoneStatuses, &apploadbalancer.TargetState_ZoneHealthcheckStatus{Status: s})
+		}
+		return &apploadbalancer.TargetState{Status: hc}
+	}
+
+	states := []*apploadbalancer.TargetState{
+		zone(apploadbalancer.TargetState_HEALTHY),
+		zone(apploadbalancer.TargetState_HEALTHY, apploadbalancer.TargetState_UNHEALTHY),
+		zone(apploadbalancer.TargetState_UNHEALTHY, apploadbalancer.TargetState_UNHEALTHY),
+		zone(apploadbalancer.TargetState_DRAINING),
+	}
+	require.Equal(t, 2, countUnhealthyALBTargets(states))
+}
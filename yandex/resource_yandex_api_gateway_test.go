@@ -16,6 +16,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/logging/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/apigateway/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const apiGatewayResource = "yandex_api_gateway.test-api-gateway"
@@ -28,7 +30,7 @@ var specUpdated string
 var specParametrized string
 
 func init() {
-	resource.AddTestSweepers("yandex_api_gateway", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name:         "yandex_api_gateway",
 		F:            testSweepAPIGateway,
 		Dependencies: []string{},
@@ -9,10 +9,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	backuppb "github.com/yandex-cloud/go-genproto/yandex/cloud/backup/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_backup_policy_bindings", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_backup_policy_bindings",
 		F:    testSweepBackupPolicyBindings,
 	})
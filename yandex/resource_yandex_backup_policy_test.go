@@ -11,10 +11,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	backuppb "github.com/yandex-cloud/go-genproto/yandex/cloud/backup/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_backup_policy", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name:         "yandex_backup_policy",
 		F:            testSweepBackupPolicy,
 		Dependencies: []string{},
@@ -13,10 +13,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/cdn/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_cdn_origin_group", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_cdn_origin_group",
 		F:    testSweepCDNOriginGroups,
 		Dependencies: []string{
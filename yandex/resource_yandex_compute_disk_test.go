@@ -7,18 +7,20 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_compute_disk", &resource.Sweeper{
-		Name: "yandex_compute_disk",
-		F:    testSweepComputeDisks,
+	sweeper.Add(&sweeper.Sweeper{
+		Name:   "yandex_compute_disk",
+		List:   listComputeDisks,
+		Delete: sweeperDelete(sweepComputeDisk),
 		Dependencies: []string{
 			"yandex_compute_instance",
 			"yandex_compute_instance_group",
@@ -26,23 +28,20 @@ func init() {
 	})
 }
 
-func testSweepComputeDisks(_ string) error {
-	conf, err := configForSweepers()
+func listComputeDisks(ctx context.Context) ([]sweeper.Resource, error) {
+	conf, err := sweeperConfig()
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
 
 	req := &compute.ListDisksRequest{FolderId: conf.FolderID}
-	it := conf.sdk.Compute().Disk().DiskIterator(conf.Context(), req)
-	result := &multierror.Error{}
+	it := conf.sdk.Compute().Disk().DiskIterator(ctx, req)
+	var result []sweeper.Resource
 	for it.Next() {
-		id := it.Value().GetId()
-		if !sweepComputeDisk(conf, id) {
-			result = multierror.Append(result, fmt.Errorf("failed to sweep Compute Disk %q", id))
-		}
+		result = append(result, sweeperResource(it.Value()))
 	}
 
-	return result.ErrorOrNil()
+	return result, it.Error()
 }
 
 func sweepComputeDisk(conf *Config, id string) bool {
@@ -10,7 +10,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -18,6 +17,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
 	"github.com/yandex-cloud/go-sdk/sdkresolvers"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const (
@@ -25,9 +26,10 @@ const (
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_compute_instance", &resource.Sweeper{
-		Name: "yandex_compute_instance",
-		F:    testSweepComputeInstances,
+	sweeper.Add(&sweeper.Sweeper{
+		Name:   "yandex_compute_instance",
+		List:   listComputeInstances,
+		Delete: sweeperDelete(sweepComputeInstance),
 		Dependencies: []string{
 			"yandex_dataproc_cluster",
 			"yandex_kubernetes_cluster",
@@ -36,23 +38,20 @@ func init() {
 	})
 }
 
-func testSweepComputeInstances(_ string) error {
-	conf, err := configForSweepers()
+func listComputeInstances(ctx context.Context) ([]sweeper.Resource, error) {
+	conf, err := sweeperConfig()
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
 
 	req := &compute.ListInstancesRequest{FolderId: conf.FolderID}
-	it := conf.sdk.Compute().Instance().InstanceIterator(conf.Context(), req)
-	result := &multierror.Error{}
+	it := conf.sdk.Compute().Instance().InstanceIterator(ctx, req)
+	var result []sweeper.Resource
 	for it.Next() {
-		id := it.Value().GetId()
-		if !sweepComputeInstance(conf, id) {
-			result = multierror.Append(result, fmt.Errorf("failed to sweep Compute Instance %q", id))
-		}
+		result = append(result, sweeperResource(it.Value()))
 	}
 
-	return result.ErrorOrNil()
+	return result, it.Error()
 }
 
 func sweepComputeInstance(conf *Config, id string) bool {
@@ -25,6 +25,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/dataproc/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 var testDataprocZone = "ru-central1-b"
@@ -34,7 +36,7 @@ func init() {
 	if ok {
 		testDataprocZone = zone
 	}
-	resource.AddTestSweepers("yandex_dataproc_cluster", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_dataproc_cluster",
 		F:    testSweepDataprocCluster,
 	})
@@ -15,6 +15,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/datatransfer/v1"
 	"google.golang.org/grpc/codes"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const (
@@ -30,7 +32,7 @@ var (
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_datatransfer", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_datatransfer",
 		F:    testSweepDataTransfer,
 	})
@@ -0,0 +1,382 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+)
+
+// dnsZoneRecordsBatchSize is the maximum number of record sets sent in one UpsertRecordSets request.
+const dnsZoneRecordsBatchSize = 100
+
+func resourceYandexDnsZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Description: "Authoritatively manages all record sets of a DNS Zone except `SOA` and `NS` ones. Record sets of the zone which are not described by this resource are deleted.\n\n~> Only one `yandex_dns_zone_records` resource should be used per zone. It conflicts with `yandex_dns_recordset` resources managing the same zone.\n",
+		Create:      resourceYandexDnsZoneRecordsCreate,
+		Read:        resourceYandexDnsZoneRecordsRead,
+		Update:      resourceYandexDnsZoneRecordsUpdate,
+		Delete:      resourceYandexDnsZoneRecordsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceYandexDnsZoneRecordsImportState,
+		},
+
+		CustomizeDiff: dnsZoneRecordsCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexDnsDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexDnsDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexDnsDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the zone which record sets are managed.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"zone_file": {
+				Type:         schema.TypeString,
+				Description:  "Zone contents in the BIND zone file format. Relative names are resolved against the zone name unless `$ORIGIN` is specified. `SOA` and `NS` records are ignored. Conflicts with `record`.",
+				Optional:     true,
+				ExactlyOneOf: []string{"zone_file", "record"},
+			},
+
+			"record": {
+				Type:         schema.TypeSet,
+				Description:  "Record set of the zone. Conflicts with `zone_file`.",
+				Optional:     true,
+				ExactlyOneOf: []string{"zone_file", "record"},
+				Elem:         dnsZoneRecordSchema(false),
+			},
+
+			"records": {
+				Type:        schema.TypeSet,
+				Description: "All record sets of the zone managed by this resource, with fully qualified names.",
+				Computed:    true,
+				Elem:        dnsZoneRecordSchema(true),
+			},
+		},
+	}
+}
+
+func dnsZoneRecordSchema(computed bool) *schema.Resource {
+	if computed {
+		return &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Computed: true},
+				"type": {Type: schema.TypeString, Computed: true},
+				"ttl":  {Type: schema.TypeInt, Computed: true},
+				"data": {Type: schema.TypeSet, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}, Set: schema.HashString},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "The DNS name of the record set. Relative names are resolved against the zone name.",
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 254),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Description:  "The DNS record set type.",
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 20),
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Description:  "The time-to-live of the record set (seconds).",
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 2147483647),
+			},
+			"data": {
+				Type:        schema.TypeSet,
+				Description: "The string data for the records in the record set.",
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    100,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceYandexDnsZoneRecordsCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := reconcileDnsZoneRecords(d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("zone_id").(string))
+
+	return resourceYandexDnsZoneRecordsRead(d, meta)
+}
+
+func resourceYandexDnsZoneRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	current, err := listDnsZoneManagedRecordSets(config.Context(), config, d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DnsZone %q records", d.Id()))
+	}
+
+	return d.Set("records", flattenDnsZoneRecordSets(current))
+}
+
+func resourceYandexDnsZoneRecordsUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := reconcileDnsZoneRecords(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceYandexDnsZoneRecordsRead(d, meta)
+}
+
+func resourceYandexDnsZoneRecordsDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	current, err := listDnsZoneManagedRecordSets(ctx, config, d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DnsZone %q records", d.Id()))
+	}
+
+	if err := upsertDnsZoneRecordSets(ctx, config, d.Id(), current, nil); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting %d record sets of DnsZone %q", len(current), d.Id())
+	return nil
+}
+
+func resourceYandexDnsZoneRecordsImportState(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("zone_id", d.Id()); err != nil {
+		return nil, fmt.Errorf("Error setting zone_id: %s", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func dnsZoneRecordsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("zone_id") || !d.NewValueKnown("zone_file") || !d.NewValueKnown("record") {
+		return d.SetNewComputed("records")
+	}
+
+	config := meta.(*Config)
+	sdk := getSDK(config)
+
+	zone, err := sdk.DNS().DnsZone().Get(ctx, &dns.GetDnsZoneRequest{
+		DnsZoneId: d.Get("zone_id").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("Error while getting DnsZone %q: %s", d.Get("zone_id"), err)
+	}
+
+	desired, err := expandDnsZoneRecords(d.Get("zone_file").(string), d.Get("record").(*schema.Set), zone.Zone)
+	if err != nil {
+		return err
+	}
+
+	return d.SetNew("records", flattenDnsZoneRecordSets(desired))
+}
+
+func reconcileDnsZoneRecords(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	config := meta.(*Config)
+	sdk := getSDK(config)
+	zoneID := d.Get("zone_id").(string)
+
+	ctx, cancel := context.WithTimeout(config.Context(), timeout)
+	defer cancel()
+
+	zone, err := sdk.DNS().DnsZone().Get(ctx, &dns.GetDnsZoneRequest{DnsZoneId: zoneID})
+	if err != nil {
+		return fmt.Errorf("Error while getting DnsZone %q: %s", zoneID, err)
+	}
+
+	desired, err := expandDnsZoneRecords(d.Get("zone_file").(string), d.Get("record").(*schema.Set), zone.Zone)
+	if err != nil {
+		return err
+	}
+
+	current, err := listDnsZoneManagedRecordSets(ctx, config, zoneID)
+	if err != nil {
+		return fmt.Errorf("Error while listing record sets of DnsZone %q: %s", zoneID, err)
+	}
+
+	deletions, replacements := diffDnsZoneRecordSets(current, desired)
+	log.Printf("[DEBUG] Reconciling DnsZone %q records: %d deletions, %d replacements", zoneID, len(deletions), len(replacements))
+
+	return upsertDnsZoneRecordSets(ctx, config, zoneID, deletions, replacements)
+}
+
+func expandDnsZoneRecords(zoneFile string, records *schema.Set, origin string) ([]*dns.RecordSet, error) {
+	var recordSets []*dns.RecordSet
+
+	if zoneFile != "" {
+		parsed, err := parseDnsZoneFile(zoneFile, origin)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing zone_file: %s", err)
+		}
+		recordSets = parsed
+	}
+
+	for _, v := range records.List() {
+		record := v.(map[string]interface{})
+		recordSets = append(recordSets, &dns.RecordSet{
+			Name: dnsZoneRecordFQDN(record["name"].(string), origin),
+			Type: strings.ToUpper(record["type"].(string)),
+			Ttl:  int64(record["ttl"].(int)),
+			Data: convertStringSet(record["data"].(*schema.Set)),
+		})
+	}
+
+	result := make([]*dns.RecordSet, 0, len(recordSets))
+	seen := make(map[string]bool)
+	for _, rs := range recordSets {
+		if !isDnsZoneManagedRecordSet(rs) {
+			continue
+		}
+		key := dnsRecordSetKey(rs)
+		if seen[key] {
+			return nil, fmt.Errorf("record set %s is defined more than once", key)
+		}
+		seen[key] = true
+		result = append(result, rs)
+	}
+	return result, nil
+}
+
+func flattenDnsZoneRecordSets(recordSets []*dns.RecordSet) []interface{} {
+	result := make([]interface{}, 0, len(recordSets))
+	for _, rs := range recordSets {
+		result = append(result, map[string]interface{}{
+			"name": rs.Name,
+			"type": rs.Type,
+			"ttl":  int(rs.Ttl),
+			"data": convertStringArrToInterface(rs.Data),
+		})
+	}
+	return result
+}
+
+// diffDnsZoneRecordSets returns record sets to delete and to replace to turn current into desired.
+func diffDnsZoneRecordSets(current, desired []*dns.RecordSet) (deletions, replacements []*dns.RecordSet) {
+	currentByKey := make(map[string]*dns.RecordSet, len(current))
+	for _, rs := range current {
+		currentByKey[dnsRecordSetKey(rs)] = rs
+	}
+
+	desiredKeys := make(map[string]bool, len(desired))
+	for _, rs := range desired {
+		key := dnsRecordSetKey(rs)
+		desiredKeys[key] = true
+		if existing, ok := currentByKey[key]; !ok || !equalDnsRecordSets(existing, rs) {
+			replacements = append(replacements, rs)
+		}
+	}
+
+	for _, rs := range current {
+		if !desiredKeys[dnsRecordSetKey(rs)] {
+			deletions = append(deletions, rs)
+		}
+	}
+
+	sortDnsRecordSets(deletions)
+	sortDnsRecordSets(replacements)
+	return deletions, replacements
+}
+
+func upsertDnsZoneRecordSets(ctx context.Context, config *Config, zoneID string, deletions, replacements []*dns.RecordSet) error {
+	sdk := getSDK(config)
+
+	for len(deletions) > 0 || len(replacements) > 0 {
+		req := &dns.UpsertRecordSetsRequest{DnsZoneId: zoneID}
+
+		n := min(len(deletions), dnsZoneRecordsBatchSize)
+		req.Deletions, deletions = deletions[:n], deletions[n:]
+
+		m := min(len(replacements), dnsZoneRecordsBatchSize-n)
+		req.Replacements, replacements = replacements[:m], replacements[m:]
+
+		op, err := sdk.WrapOperation(sdk.DNS().DnsZone().UpsertRecordSets(ctx, req))
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to upsert record sets of DnsZone %q: %s", zoneID, err)
+		}
+
+		if err := op.Wait(ctx); err != nil {
+			return fmt.Errorf("Error while waiting operation to upsert record sets of DnsZone %q: %s", zoneID, err)
+		}
+
+		if _, err := op.Response(); err != nil {
+			return fmt.Errorf("Upserting record sets of DnsZone %q failed: %s", zoneID, err)
+		}
+	}
+
+	return nil
+}
+
+func listDnsZoneRecordSets(ctx context.Context, config *Config, zoneID string) ([]*dns.RecordSet, error) {
+	sdk := getSDK(config)
+
+	it := sdk.DNS().DnsZone().DnsZoneRecordSetsIterator(ctx, &dns.ListDnsZoneRecordSetsRequest{
+		DnsZoneId: zoneID,
+	})
+	return it.TakeAll()
+}
+
+func listDnsZoneManagedRecordSets(ctx context.Context, config *Config, zoneID string) ([]*dns.RecordSet, error) {
+	recordSets, err := listDnsZoneRecordSets(ctx, config, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dns.RecordSet, 0, len(recordSets))
+	for _, rs := range recordSets {
+		if isDnsZoneManagedRecordSet(rs) {
+			result = append(result, rs)
+		}
+	}
+	return result, nil
+}
+
+func isDnsZoneManagedRecordSet(rs *dns.RecordSet) bool {
+	return rs.Type != "SOA" && rs.Type != "NS"
+}
+
+func dnsRecordSetKey(rs *dns.RecordSet) string {
+	return rs.Name + " " + rs.Type
+}
+
+func equalDnsRecordSets(a, b *dns.RecordSet) bool {
+	if a.Ttl != b.Ttl || len(a.Data) != len(b.Data) {
+		return false
+	}
+
+	aData := append([]string(nil), a.Data...)
+	bData := append([]string(nil), b.Data...)
+	sort.Strings(aData)
+	sort.Strings(bData)
+	for i := range aData {
+		if aData[i] != bData[i] {
+			return false
+		}
+	}
+	return true
+}
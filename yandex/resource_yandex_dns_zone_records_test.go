@@ -0,0 +1,135 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDNSZoneRecords_zoneFile(t *testing.T) {
+	t.Parallel()
+
+	zoneName := acctest.RandomWithPrefix("tf-dns-zone")
+	fqdn := acctest.RandomWithPrefix("tf-test") + ".dnstest.test."
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDnsZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSZoneRecordsZoneFile(zoneName, fqdn, `
+$TTL 300
+www   A     192.0.2.1
+      A     192.0.2.2
+api   CNAME www
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.records", "records.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("yandex_dns_zone_records.records", "records.*", map[string]string{
+						"name":   "www." + fqdn,
+						"type":   "A",
+						"ttl":    "300",
+						"data.#": "2",
+					}),
+					resource.TestCheckResourceAttr("data.yandex_dns_zone_file.export", "records.#", "4"),
+				),
+			},
+			{
+				Config: testAccDNSZoneRecordsZoneFile(zoneName, fqdn, `
+www   600   A     192.0.2.1
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.records", "records.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("yandex_dns_zone_records.records", "records.*", map[string]string{
+						"name":   "www." + fqdn,
+						"ttl":    "600",
+						"data.#": "1",
+					}),
+				),
+			},
+			{
+				ResourceName:            "yandex_dns_zone_records.records",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zone_file"},
+			},
+		},
+	})
+}
+
+func TestAccDNSZoneRecords_record(t *testing.T) {
+	t.Parallel()
+
+	zoneName := acctest.RandomWithPrefix("tf-dns-zone")
+	fqdn := acctest.RandomWithPrefix("tf-test") + ".dnstest.test."
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDnsZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "yandex_dns_zone" "zone1" {
+  name = "%[1]s"
+  zone = "%[2]s"
+}
+
+resource "yandex_dns_zone_records" "records" {
+  zone_id = yandex_dns_zone.zone1.id
+
+  record {
+    name = "srv"
+    type = "A"
+    ttl  = 200
+    data = ["192.168.0.1"]
+  }
+
+  record {
+    name = "@"
+    type = "TXT"
+    ttl  = 200
+    data = ["\"hello\""]
+  }
+}
+`, zoneName, fqdn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.records", "records.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("yandex_dns_zone_records.records", "records.*", map[string]string{
+						"name": "srv." + fqdn,
+						"type": "A",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("yandex_dns_zone_records.records", "records.*", map[string]string{
+						"name": fqdn,
+						"type": "TXT",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDNSZoneRecordsZoneFile(name, fqdn, zoneFile string) string {
+	return fmt.Sprintf(`
+resource "yandex_dns_zone" "zone1" {
+  name = "%[1]s"
+  zone = "%[2]s"
+}
+
+resource "yandex_dns_zone_records" "records" {
+  zone_id   = yandex_dns_zone.zone1.id
+  zone_file = <<-EOT
+%[3]s
+EOT
+}
+
+data "yandex_dns_zone_file" "export" {
+  dns_zone_id = yandex_dns_zone.zone1.id
+
+  depends_on = [yandex_dns_zone_records.records]
+}
+`, name, fqdn, zoneFile)
+}
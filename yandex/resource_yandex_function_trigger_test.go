@@ -17,12 +17,14 @@ import (
 
 	iot "github.com/yandex-cloud/go-genproto/yandex/cloud/iot/devices/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/triggers/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const triggerResource = "yandex_function_trigger.test-trigger"
 
 func init() {
-	resource.AddTestSweepers("yandex_function_trigger", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_function_trigger",
 		F:    testSweepFunctionTrigger,
 	})
@@ -0,0 +1,511 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"google.golang.org/grpc/codes"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+)
+
+const yandexIAMServiceAccountKeyRotationDefaultTimeout = 5 * time.Minute
+
+func resourceYandexIAMServiceAccountKeyRotation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Allows rotation of [Yandex Cloud IAM service account authorized keys](https://yandex.cloud/docs/iam/concepts/authorization/key) without breaking their consumers.\n\n" +
+			"The resource creates a new key when `rotation_period` has elapsed since the newest key was created. The check is performed at plan time, so a rotation is planned by any `terraform plan` run after the period has elapsed. " +
+			"Keys that fall out of the `keep_keys` newest ones are deleted only after `overlap_period` has elapsed since the key which replaced them was created, so consumers have time to switch to the new key.\n\n" +
+			"~> The private key is only available for the newest key and only on the apply which created it. Use `output_to_lockbox` to publish every new key into a Lockbox secret.\n",
+		Create:        resourceYandexIAMServiceAccountKeyRotationCreate,
+		Read:          resourceYandexIAMServiceAccountKeyRotationRead,
+		Update:        resourceYandexIAMServiceAccountKeyRotationUpdate,
+		Delete:        resourceYandexIAMServiceAccountKeyRotationDelete,
+		CustomizeDiff: resourceYandexIAMServiceAccountKeyRotationCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: resourceYandexIAMServiceAccountKeyRotationImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexIAMServiceAccountKeyRotationDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexIAMServiceAccountKeyRotationDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexIAMServiceAccountKeyRotationDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexIAMServiceAccountKeyRotationDefaultTimeout),
+		},
+
+		Schema: ExtendWithOutputToLockbox(map[string]*schema.Schema{
+			"service_account_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the service account to create keys for.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of keys created by the resource. Changing it does not affect the existing keys.",
+				Optional:    true,
+			},
+
+			"format": {
+				Type:         schema.TypeString,
+				Description:  "The output format of the keys. `PEM_FILE` is the default format.",
+				Default:      "PEM_FILE",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateParsableValue(parseIamKeyFormat),
+			},
+
+			"key_algorithm": {
+				Type:         schema.TypeString,
+				Description:  "The algorithm used to generate the keys. `RSA_2048` is the default algorithm. Valid values are listed in the [API reference](https://yandex.cloud/docs/iam/api-ref/Key).",
+				Default:      "RSA_2048",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateParsableValue(parseIamKeyAlgorithm),
+			},
+
+			"rotation_period": {
+				Type:         schema.TypeString,
+				Description:  "Period after which a new key is created, e.g. `720h`.",
+				Required:     true,
+				ValidateFunc: validateParsableValue(time.ParseDuration),
+			},
+
+			"overlap_period": {
+				Type:         schema.TypeString,
+				Description:  "Period during which a replaced key is still kept, e.g. `24h`. By default, replaced keys are deleted on the same apply which creates the new key.",
+				Optional:     true,
+				Default:      "0s",
+				ValidateFunc: validateParsableValue(time.ParseDuration),
+			},
+
+			"keep_keys": {
+				Type:         schema.TypeInt,
+				Description:  "Number of the newest keys which are never deleted. The default value is `1`.",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"active_key_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the newest key. The ID of the resource is the ID of the first key managed by the resource and doesn't change on rotation.",
+				Computed:    true,
+			},
+
+			"public_key": {
+				Type:        schema.TypeString,
+				Description: "The public key of the newest key.",
+				Computed:    true,
+			},
+
+			"private_key": {
+				Type:        schema.TypeString,
+				Description: "The private key of the newest key. This is only populated when `output_to_lockbox` is not provided.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+
+			"next_rotation_at": {
+				Type:        schema.TypeString,
+				Description: "Time after which the next `terraform plan` creates a new key.",
+				Computed:    true,
+			},
+
+			"keys": {
+				Type:        schema.TypeList,
+				Description: "Keys managed by the resource, the newest first.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of the key.",
+							Computed:    true,
+						},
+						"public_key": {
+							Type:        schema.TypeString,
+							Description: "The public key.",
+							Computed:    true,
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Description: common.ResourceDescriptions["created_at"],
+							Computed:    true,
+						},
+					},
+				},
+			},
+		}, resourceYandexIAMServiceAccountKeySensitiveAttrs),
+	}
+}
+
+// serviceAccountRotatedKey is a key managed by `yandex_iam_service_account_key_rotation`.
+type serviceAccountRotatedKey struct {
+	ID        string
+	PublicKey string
+	CreatedAt time.Time
+}
+
+type serviceAccountKeyRotationPolicy struct {
+	rotationPeriod time.Duration
+	overlapPeriod  time.Duration
+	keepKeys       int
+}
+
+// rotationDue reports whether a new key has to be created. keys are sorted from the newest to the oldest.
+func (p *serviceAccountKeyRotationPolicy) rotationDue(keys []serviceAccountRotatedKey, now time.Time) bool {
+	return len(keys) == 0 || !now.Before(keys[0].CreatedAt.Add(p.rotationPeriod))
+}
+
+// expiredKeys returns keys which are out of the `keep_keys` newest ones for longer than the overlap period.
+// A key at position i fell out of the newest ones when the key at position i-keepKeys was created.
+func (p *serviceAccountKeyRotationPolicy) expiredKeys(keys []serviceAccountRotatedKey, now time.Time) []serviceAccountRotatedKey {
+	var expired []serviceAccountRotatedKey
+	for i := p.keepKeys; i < len(keys); i++ {
+		if !now.Before(keys[i-p.keepKeys].CreatedAt.Add(p.overlapPeriod)) {
+			expired = append(expired, keys[i])
+		}
+	}
+	return expired
+}
+
+func (p *serviceAccountKeyRotationPolicy) nextRotationAt(keys []serviceAccountRotatedKey) string {
+	if len(keys) == 0 {
+		return ""
+	}
+	return keys[0].CreatedAt.Add(p.rotationPeriod).Format(defaultTimeFormat)
+}
+
+func getServiceAccountKeyRotationPolicy(d interface{ Get(string) interface{} }) (*serviceAccountKeyRotationPolicy, error) {
+	rotationPeriod, err := time.ParseDuration(d.Get("rotation_period").(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid rotation_period: %s", err)
+	}
+	overlapPeriod, err := time.ParseDuration(d.Get("overlap_period").(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid overlap_period: %s", err)
+	}
+	return &serviceAccountKeyRotationPolicy{
+		rotationPeriod: rotationPeriod,
+		overlapPeriod:  overlapPeriod,
+		keepKeys:       d.Get("keep_keys").(int),
+	}, nil
+}
+
+func expandServiceAccountRotatedKeys(v interface{}) ([]serviceAccountRotatedKey, error) {
+	var keys []serviceAccountRotatedKey
+	for _, raw := range v.([]interface{}) {
+		m := raw.(map[string]interface{})
+		createdAt, err := time.Parse(defaultTimeFormat, m["created_at"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid created_at of key %q: %s", m["id"], err)
+		}
+		keys = append(keys, serviceAccountRotatedKey{
+			ID:        m["id"].(string),
+			PublicKey: m["public_key"].(string),
+			CreatedAt: createdAt,
+		})
+	}
+	sortServiceAccountRotatedKeys(keys)
+	return keys, nil
+}
+
+func flattenServiceAccountRotatedKeys(keys []serviceAccountRotatedKey) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(keys))
+	for _, k := range keys {
+		result = append(result, map[string]interface{}{
+			"id":         k.ID,
+			"public_key": k.PublicKey,
+			"created_at": k.CreatedAt.Format(defaultTimeFormat),
+		})
+	}
+	return result
+}
+
+func sortServiceAccountRotatedKeys(keys []serviceAccountRotatedKey) {
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.After(keys[j].CreatedAt)
+	})
+}
+
+func resourceYandexIAMServiceAccountKeyRotationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	policy, err := getServiceAccountKeyRotationPolicy(d)
+	if err != nil {
+		return err
+	}
+	keys, err := expandServiceAccountRotatedKeys(d.Get("keys"))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if policy.rotationDue(keys, now) {
+		log.Printf("[DEBUG] Service account key rotation %q: rotation period has elapsed, planning a new key", d.Id())
+		for _, attr := range []string{"active_key_id", "public_key", "private_key", "next_rotation_at", "keys", lockboxOutputVersionIdAttr} {
+			if err := d.SetNewComputed(attr); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if len(policy.expiredKeys(keys, now)) > 0 {
+		if err := d.SetNewComputed("keys"); err != nil {
+			return err
+		}
+	}
+	return d.SetNew("next_rotation_at", policy.nextRotationAt(keys))
+}
+
+// createServiceAccountRotatedKey creates a new key and returns it with its private key.
+func createServiceAccountRotatedKey(ctx context.Context, d *schema.ResourceData, config *Config) (serviceAccountRotatedKey, string, error) {
+	format, err := parseIamKeyFormat(d.Get("format").(string))
+	if err != nil {
+		return serviceAccountRotatedKey{}, "", err
+	}
+
+	algorithm, err := parseIamKeyAlgorithm(d.Get("key_algorithm").(string))
+	if err != nil {
+		return serviceAccountRotatedKey{}, "", err
+	}
+
+	resp, err := config.sdk.IAM().Key().Create(ctx, &iam.CreateKeyRequest{
+		ServiceAccountId: d.Get("service_account_id").(string),
+		Description:      d.Get("description").(string),
+		Format:           format,
+		KeyAlgorithm:     algorithm,
+	})
+	if err != nil {
+		return serviceAccountRotatedKey{}, "", fmt.Errorf("error creating service account key: %s", err)
+	}
+
+	log.Printf("[DEBUG] Created service account key %q", resp.Key.Id)
+	return serviceAccountRotatedKey{
+		ID:        resp.Key.Id,
+		PublicKey: resp.Key.PublicKey,
+		CreatedAt: resp.Key.CreatedAt.AsTime(),
+	}, resp.PrivateKey, nil
+}
+
+func resourceYandexIAMServiceAccountKeyRotationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	key, privateKey, err := createServiceAccountRotatedKey(ctx, d, config)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(key.ID)
+	if err := d.Set("keys", flattenServiceAccountRotatedKeys([]serviceAccountRotatedKey{key})); err != nil {
+		return err
+	}
+	d.Set("private_key", privateKey)
+
+	err = resourceYandexIAMServiceAccountKeyRotationRead(d, meta)
+	if err != nil {
+		return err
+	}
+
+	return ManageOutputToLockbox(ctx, d, config, resourceYandexIAMServiceAccountKeySensitiveAttrs)
+}
+
+func resourceYandexIAMServiceAccountKeyRotationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	policy, err := getServiceAccountKeyRotationPolicy(d)
+	if err != nil {
+		return err
+	}
+	stateKeys, err := expandServiceAccountRotatedKeys(d.Get("keys"))
+	if err != nil {
+		return err
+	}
+
+	var keys []serviceAccountRotatedKey
+	for _, k := range stateKeys {
+		key, err := config.sdk.IAM().Key().Get(ctx, &iam.GetKeyRequest{KeyId: k.ID})
+		if err != nil {
+			if isStatusWithCode(err, codes.NotFound) {
+				log.Printf("[WARN] Service account key %q of rotation %q no longer exists", k.ID, d.Id())
+				continue
+			}
+			return fmt.Errorf("error reading service account key %q: %s", k.ID, err)
+		}
+		keys = append(keys, serviceAccountRotatedKey{
+			ID:        key.Id,
+			PublicKey: key.PublicKey,
+			CreatedAt: key.CreatedAt.AsTime(),
+		})
+	}
+
+	if len(keys) == 0 {
+		log.Printf("[WARN] All keys of service account key rotation %q are gone, removing it from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	sortServiceAccountRotatedKeys(keys)
+
+	if err := d.Set("keys", flattenServiceAccountRotatedKeys(keys)); err != nil {
+		return err
+	}
+	d.Set("active_key_id", keys[0].ID)
+	d.Set("public_key", keys[0].PublicKey)
+	d.Set("next_rotation_at", policy.nextRotationAt(keys))
+
+	return nil
+}
+
+// resourceYandexIAMServiceAccountKeyRotationImportState imports the key with the given ID as the only key managed
+// by the resource. `rotation_period` can't be read from the API, so it's set to `0s` and the first plan after the import
+// updates it from the configuration.
+func resourceYandexIAMServiceAccountKeyRotationImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	key, err := config.sdk.IAM().Key().Get(ctx, &iam.GetKeyRequest{KeyId: d.Id()})
+	if err != nil {
+		return nil, fmt.Errorf("error reading service account key %q: %s", d.Id(), err)
+	}
+	if key.GetServiceAccountId() == "" {
+		return nil, fmt.Errorf("key %q does not belong to a service account", d.Id())
+	}
+
+	d.Set("service_account_id", key.GetServiceAccountId())
+	d.Set("description", key.GetDescription())
+	d.Set("key_algorithm", key.GetKeyAlgorithm().String())
+	d.Set("format", "PEM_FILE")
+	d.Set("overlap_period", "0s")
+	d.Set("keep_keys", 1)
+	d.Set("rotation_period", "0s")
+
+	rotatedKey := serviceAccountRotatedKey{
+		ID:        key.Id,
+		PublicKey: key.PublicKey,
+		CreatedAt: key.CreatedAt.AsTime(),
+	}
+	if err := d.Set("keys", flattenServiceAccountRotatedKeys([]serviceAccountRotatedKey{rotatedKey})); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceYandexIAMServiceAccountKeyRotationUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	policy, err := getServiceAccountKeyRotationPolicy(d)
+	if err != nil {
+		return err
+	}
+	oldKeys, _ := d.GetChange("keys")
+	keys, err := expandServiceAccountRotatedKeys(oldKeys)
+	if err != nil {
+		return err
+	}
+
+	// The rotation decision is made at plan time, where `active_key_id` becomes unknown. The unknown value is read
+	// from the plan, as ResourceData returns the prior value of a computed attribute.
+	rotated := !d.GetRawPlan().GetAttr("active_key_id").IsKnown()
+	var privateKey string
+	if rotated {
+		var key serviceAccountRotatedKey
+		key, privateKey, err = createServiceAccountRotatedKey(ctx, d, config)
+		if err != nil {
+			return err
+		}
+		keys = append([]serviceAccountRotatedKey{key}, keys...)
+	}
+
+	expired := policy.expiredKeys(keys, time.Now())
+	for _, k := range expired {
+		log.Printf("[DEBUG] Deleting expired service account key %q", k.ID)
+		_, err := config.sdk.IAM().Key().Delete(ctx, &iam.DeleteKeyRequest{KeyId: k.ID})
+		if err != nil && !isStatusWithCode(err, codes.NotFound) {
+			return fmt.Errorf("error deleting service account key %q: %s", k.ID, err)
+		}
+	}
+	keys = keys[:len(keys)-len(expired)]
+
+	if err := d.Set("keys", flattenServiceAccountRotatedKeys(keys)); err != nil {
+		return err
+	}
+
+	err = resourceYandexIAMServiceAccountKeyRotationRead(d, meta)
+	if err != nil {
+		return err
+	}
+
+	if !rotated {
+		return ManageOutputToLockbox(ctx, d, config, resourceYandexIAMServiceAccountKeySensitiveAttrs)
+	}
+	return outputRotatedKeyToLockbox(ctx, d, config, privateKey)
+}
+
+// outputRotatedKeyToLockbox publishes the private key of a new key. The previous private key is already obsolete,
+// so the previous Lockbox version is destroyed instead of being restored into the state.
+func outputRotatedKeyToLockbox(ctx context.Context, d *schema.ResourceData, config *Config, privateKey string) error {
+	d.Set("private_key", privateKey)
+
+	secretOldID, secretID := getChangeAsString(d, lockboxOutputSecretIdAttr)
+	versionOldID, _ := getChangeAsString(d, lockboxOutputVersionIdAttr)
+	if secretOldID != "" && versionOldID != "" {
+		if err := destroyLockboxVersion(ctx, config, secretOldID, versionOldID); err != nil {
+			return err
+		}
+	}
+
+	if secretID == "" {
+		return d.Set(lockboxOutputVersionIdAttr, "")
+	}
+	return moveSensitiveAttrsToNewLockboxVersion(ctx, d, config, resourceYandexIAMServiceAccountKeySensitiveAttrs, secretID)
+}
+
+func resourceYandexIAMServiceAccountKeyRotationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	keys, err := expandServiceAccountRotatedKeys(d.Get("keys"))
+	if err != nil {
+		return err
+	}
+
+	for _, k := range keys {
+		_, err := config.sdk.IAM().Key().Delete(ctx, &iam.DeleteKeyRequest{KeyId: k.ID})
+		if err != nil && !isStatusWithCode(err, codes.NotFound) {
+			return fmt.Errorf("error deleting service account key %q: %s", k.ID, err)
+		}
+	}
+
+	err = DestroyOutputToLockboxVersion(ctx, d, config)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,161 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers/fakecloud"
+)
+
+func TestServiceAccountKeyRotationPolicy(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	key := func(id string, age time.Duration) serviceAccountRotatedKey {
+		return serviceAccountRotatedKey{ID: id, CreatedAt: now.Add(-age)}
+	}
+
+	tests := []struct {
+		name           string
+		policy         serviceAccountKeyRotationPolicy
+		keys           []serviceAccountRotatedKey
+		expectedRotate bool
+		expectedIDs    []string
+	}{
+		{
+			name:           "no keys",
+			policy:         serviceAccountKeyRotationPolicy{rotationPeriod: time.Hour, keepKeys: 1},
+			expectedRotate: true,
+		},
+		{
+			name:   "fresh key",
+			policy: serviceAccountKeyRotationPolicy{rotationPeriod: 48 * time.Hour, keepKeys: 1},
+			keys:   []serviceAccountRotatedKey{key("new", time.Hour)},
+		},
+		{
+			name:           "rotation period elapsed",
+			policy:         serviceAccountKeyRotationPolicy{rotationPeriod: 48 * time.Hour, keepKeys: 1},
+			keys:           []serviceAccountRotatedKey{key("new", 48*time.Hour)},
+			expectedRotate: true,
+		},
+		{
+			name:   "replaced key within overlap",
+			policy: serviceAccountKeyRotationPolicy{rotationPeriod: 48 * time.Hour, overlapPeriod: 24 * time.Hour, keepKeys: 1},
+			keys:   []serviceAccountRotatedKey{key("new", time.Hour), key("old", 49*time.Hour)},
+		},
+		{
+			name:        "replaced key after overlap",
+			policy:      serviceAccountKeyRotationPolicy{rotationPeriod: 48 * time.Hour, overlapPeriod: 24 * time.Hour, keepKeys: 1},
+			keys:        []serviceAccountRotatedKey{key("new", 25*time.Hour), key("old", 73*time.Hour)},
+			expectedIDs: []string{"old"},
+		},
+		{
+			name:   "keep several keys",
+			policy: serviceAccountKeyRotationPolicy{rotationPeriod: 48 * time.Hour, overlapPeriod: 24 * time.Hour, keepKeys: 2},
+			keys: []serviceAccountRotatedKey{
+				key("new", time.Hour), key("mid", 49*time.Hour), key("old", 97*time.Hour), key("oldest", 145*time.Hour),
+			},
+			expectedIDs: []string{"oldest"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expectedRotate, test.policy.rotationDue(test.keys, now))
+
+			var ids []string
+			for _, k := range test.policy.expiredKeys(test.keys, now) {
+				ids = append(ids, k.ID)
+			}
+			assert.Equal(t, test.expectedIDs, ids)
+		})
+	}
+}
+
+func TestExpandServiceAccountRotatedKeys(t *testing.T) {
+	keys, err := expandServiceAccountRotatedKeys([]interface{}{
+		map[string]interface{}{"id": "old", "public_key": "a", "created_at": "2026-10-01T00:00:00Z"},
+		map[string]interface{}{"id": "new", "public_key": "b", "created_at": "2026-10-10T00:00:00Z"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "new", keys[0].ID)
+	assert.Equal(t, "old", keys[1].ID)
+	assert.Equal(t, "2026-10-10T00:00:00Z", flattenServiceAccountRotatedKeys(keys)[0]["created_at"])
+}
+
+func TestFakeCloud_IAMServiceAccountKeyRotation(t *testing.T) {
+	skipWithoutTerraform(t)
+	fakecloud.NewTestServer(t)
+
+	const name = "yandex_iam_service_account_key_rotation.rotation"
+	var firstKeyID string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeCloudIAMServiceAccountKeyRotationConfig("720h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "id", name, "active_key_id"),
+					resource.TestCheckResourceAttr(name, "keys.#", "1"),
+					func(s *terraform.State) error {
+						firstKeyID = s.RootModule().Resources[name].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// The rotation period has always elapsed, so the key is rotated on every apply.
+				Config:             testFakeCloudIAMServiceAccountKeyRotationConfig("1ns"),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(name, "id", &firstKeyID),
+					resource.TestCheckResourceAttr(name, "keys.#", "1"),
+					testAccCheckServiceAccountKeyRotationRotated(name, &firstKeyID),
+				),
+			},
+			{
+				Config: testFakeCloudIAMServiceAccountKeyRotationConfig("720h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(name, "id", &firstKeyID),
+					testAccCheckServiceAccountKeyRotationRotated(name, &firstKeyID),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckServiceAccountKeyRotationRotated checks that the newest key of the rotation is not the first one.
+func testAccCheckServiceAccountKeyRotationRotated(n string, firstKeyID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		activeKeyID := rs.Primary.Attributes["active_key_id"]
+		if activeKeyID == *firstKeyID {
+			return fmt.Errorf("active_key_id is still the first key %q", activeKeyID)
+		}
+		if keyID := rs.Primary.Attributes["keys.0.id"]; keyID != activeKeyID {
+			return fmt.Errorf("active_key_id is %q, but the newest key is %q", activeKeyID, keyID)
+		}
+		return nil
+	}
+}
+
+func testFakeCloudIAMServiceAccountKeyRotationConfig(rotationPeriod string) string {
+	return fmt.Sprintf(`
+resource "yandex_iam_service_account" "sa" {
+  name = "fakecloud-sa"
+}
+
+resource "yandex_iam_service_account_key_rotation" "rotation" {
+  service_account_id = yandex_iam_service_account.sa.id
+  rotation_period    = "%s"
+}
+`, rotationPeriod)
+}
@@ -12,12 +12,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	iot "github.com/yandex-cloud/go-genproto/yandex/cloud/iot/broker/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const iotBrokerResource = "yandex_iot_core_broker.test-broker"
 
 func init() {
-	resource.AddTestSweepers("yandex_iot_core_broker", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name:         "yandex_iot_core_broker",
 		F:            testSweepIoTCoreBroker,
 		Dependencies: []string{},
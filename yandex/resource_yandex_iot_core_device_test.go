@@ -13,13 +13,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	iot "github.com/yandex-cloud/go-genproto/yandex/cloud/iot/devices/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const iotRegistryResourceForDevices = "yandex_iot_core_registry.test-registry"
 const iotDeviceResource = "yandex_iot_core_device.test-device"
 
 func init() {
-	resource.AddTestSweepers("yandex_iot_core_device", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_iot_core_device",
 		F:    testSweepIoTCoreDevice,
 		Dependencies: []string{
@@ -12,12 +12,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	iot "github.com/yandex-cloud/go-genproto/yandex/cloud/iot/devices/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const iotRegistryResource = "yandex_iot_core_registry.test-registry"
 
 func init() {
-	resource.AddTestSweepers("yandex_iot_core_registry", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_iot_core_registry",
 		F:    testSweepIoTCoreRegistry,
 		Dependencies: []string{
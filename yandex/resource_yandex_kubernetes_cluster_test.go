@@ -16,6 +16,8 @@ import (
 	"google.golang.org/genproto/protobuf/field_mask"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/k8s/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const (
@@ -24,7 +26,7 @@ const (
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_kubernetes_cluster", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_kubernetes_cluster",
 		F:    testSweepKubernetesClusters,
 		Dependencies: []string{
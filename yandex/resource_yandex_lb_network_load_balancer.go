@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -252,6 +253,12 @@ func resourceYandexLBNetworkLoadBalancer() *schema.Resource {
 								},
 							},
 						},
+						"wait_for_healthy": {
+							Type:        schema.TypeBool,
+							Description: "Wait until all targets of the group are reported as `HEALTHY` before finishing create or update of the load balancer. Waiting is limited by the `create` and `update` timeouts.",
+							Optional:    true,
+							Default:     false,
+						},
 					},
 				},
 			},
@@ -348,6 +355,10 @@ func resourceYandexLBNetworkLoadBalancerCreate(d *schema.ResourceData, meta inte
 		return fmt.Errorf("Network creation failed: %s", err)
 	}
 
+	if err := waitForLBTargetGroupsHealthy(ctx, config, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
 	return resourceYandexLBNetworkLoadBalancerRead(d, meta)
 }
 
@@ -374,6 +385,7 @@ func resourceYandexLBNetworkLoadBalancerRead(d *schema.ResourceData, meta interf
 	if err != nil {
 		return err
 	}
+	atgs = keepLBWaitForHealthy(atgs, lbWaitForHealthyTargetGroups(d))
 
 	d.Set("created_at", getTimestamp(nlb.CreatedAt))
 	d.Set("name", nlb.Name)
@@ -437,9 +449,54 @@ func resourceYandexLBNetworkLoadBalancerUpdate(d *schema.ResourceData, meta inte
 		return fmt.Errorf("Error updating NetworkLoadBalancer %q: %s", d.Id(), err)
 	}
 
+	if err := waitForLBTargetGroupsHealthy(ctx, config, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
 	return resourceYandexLBNetworkLoadBalancerRead(d, meta)
 }
 
+func waitForLBTargetGroupsHealthy(ctx context.Context, config *Config, d *schema.ResourceData, timeout time.Duration) error {
+	targetGroupIDs := lbWaitForHealthyTargetGroups(d)
+	if len(targetGroupIDs) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Waiting for targets of NetworkLoadBalancer %q to become healthy", d.Id())
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		states, err := getLBTargetStates(ctx, config, d.Id(), targetGroupIDs)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		if !lbTargetStatesHealthy(states) {
+			return retry.RetryableError(fmt.Errorf("targets of NetworkLoadBalancer %q are not healthy yet", d.Id()))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error while waiting for targets of NetworkLoadBalancer %q to become healthy: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// keepLBWaitForHealthy copies wait_for_healthy flags from the configuration,
+// since the API does not store them.
+func keepLBWaitForHealthy(atgs *schema.Set, targetGroupIDs []string) *schema.Set {
+	wait := make(map[string]bool, len(targetGroupIDs))
+	for _, id := range targetGroupIDs {
+		wait[id] = true
+	}
+
+	result := &schema.Set{F: atgs.F}
+	for _, v := range atgs.List() {
+		atg := v.(map[string]interface{})
+		atg["wait_for_healthy"] = wait[atg["target_group_id"].(string)]
+		result.Add(atg)
+	}
+	return result
+}
+
 func resourceYandexLBNetworkLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -11,12 +11,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const nlbResource = "yandex_lb_network_load_balancer.test-nlb"
 
 func init() {
-	resource.AddTestSweepers("yandex_lb_network_load_balancer", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_lb_network_load_balancer",
 		F:    testSweepLBNetworkLoadBalancers,
 		Dependencies: []string{
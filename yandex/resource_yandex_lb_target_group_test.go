@@ -11,12 +11,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const tgResource = "yandex_lb_target_group.test-tg"
 
 func init() {
-	resource.AddTestSweepers("yandex_lb_target_group", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name:         "yandex_lb_target_group",
 		F:            testSweepLBTargetGroups,
 		Dependencies: []string{},
@@ -15,13 +15,15 @@ import (
 	lt "github.com/yandex-cloud/go-genproto/yandex/cloud/loadtesting/api/v1"
 	ltagent "github.com/yandex-cloud/go-genproto/yandex/cloud/loadtesting/api/v1/agent"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const loadtestingAgentResource = "yandex_loadtesting_agent.test-lt-agent"
 const loadtestingAgentSubnetResource = "yandex_vpc_subnet.loadtesting-agent-test-subnet"
 
 func init() {
-	resource.AddTestSweepers("yandex_loadtesting_agent", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_loadtesting_agent",
 		F:    testSweepLoadtestingAgents,
 		Dependencies: []string{
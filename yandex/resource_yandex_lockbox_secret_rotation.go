@@ -0,0 +1,455 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+	"google.golang.org/grpc/codes"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+)
+
+const (
+	yandexLockboxSecretRotationDefaultTimeout = 5 * time.Minute
+)
+
+func resourceYandexLockboxSecretRotation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Periodically adds new versions to a Yandex Cloud Lockbox secret. For more information, see [the official documentation](https://yandex.cloud/docs/lockbox/).\n\n" +
+			"The resource adds a new version when `rotation_period` has elapsed since the newest version added by the resource was created, or when `entries` change. The check is performed at plan time, so a rotation is planned by any `terraform plan` run after the period has elapsed. " +
+			"For secrets with `password_payload_specification` Lockbox generates a new password, otherwise the version is built from `entries`, running their commands again.\n\n" +
+			"Only the `max_versions` newest versions added by the resource are kept, older ones are scheduled for destruction. Versions added outside of the resource are not affected.\n",
+
+		ReadContext:   resourceYandexLockboxSecretRotationRead,
+		CreateContext: resourceYandexLockboxSecretRotationCreate,
+		UpdateContext: resourceYandexLockboxSecretRotationUpdate,
+		DeleteContext: resourceYandexLockboxSecretRotationDelete,
+		CustomizeDiff: resourceYandexLockboxSecretRotationCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexLockboxSecretRotationDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexLockboxSecretVersionDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexLockboxSecretRotationDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexLockboxSecretRotationDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": {
+				Type:         schema.TypeString,
+				Description:  "The Yandex Cloud Lockbox secret ID where to add the versions.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+			},
+
+			"entries": {
+				Type:        schema.TypeList,
+				Description: "List of entries of the new versions. Must be omitted for secrets with a payload specification.\n\n~> One either `text_value` or `command` is required.\n",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Description:  "The key of the entry.",
+							Required:     true,
+							ValidateFunc: validation.All(validation.StringMatch(regexp.MustCompile(`^([-_./\\@0-9a-zA-Z]+)$`), ""), validation.StringLenBetween(0, 256)),
+						},
+
+						"text_value": {
+							Type:         schema.TypeString,
+							Description:  "The text value of the entry.",
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(0, 65536),
+						},
+
+						"command": {
+							Type:        schema.TypeList,
+							Description: "The command that generates the text value of the entry. The command is run for every new version.",
+							MaxItems:    1,
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Type:        schema.TypeString,
+										Description: "The path to the script or command to execute.",
+										Required:    true,
+									},
+									"env": {
+										Type:        schema.TypeMap,
+										Description: "Map of environment variables to set before calling the script/command.",
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Optional:    true,
+									},
+									"args": {
+										Type:        schema.TypeList,
+										Description: "List of arguments to be passed to the script/command.",
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Description:  "The description of versions added by the resource. Changing it does not affect the existing versions.",
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+
+			"rotation_period": {
+				Type:         schema.TypeString,
+				Description:  "Period after which a new version is added, e.g. `720h`.",
+				Required:     true,
+				ValidateFunc: validateParsableValue(time.ParseDuration),
+			},
+
+			"max_versions": {
+				Type:         schema.TypeInt,
+				Description:  "Number of the newest versions added by the resource which are kept. The default value is `2`.",
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"current_version_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the newest version added by the resource.",
+				Computed:    true,
+			},
+
+			"next_rotation_at": {
+				Type:        schema.TypeString,
+				Description: "Time after which the next `terraform plan` adds a new version.",
+				Computed:    true,
+			},
+
+			"versions": {
+				Type:        schema.TypeList,
+				Description: "Versions added by the resource and not scheduled for destruction, the newest first.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of the version.",
+							Computed:    true,
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Description: common.ResourceDescriptions["created_at"],
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// lockboxRotatedVersion is a secret version added by `yandex_lockbox_secret_rotation`.
+type lockboxRotatedVersion struct {
+	ID        string
+	CreatedAt time.Time
+}
+
+type lockboxSecretRotationPolicy struct {
+	rotationPeriod time.Duration
+	maxVersions    int
+}
+
+// rotationDue reports whether a new version has to be added. versions are sorted from the newest to the oldest.
+func (p *lockboxSecretRotationPolicy) rotationDue(versions []lockboxRotatedVersion, now time.Time) bool {
+	return len(versions) == 0 || !now.Before(versions[0].CreatedAt.Add(p.rotationPeriod))
+}
+
+// excessVersions returns versions which are out of the `max_versions` newest ones.
+func (p *lockboxSecretRotationPolicy) excessVersions(versions []lockboxRotatedVersion) []lockboxRotatedVersion {
+	if len(versions) <= p.maxVersions {
+		return nil
+	}
+	return versions[p.maxVersions:]
+}
+
+func (p *lockboxSecretRotationPolicy) nextRotationAt(versions []lockboxRotatedVersion) string {
+	if len(versions) == 0 {
+		return ""
+	}
+	return versions[0].CreatedAt.Add(p.rotationPeriod).Format(defaultTimeFormat)
+}
+
+func getLockboxSecretRotationPolicy(d interface{ Get(string) interface{} }) (*lockboxSecretRotationPolicy, error) {
+	rotationPeriod, err := time.ParseDuration(d.Get("rotation_period").(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid rotation_period: %s", err)
+	}
+	return &lockboxSecretRotationPolicy{
+		rotationPeriod: rotationPeriod,
+		maxVersions:    d.Get("max_versions").(int),
+	}, nil
+}
+
+func expandLockboxRotatedVersions(v interface{}) ([]lockboxRotatedVersion, error) {
+	var versions []lockboxRotatedVersion
+	for _, raw := range v.([]interface{}) {
+		m := raw.(map[string]interface{})
+		createdAt, err := time.Parse(defaultTimeFormat, m["created_at"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid created_at of version %q: %s", m["id"], err)
+		}
+		versions = append(versions, lockboxRotatedVersion{
+			ID:        m["id"].(string),
+			CreatedAt: createdAt,
+		})
+	}
+	sortLockboxRotatedVersions(versions)
+	return versions, nil
+}
+
+func flattenLockboxRotatedVersions(versions []lockboxRotatedVersion) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(versions))
+	for _, v := range versions {
+		result = append(result, map[string]interface{}{
+			"id":         v.ID,
+			"created_at": v.CreatedAt.Format(defaultTimeFormat),
+		})
+	}
+	return result
+}
+
+func sortLockboxRotatedVersions(versions []lockboxRotatedVersion) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].CreatedAt.After(versions[j].CreatedAt)
+	})
+}
+
+func resourceYandexLockboxSecretRotationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	policy, err := getLockboxSecretRotationPolicy(d)
+	if err != nil {
+		return err
+	}
+	versions, err := expandLockboxRotatedVersions(d.Get("versions"))
+	if err != nil {
+		return err
+	}
+
+	if policy.rotationDue(versions, time.Now()) || d.HasChange("entries") {
+		log.Printf("[DEBUG] Lockbox secret rotation %q: planning a new version", d.Id())
+		for _, attr := range []string{"current_version_id", "next_rotation_at", "versions"} {
+			if err := d.SetNewComputed(attr); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if len(policy.excessVersions(versions)) > 0 {
+		if err := d.SetNewComputed("versions"); err != nil {
+			return err
+		}
+	}
+	return d.SetNew("next_rotation_at", policy.nextRotationAt(versions))
+}
+
+// addLockboxRotatedVersion adds a new version to the secret built from `entries`. The version is returned
+// with an error as well once its ID is known, so that it's kept in state and destroyed by the resource later.
+func addLockboxRotatedVersion(ctx context.Context, d *schema.ResourceData, config *Config) (lockboxRotatedVersion, error) {
+	entries, err := expandLockboxSecretVersionEntriesSlice(ctx, d)
+	if err != nil {
+		return lockboxRotatedVersion{}, err
+	}
+
+	resourceYandexLockboxSecretVersionMutex.Lock()
+	defer resourceYandexLockboxSecretVersionMutex.Unlock()
+
+	secretID := d.Get("secret_id").(string)
+	versionID, err := addLockboxSecretVersion(ctx, config, secretID, d.Get("description").(string), entries)
+	if versionID == "" {
+		return lockboxRotatedVersion{}, err
+	}
+	// The creation time is refreshed by Read, until then the time of the request is used.
+	version := lockboxRotatedVersion{ID: versionID, CreatedAt: time.Now()}
+	if err != nil {
+		return version, err
+	}
+
+	log.Printf("[INFO] added Lockbox version %q to secret %q", versionID, secretID)
+
+	// AddVersionResponse does not contain the creation time, so the version is requested separately.
+	versions, err := listLockboxSecretVersions(ctx, config, secretID)
+	if err != nil {
+		return version, err
+	}
+	v, ok := versions[versionID]
+	if !ok {
+		return version, fmt.Errorf("added version %q is not found in secret %q", versionID, secretID)
+	}
+	version.CreatedAt = v.CreatedAt.AsTime()
+	return version, nil
+}
+
+func listLockboxSecretVersions(ctx context.Context, config *Config, secretID string) (map[string]*lockbox.Version, error) {
+	versions := make(map[string]*lockbox.Version)
+	it := config.sdk.LockboxSecret().Secret().SecretVersionsIterator(ctx, &lockbox.ListVersionsRequest{SecretId: secretID})
+	for it.Next() {
+		v := it.Value()
+		versions[v.Id] = v
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+func resourceYandexLockboxSecretRotationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	version, err := addLockboxRotatedVersion(ctx, d, config)
+	if version.ID != "" {
+		d.SetId(d.Get("secret_id").(string))
+		if err := d.Set("versions", flattenLockboxRotatedVersions([]lockboxRotatedVersion{version})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceYandexLockboxSecretRotationRead(ctx, d, meta)
+}
+
+func resourceYandexLockboxSecretRotationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	policy, err := getLockboxSecretRotationPolicy(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	stateVersions, err := expandLockboxRotatedVersions(d.Get("versions"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretVersions, err := listLockboxSecretVersions(ctx, config, d.Get("secret_id").(string))
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("secret %q", d.Get("secret_id"))))
+	}
+
+	var versions []lockboxRotatedVersion
+	for _, v := range stateVersions {
+		version, ok := secretVersions[v.ID]
+		if !ok || version.Status != lockbox.Version_ACTIVE {
+			log.Printf("[WARN] Lockbox version %q of rotation %q is no longer active", v.ID, d.Id())
+			continue
+		}
+		versions = append(versions, lockboxRotatedVersion{ID: version.Id, CreatedAt: version.CreatedAt.AsTime()})
+	}
+
+	if len(versions) == 0 {
+		log.Printf("[WARN] All versions of Lockbox secret rotation %q are gone, removing it from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	sortLockboxRotatedVersions(versions)
+
+	if err := d.Set("versions", flattenLockboxRotatedVersions(versions)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("current_version_id", versions[0].ID)
+	d.Set("next_rotation_at", policy.nextRotationAt(versions))
+
+	return nil
+}
+
+func resourceYandexLockboxSecretRotationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	policy, err := getLockboxSecretRotationPolicy(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	oldVersions, _ := d.GetChange("versions")
+	versions, err := expandLockboxRotatedVersions(oldVersions)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The rotation decision is made at plan time, where `current_version_id` becomes unknown. The unknown value is read
+	// from the plan, as ResourceData returns the prior value of a computed attribute.
+	if !d.GetRawPlan().GetAttr("current_version_id").IsKnown() {
+		version, err := addLockboxRotatedVersion(ctx, d, config)
+		if version.ID != "" {
+			versions = append([]lockboxRotatedVersion{version}, versions...)
+		}
+		if err != nil {
+			if err := d.Set("versions", flattenLockboxRotatedVersions(versions)); err != nil {
+				log.Printf("[ERROR] failed set field versions: %s", err)
+			}
+			return diag.FromErr(err)
+		}
+	}
+
+	excess := policy.excessVersions(versions)
+	if err := destroyLockboxRotatedVersions(ctx, config, d.Get("secret_id").(string), excess); err != nil {
+		return diag.FromErr(err)
+	}
+	versions = versions[:len(versions)-len(excess)]
+
+	if err := d.Set("versions", flattenLockboxRotatedVersions(versions)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceYandexLockboxSecretRotationRead(ctx, d, meta)
+}
+
+func resourceYandexLockboxSecretRotationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	versions, err := expandLockboxRotatedVersions(d.Get("versions"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := destroyLockboxRotatedVersions(ctx, config, d.Get("secret_id").(string), versions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func destroyLockboxRotatedVersions(ctx context.Context, config *Config, secretID string, versions []lockboxRotatedVersion) error {
+	resourceYandexLockboxSecretVersionMutex.Lock()
+	defer resourceYandexLockboxSecretVersionMutex.Unlock()
+
+	for _, v := range versions {
+		err := scheduleLockboxSecretVersionDestruction(ctx, config, secretID, v.ID)
+		if isStatusWithCode(err, codes.NotFound) {
+			log.Printf("[DEBUG] Lockbox version %q no longer exists, skipping its destruction", v.ID)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error scheduling destruction of Lockbox version %q: %s", v.ID, err)
+		}
+		log.Printf("[INFO] scheduled destruction of Lockbox version %q", v.ID)
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers/fakecloud"
+)
+
+func TestLockboxSecretRotationPolicy(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	version := func(id string, age time.Duration) lockboxRotatedVersion {
+		return lockboxRotatedVersion{ID: id, CreatedAt: now.Add(-age)}
+	}
+
+	tests := []struct {
+		name           string
+		policy         lockboxSecretRotationPolicy
+		versions       []lockboxRotatedVersion
+		expectedRotate bool
+		expectedIDs    []string
+	}{
+		{
+			name:           "no versions",
+			policy:         lockboxSecretRotationPolicy{rotationPeriod: time.Hour, maxVersions: 1},
+			expectedRotate: true,
+		},
+		{
+			name:     "fresh version",
+			policy:   lockboxSecretRotationPolicy{rotationPeriod: 48 * time.Hour, maxVersions: 1},
+			versions: []lockboxRotatedVersion{version("new", time.Hour)},
+		},
+		{
+			name:           "rotation period elapsed",
+			policy:         lockboxSecretRotationPolicy{rotationPeriod: 48 * time.Hour, maxVersions: 2},
+			versions:       []lockboxRotatedVersion{version("new", 48*time.Hour), version("old", 96*time.Hour)},
+			expectedRotate: true,
+		},
+		{
+			name:        "more versions than allowed",
+			policy:      lockboxSecretRotationPolicy{rotationPeriod: 48 * time.Hour, maxVersions: 1},
+			versions:    []lockboxRotatedVersion{version("new", time.Hour), version("mid", 49*time.Hour), version("old", 97*time.Hour)},
+			expectedIDs: []string{"mid", "old"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expectedRotate, test.policy.rotationDue(test.versions, now))
+
+			var ids []string
+			for _, v := range test.policy.excessVersions(test.versions) {
+				ids = append(ids, v.ID)
+			}
+			assert.Equal(t, test.expectedIDs, ids)
+		})
+	}
+}
+
+func TestExpandLockboxRotatedVersions(t *testing.T) {
+	versions, err := expandLockboxRotatedVersions([]interface{}{
+		map[string]interface{}{"id": "old", "created_at": "2026-10-01T00:00:00Z"},
+		map[string]interface{}{"id": "new", "created_at": "2026-10-10T00:00:00Z"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "new", versions[0].ID)
+	assert.Equal(t, "old", versions[1].ID)
+
+	policy := lockboxSecretRotationPolicy{rotationPeriod: 24 * time.Hour}
+	assert.Equal(t, "2026-10-11T00:00:00Z", policy.nextRotationAt(versions))
+
+	_, err = expandLockboxRotatedVersions([]interface{}{
+		map[string]interface{}{"id": "bad", "created_at": "yesterday"},
+	})
+	assert.Error(t, err)
+}
+
+func TestFakeCloud_LockboxSecretRotation(t *testing.T) {
+	skipWithoutTerraform(t)
+	server := fakecloud.NewTestServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeCloudLockboxSecretRotationConfig("first", "720h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_lockbox_secret_rotation.rotation", "versions.#", "1"),
+					testAccCheckLockboxSecretRotationVersions("yandex_lockbox_secret_rotation.rotation", 1),
+				),
+			},
+			{
+				Config: testFakeCloudLockboxSecretRotationConfig("second", "720h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_lockbox_secret_rotation.rotation", "versions.#", "2"),
+					testAccCheckLockboxSecretRotationVersions("yandex_lockbox_secret_rotation.rotation", 2),
+				),
+			},
+			{
+				PreConfig:   func() { server.FailLockboxVersionOperations(true) },
+				Config:      testFakeCloudLockboxSecretRotationConfig("third", "720h"),
+				ExpectError: regexp.MustCompile("error while waiting operation to add secret version"),
+			},
+			{
+				// The version added by the failed rotation is tracked, so the oldest one is destroyed instead of it.
+				PreConfig: func() { server.FailLockboxVersionOperations(false) },
+				Config:    testFakeCloudLockboxSecretRotationConfig("third", "720h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_lockbox_secret_rotation.rotation", "versions.#", "2"),
+					testAccCheckLockboxSecretRotationVersions("yandex_lockbox_secret_rotation.rotation", 2),
+				),
+			},
+			{
+				// The rotation period has always elapsed, so a version is added on every apply.
+				Config:             testFakeCloudLockboxSecretRotationConfig("third", "1ns"),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_lockbox_secret_rotation.rotation", "versions.#", "2"),
+					testAccCheckLockboxSecretRotationVersions("yandex_lockbox_secret_rotation.rotation", 2),
+					testAccCheckLockboxSecretRotationVersionCount("yandex_lockbox_secret_rotation.rotation", 4),
+				),
+			},
+		},
+	})
+}
+
+func TestFakeCloud_LockboxSecretRotationFailedCreate(t *testing.T) {
+	skipWithoutTerraform(t)
+	server := fakecloud.NewTestServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig:   func() { server.FailLockboxVersionOperations(true) },
+				Config:      testFakeCloudLockboxSecretRotationConfig("first", "720h"),
+				ExpectError: regexp.MustCompile("error while waiting operation to add secret version"),
+			},
+			{
+				// The rotation is tainted with the version it added, which is destroyed on replacement.
+				PreConfig: func() { server.FailLockboxVersionOperations(false) },
+				Config:    testFakeCloudLockboxSecretRotationConfig("first", "720h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_lockbox_secret_rotation.rotation", "versions.#", "1"),
+					testAccCheckLockboxSecretRotationVersions("yandex_lockbox_secret_rotation.rotation", 1),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckLockboxSecretRotationVersions checks that the secret has exactly the active versions tracked
+// by the rotation, and that the newest of them is current.
+func testAccCheckLockboxSecretRotationVersions(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		secretID := rs.Primary.Attributes["secret_id"]
+
+		secret, err := config.sdk.LockboxSecret().Secret().Get(context.Background(), &lockbox.GetSecretRequest{SecretId: secretID})
+		if err != nil {
+			return err
+		}
+		if current := rs.Primary.Attributes["current_version_id"]; current != secret.GetCurrentVersion().GetId() {
+			return fmt.Errorf("current_version_id is %q, but the current version of the secret is %q", current, secret.GetCurrentVersion().GetId())
+		}
+
+		versions, err := listLockboxSecretVersions(context.Background(), config, secretID)
+		if err != nil {
+			return err
+		}
+		tracked := make(map[string]bool)
+		for i := 0; i < count; i++ {
+			tracked[rs.Primary.Attributes[fmt.Sprintf("versions.%d.id", i)]] = true
+		}
+		for id, v := range versions {
+			if v.GetStatus() == lockbox.Version_ACTIVE && !tracked[id] {
+				return fmt.Errorf("active version %q of secret %q is not tracked by the rotation", id, secretID)
+			}
+			if v.GetStatus() != lockbox.Version_ACTIVE && tracked[id] {
+				return fmt.Errorf("tracked version %q of secret %q is %s", id, secretID, v.GetStatus())
+			}
+		}
+		return nil
+	}
+}
+
+// testAccCheckLockboxSecretRotationVersionCount checks the number of versions the secret has, including destroyed ones.
+func testAccCheckLockboxSecretRotationVersionCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		versions, err := listLockboxSecretVersions(context.Background(), config, rs.Primary.Attributes["secret_id"])
+		if err != nil {
+			return err
+		}
+		if len(versions) != count {
+			return fmt.Errorf("secret has %d versions, expected %d", len(versions), count)
+		}
+		return nil
+	}
+}
+
+func testFakeCloudLockboxSecretRotationConfig(password, rotationPeriod string) string {
+	return fmt.Sprintf(`
+resource "yandex_lockbox_secret" "secret" {
+  name = "fakecloud-secret"
+}
+
+resource "yandex_lockbox_secret_rotation" "rotation" {
+  secret_id       = yandex_lockbox_secret.secret.id
+  rotation_period = "%s"
+  max_versions    = 2
+
+  entries {
+    key        = "password"
+    text_value = "%s"
+  }
+}
+`, rotationPeriod, password)
+}
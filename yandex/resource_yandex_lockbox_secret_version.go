@@ -139,11 +139,29 @@ func resourceYandexLockboxSecretVersionCreateAux(ctx context.Context, versionPay
 	resourceYandexLockboxSecretVersionMutex.Lock()
 	defer resourceYandexLockboxSecretVersionMutex.Unlock()
 
+	versionID, err := addLockboxSecretVersion(ctx, config, d.Get("secret_id").(string), d.Get("description").(string), versionPayloadEntries)
+	if versionID != "" {
+		d.SetId(versionID)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] added Lockbox version with ID: %s", d.Id())
+
+	return nil
+}
+
+// addLockboxSecretVersion adds a version with the given entries on top of the current version of the secret.
+// Entries are ignored for secrets with a payload specification, the payload is generated by Lockbox.
+// The version ID is returned as soon as it is known, even if the operation fails afterwards.
+// Callers must hold resourceYandexLockboxSecretVersionMutex.
+func addLockboxSecretVersion(ctx context.Context, config *Config, secretID, description string, versionPayloadEntries []*lockbox.PayloadEntryChange) (string, error) {
 	secret, err := config.sdk.LockboxSecret().Secret().Get(ctx, &lockbox.GetSecretRequest{
-		SecretId: d.Get("secret_id").(string),
+		SecretId: secretID,
 	})
 	if err != nil {
-		return diag.Errorf("could not get secret %v: %s", d.Get("secret_id"), err)
+		return "", fmt.Errorf("could not get secret %v: %s", secretID, err)
 	}
 
 	var currentVersionId string
@@ -156,16 +174,15 @@ func resourceYandexLockboxSecretVersionCreateAux(ctx context.Context, versionPay
 
 	if currentVersionId != "" {
 		getPayloadReq := &lockbox.GetPayloadRequest{
-			SecretId:  d.Get("secret_id").(string),
+			SecretId:  secretID,
 			VersionId: currentVersionId,
 		}
-		getPayloadReq.VersionId = currentVersionId
 
 		log.Printf("[INFO] getting Lockbox payload (to compare entries): %s", protojson.Format(getPayloadReq))
 
 		payload, err := config.sdk.LockboxPayload().Payload().Get(ctx, getPayloadReq)
 		if err != nil {
-			return diag.Errorf("could not get payload from secret %v and version %v: %s", getPayloadReq.SecretId, getPayloadReq.VersionId, err)
+			return "", fmt.Errorf("could not get payload from secret %v and version %v: %s", getPayloadReq.SecretId, getPayloadReq.VersionId, err)
 		}
 
 		log.Printf("[INFO] read Lockbox payload (to compare entries) with VersionID: %s", payload.GetVersionId())
@@ -174,10 +191,10 @@ func resourceYandexLockboxSecretVersionCreateAux(ctx context.Context, versionPay
 	}
 
 	req := &lockbox.AddVersionRequest{
-		SecretId: d.Get("secret_id").(string),
+		SecretId: secretID,
 		// Make sure we're taking this version as reference, since addEntryChangesForRemovedKeys will
 		// remove the entries in payload.Entries that versionPayloadEntries doesn't contain anymore.
-		Description: d.Get("description").(string),
+		Description: description,
 	}
 	if currentVersionId != "" {
 		req.BaseVersionId = currentVersionId
@@ -194,33 +211,29 @@ func resourceYandexLockboxSecretVersionCreateAux(ctx context.Context, versionPay
 
 	op, err := config.sdk.WrapOperation(config.sdk.LockboxSecret().Secret().AddVersion(ctx, req))
 	if err != nil {
-		return diag.Errorf("error while requesting API to add version: %s", err)
+		return "", fmt.Errorf("error while requesting API to add version: %s", err)
 	}
 
 	protoMetadata, err := op.Metadata()
 	if err != nil {
-		return diag.Errorf("error while getting operation metadata of add secret version: %s", err)
+		return "", fmt.Errorf("error while getting operation metadata of add secret version: %s", err)
 	}
 
 	md, ok := protoMetadata.(*lockbox.AddVersionMetadata)
 	if !ok {
-		return diag.Errorf("could not get Secret ID from create operation metadata")
+		return "", fmt.Errorf("could not get Secret ID from create operation metadata")
 	}
 
-	d.SetId(md.VersionId)
-
 	err = op.Wait(ctx)
 	if err != nil {
-		return diag.Errorf("error while waiting operation to add secret version: %s", err)
+		return md.VersionId, fmt.Errorf("error while waiting operation to add secret version: %s", err)
 	}
 
 	if _, err := op.Response(); err != nil {
-		return diag.Errorf("add secret version failed: %s", err)
+		return md.VersionId, fmt.Errorf("add secret version failed: %s", err)
 	}
 
-	log.Printf("[INFO] added Lockbox version with ID: %s", d.Id())
-
-	return nil
+	return md.VersionId, nil
 }
 
 func resourceYandexLockboxSecretVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -250,25 +263,33 @@ func resourceYandexLockboxSecretVersionDelete(ctx context.Context, d *schema.Res
 
 	config := meta.(*Config)
 
+	if err := scheduleLockboxSecretVersionDestruction(ctx, config, d.Get("secret_id").(string), d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] successfully scheduled destruction of Lockbox version with ID: %s", d.Id())
+
+	return nil
+}
+
+func scheduleLockboxSecretVersionDestruction(ctx context.Context, config *Config, secretID, versionID string) error {
 	req := &lockbox.ScheduleVersionDestructionRequest{
-		SecretId:  d.Get("secret_id").(string),
-		VersionId: d.Id(),
+		SecretId:  secretID,
+		VersionId: versionID,
 	}
 
 	log.Printf("[INFO] scheduling destruction of Lockbox version: %s", protojson.Format(req))
 
 	op, err := config.sdk.WrapOperation(config.sdk.LockboxSecret().Secret().ScheduleVersionDestruction(ctx, req))
 	if err != nil {
-		return diag.Errorf("error while requesting API to destroy version: %s", err)
+		return fmt.Errorf("error while requesting API to destroy version: %w", err)
 	}
 
 	err = op.Wait(ctx)
 	if err != nil {
-		return diag.Errorf("error while waiting operation to destroy version: %s", err)
+		return fmt.Errorf("error while waiting operation to destroy version: %w", err)
 	}
 
-	log.Printf("[INFO] successfully scheduled destruction of Lockbox version with ID: %s", d.Id())
-
 	return nil
 }
 
@@ -0,0 +1,380 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/logging/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+const yandexLoggingExportDefaultTimeout = 30 * time.Second
+
+func resourceYandexLoggingExport() *schema.Resource {
+	return &schema.Resource{
+		Description: "Yandex Cloud Logging export resource. Export describes which log entries of the log group are exported to the sink. For more information, see [the official documentation](https://yandex.cloud/docs/logging/operations/export-logs).",
+
+		Create: resourceYandexLoggingExportCreate,
+		Read:   resourceYandexLoggingExportRead,
+		Update: resourceYandexLoggingExportUpdate,
+		Delete: performYandexLoggingExportDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(yandexLoggingExportDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["name"],
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"folder_id": {
+				Type:         schema.TypeString,
+				Description:  common.ResourceDescriptions["folder_id"],
+				Computed:     true,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["description"],
+				Optional:    true,
+			},
+
+			"labels": {
+				Type:        schema.TypeMap,
+				Description: common.ResourceDescriptions["labels"],
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+
+			"group_id": {
+				Type:         schema.TypeString,
+				Description:  "ID of the [Cloud Logging Group](https://yandex.cloud/docs/logging/concepts/log-group) to export log entries from.",
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"sink_id": {
+				Type:         schema.TypeString,
+				Description:  "ID of the `yandex_logging_sink` to export log entries to.",
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"params": {
+				Type:        schema.TypeList,
+				Description: "Parameters describing which log entries are exported.",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_types": {
+							Type:        schema.TypeList,
+							Description: "List of resource types to export log entries of.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_ids": {
+							Type:        schema.TypeList,
+							Description: "List of resource IDs to export log entries of.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"stream_names": {
+							Type:        schema.TypeList,
+							Description: "List of stream names to export log entries of.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"levels": {
+							Type:        schema.TypeList,
+							Description: "List of log levels to export. Values: `TRACE`, `DEBUG`, `INFO`, `WARN`, `ERROR`, `FATAL`.",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(loggingExportLevels(), false),
+							},
+						},
+						"filter": {
+							Type:        schema.TypeString,
+							Description: "[Filter expression](https://yandex.cloud/docs/logging/concepts/filter) for the exported log entries.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+
+			"cloud_id": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["cloud_id"],
+				Computed:    true,
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["created_at"],
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceYandexLoggingExportCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	folderID, err := getFolderID(d, config)
+	if err != nil {
+		return fmt.Errorf("error getting folder ID while creating log export: %s", err)
+	}
+
+	labels, err := expandLabels(d.Get("labels"))
+	if err != nil {
+		return fmt.Errorf("error expanding labels while creating log export: %s", err)
+	}
+
+	params, err := expandYandexLoggingExportParams(d)
+	if err != nil {
+		return fmt.Errorf("error expanding params while creating log export: %s", err)
+	}
+
+	req := logging.CreateExportRequest{
+		FolderId:    folderID,
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Labels:      labels,
+		GroupId:     d.Get("group_id").(string),
+		SinkId:      d.Get("sink_id").(string),
+		Params:      params,
+	}
+
+	if err := performYandexLoggingExportCreate(d, config, &req); err != nil {
+		return err
+	}
+
+	return resourceYandexLoggingExportRead(d, meta)
+}
+
+func performYandexLoggingExportCreate(d *schema.ResourceData, config *Config, req *logging.CreateExportRequest) error {
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	op, err := config.sdk.WrapOperation(config.sdk.Logging().Export().Create(ctx, req))
+	if err != nil {
+		return fmt.Errorf("error while requesting API to create log export: %s", err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return fmt.Errorf("error while get log export create operation metadata: %s", err)
+	}
+
+	md, ok := protoMetadata.(*logging.CreateExportMetadata)
+	if !ok {
+		return fmt.Errorf("could not get log export ID from create operation metadata")
+	}
+
+	d.SetId(md.ExportId)
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while waiting operation to create log export: %s", err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("log export creation failed: %s", err)
+	}
+	return nil
+}
+
+func resourceYandexLoggingExportUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	req := logging.UpdateExportRequest{
+		ExportId:   d.Id(),
+		UpdateMask: &field_mask.FieldMask{},
+	}
+
+	if err := performYandexLoggingExportUpdate(d, config, &req); err != nil {
+		return err
+	}
+
+	return resourceYandexLoggingExportRead(d, meta)
+}
+
+func performYandexLoggingExportUpdate(d *schema.ResourceData, config *Config, req *logging.UpdateExportRequest) error {
+	d.Partial(true)
+
+	if d.HasChange("name") {
+		req.Name = d.Get("name").(string)
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "name")
+	}
+
+	if d.HasChange("description") {
+		req.Description = d.Get("description").(string)
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "description")
+	}
+
+	if d.HasChange("labels") {
+		labelsProp, err := expandLabels(d.Get("labels"))
+		if err != nil {
+			return err
+		}
+
+		req.Labels = labelsProp
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "labels")
+	}
+
+	if d.HasChange("group_id") {
+		req.GroupId = d.Get("group_id").(string)
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "group_id")
+	}
+
+	if d.HasChange("sink_id") {
+		req.SinkId = d.Get("sink_id").(string)
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "sink_id")
+	}
+
+	if d.HasChange("params") {
+		params, err := expandYandexLoggingExportParams(d)
+		if err != nil {
+			return fmt.Errorf("error expanding params while updating log export: %s", err)
+		}
+		req.Params = params
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "params")
+	}
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	op, err := config.sdk.WrapOperation(config.sdk.Logging().Export().Update(ctx, req))
+	if err != nil {
+		return fmt.Errorf("error while requesting API to update log export: %s", err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error updating log export %q: %s", d.Id(), err)
+	}
+
+	d.Partial(false)
+
+	return nil
+}
+
+func performYandexLoggingExportDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	op, err := config.sdk.Logging().Export().Delete(ctx, &logging.DeleteExportRequest{ExportId: d.Id()})
+	err = waitOperation(ctx, config, op, err)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Logging export %q", d.Id()))
+	}
+
+	return nil
+}
+
+func resourceYandexLoggingExportRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	export, err := config.sdk.Logging().Export().Get(ctx, &logging.GetExportRequest{ExportId: d.Id()})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Logging export %q", d.Get("name").(string)))
+	}
+
+	return flattenYandexLoggingExport(d, export)
+}
+
+func loggingExportLevels() []string {
+	levels := make([]string, 0, len(logging.LogLevel_Level_value))
+	for name, value := range logging.LogLevel_Level_value {
+		if value != int32(logging.LogLevel_LEVEL_UNSPECIFIED) {
+			levels = append(levels, name)
+		}
+	}
+	return levels
+}
+
+func expandYandexLoggingExportParams(d *schema.ResourceData) (*logging.ExportParams, error) {
+	if d.Get("params.#").(int) == 0 {
+		return nil, nil
+	}
+
+	params := &logging.ExportParams{
+		ResourceTypes: expandStringSlice(d.Get("params.0.resource_types").([]interface{})),
+		ResourceIds:   expandStringSlice(d.Get("params.0.resource_ids").([]interface{})),
+		StreamNames:   expandStringSlice(d.Get("params.0.stream_names").([]interface{})),
+		Filter:        d.Get("params.0.filter").(string),
+	}
+
+	for _, v := range d.Get("params.0.levels").([]interface{}) {
+		level, ok := logging.LogLevel_Level_value[v.(string)]
+		if !ok {
+			return nil, fmt.Errorf("unknown log level %q", v)
+		}
+		params.Levels = append(params.Levels, logging.LogLevel_Level(level))
+	}
+
+	return params, nil
+}
+
+func flattenYandexLoggingExportParams(params *logging.ExportParams) []interface{} {
+	if params == nil {
+		return nil
+	}
+
+	levels := make([]string, 0, len(params.Levels))
+	for _, level := range params.Levels {
+		levels = append(levels, level.String())
+	}
+
+	return []interface{}{map[string]interface{}{
+		"resource_types": params.ResourceTypes,
+		"resource_ids":   params.ResourceIds,
+		"stream_names":   params.StreamNames,
+		"levels":         levels,
+		"filter":         params.Filter,
+	}}
+}
+
+func flattenYandexLoggingExport(d *schema.ResourceData, export *logging.Export) error {
+	if export == nil {
+		return nil
+	}
+	d.Set("name", export.Name)
+	d.Set("folder_id", export.FolderId)
+	d.Set("description", export.Description)
+	d.Set("group_id", export.GroupId)
+	d.Set("sink_id", export.SinkId)
+	d.Set("cloud_id", export.CloudId)
+	d.Set("created_at", getTimestamp(export.CreatedAt))
+
+	if err := d.Set("params", flattenYandexLoggingExportParams(export.Params)); err != nil {
+		return err
+	}
+
+	return d.Set("labels", export.Labels)
+}
@@ -13,12 +13,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/logging/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const yandexLoggingGroupResource = "yandex_logging_group.test-logging-group"
 
 func init() {
-	resource.AddTestSweepers("yandex_logging_group", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_logging_group",
 		F:    testSweepYandexLoggingGroup,
 	})
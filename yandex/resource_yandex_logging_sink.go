@@ -0,0 +1,342 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/logging/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+const yandexLoggingSinkDefaultTimeout = 30 * time.Second
+
+var loggingSinkDestinations = []string{"yds", "s3"}
+
+func resourceYandexLoggingSink() *schema.Resource {
+	return &schema.Resource{
+		Description: "Yandex Cloud Logging sink resource. Sink is a destination where log entries of the log groups are exported to. For more information, see [the official documentation](https://yandex.cloud/docs/logging/concepts/sink).",
+
+		Create: resourceYandexLoggingSinkCreate,
+		Read:   resourceYandexLoggingSinkRead,
+		Update: resourceYandexLoggingSinkUpdate,
+		Delete: performYandexLoggingSinkDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(yandexLoggingSinkDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["name"],
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"folder_id": {
+				Type:         schema.TypeString,
+				Description:  common.ResourceDescriptions["folder_id"],
+				Computed:     true,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["description"],
+				Optional:    true,
+			},
+
+			"labels": {
+				Type:        schema.TypeMap,
+				Description: common.ResourceDescriptions["labels"],
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+
+			"service_account_id": {
+				Type:         schema.TypeString,
+				Description:  "ID of the service account which is used to write log entries to the destination.",
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"yds": {
+				Type:         schema.TypeList,
+				Description:  "Structure describing destination [Data Stream](https://yandex.cloud/docs/data-streams/concepts/glossary#stream-concepts) of the sink. Mutually exclusive with `s3`.",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: loggingSinkDestinations,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"stream_name": {
+							Type:        schema.TypeString,
+							Description: "Full name of the destination stream, e.g. `/ru-central1/aoegtvhtp8ob********/cc8004q4lbo6********/test`.",
+							Required:    true,
+						},
+					},
+				},
+			},
+
+			"s3": {
+				Type:         schema.TypeList,
+				Description:  "Structure describing destination bucket of the sink. Mutually exclusive with `yds`.",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: loggingSinkDestinations,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:        schema.TypeString,
+							Description: "Name of the [destination bucket](https://yandex.cloud/docs/storage/concepts/bucket).",
+							Required:    true,
+						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Description: "Prefix of the uploaded objects.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+
+			"cloud_id": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["cloud_id"],
+				Computed:    true,
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["created_at"],
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceYandexLoggingSinkCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	folderID, err := getFolderID(d, config)
+	if err != nil {
+		return fmt.Errorf("error getting folder ID while creating log sink: %s", err)
+	}
+
+	labels, err := expandLabels(d.Get("labels"))
+	if err != nil {
+		return fmt.Errorf("error expanding labels while creating log sink: %s", err)
+	}
+
+	req := logging.CreateSinkRequest{
+		FolderId:         folderID,
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		Labels:           labels,
+		ServiceAccountId: d.Get("service_account_id").(string),
+	}
+
+	switch {
+	case d.Get("yds.#").(int) > 0:
+		req.Sink = &logging.CreateSinkRequest_Yds{Yds: expandYandexLoggingSinkYds(d)}
+	case d.Get("s3.#").(int) > 0:
+		req.Sink = &logging.CreateSinkRequest_S3{S3: expandYandexLoggingSinkS3(d)}
+	}
+
+	if err := performYandexLoggingSinkCreate(d, config, &req); err != nil {
+		return err
+	}
+
+	return resourceYandexLoggingSinkRead(d, meta)
+}
+
+func performYandexLoggingSinkCreate(d *schema.ResourceData, config *Config, req *logging.CreateSinkRequest) error {
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	op, err := config.sdk.WrapOperation(config.sdk.Logging().Sink().Create(ctx, req))
+	if err != nil {
+		return fmt.Errorf("error while requesting API to create log sink: %s", err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return fmt.Errorf("error while get log sink create operation metadata: %s", err)
+	}
+
+	md, ok := protoMetadata.(*logging.CreateSinkMetadata)
+	if !ok {
+		return fmt.Errorf("could not get log sink ID from create operation metadata")
+	}
+
+	d.SetId(md.SinkId)
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while waiting operation to create log sink: %s", err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("log sink creation failed: %s", err)
+	}
+	return nil
+}
+
+func resourceYandexLoggingSinkUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	req := logging.UpdateSinkRequest{
+		SinkId:     d.Id(),
+		UpdateMask: &field_mask.FieldMask{},
+	}
+
+	if err := performYandexLoggingSinkUpdate(d, config, &req); err != nil {
+		return err
+	}
+
+	return resourceYandexLoggingSinkRead(d, meta)
+}
+
+func performYandexLoggingSinkUpdate(d *schema.ResourceData, config *Config, req *logging.UpdateSinkRequest) error {
+	d.Partial(true)
+
+	if d.HasChange("name") {
+		req.Name = d.Get("name").(string)
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "name")
+	}
+
+	if d.HasChange("description") {
+		req.Description = d.Get("description").(string)
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "description")
+	}
+
+	if d.HasChange("labels") {
+		labelsProp, err := expandLabels(d.Get("labels"))
+		if err != nil {
+			return err
+		}
+
+		req.Labels = labelsProp
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "labels")
+	}
+
+	if d.HasChange("service_account_id") {
+		req.ServiceAccountId = d.Get("service_account_id").(string)
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "service_account_id")
+	}
+
+	if d.HasChanges("yds", "s3") {
+		switch {
+		case d.Get("yds.#").(int) > 0:
+			req.Sink = &logging.UpdateSinkRequest_Yds{Yds: expandYandexLoggingSinkYds(d)}
+			req.UpdateMask.Paths = append(req.UpdateMask.Paths, "yds")
+		case d.Get("s3.#").(int) > 0:
+			req.Sink = &logging.UpdateSinkRequest_S3{S3: expandYandexLoggingSinkS3(d)}
+			req.UpdateMask.Paths = append(req.UpdateMask.Paths, "s3")
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	op, err := config.sdk.WrapOperation(config.sdk.Logging().Sink().Update(ctx, req))
+	if err != nil {
+		return fmt.Errorf("error while requesting API to update log sink: %s", err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error updating log sink %q: %s", d.Id(), err)
+	}
+
+	d.Partial(false)
+
+	return nil
+}
+
+func performYandexLoggingSinkDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	op, err := config.sdk.Logging().Sink().Delete(ctx, &logging.DeleteSinkRequest{SinkId: d.Id()})
+	err = waitOperation(ctx, config, op, err)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Logging sink %q", d.Id()))
+	}
+
+	return nil
+}
+
+func resourceYandexLoggingSinkRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	sink, err := config.sdk.Logging().Sink().Get(ctx, &logging.GetSinkRequest{SinkId: d.Id()})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Yandex Cloud Logging sink %q", d.Get("name").(string)))
+	}
+
+	return flattenYandexLoggingSink(d, sink)
+}
+
+func expandYandexLoggingSinkYds(d *schema.ResourceData) *logging.Sink_Yds {
+	return &logging.Sink_Yds{
+		StreamName: d.Get("yds.0.stream_name").(string),
+	}
+}
+
+func expandYandexLoggingSinkS3(d *schema.ResourceData) *logging.Sink_S3 {
+	return &logging.Sink_S3{
+		Bucket: d.Get("s3.0.bucket").(string),
+		Prefix: d.Get("s3.0.prefix").(string),
+	}
+}
+
+func flattenYandexLoggingSink(d *schema.ResourceData, sink *logging.Sink) error {
+	if sink == nil {
+		return nil
+	}
+	d.Set("name", sink.Name)
+	d.Set("folder_id", sink.FolderId)
+	d.Set("description", sink.Description)
+	d.Set("service_account_id", sink.ServiceAccountId)
+	d.Set("cloud_id", sink.CloudId)
+	d.Set("created_at", getTimestamp(sink.CreatedAt))
+
+	var yds, s3 []interface{}
+	if v := sink.GetYds(); v != nil {
+		yds = []interface{}{map[string]interface{}{
+			"stream_name": v.StreamName,
+		}}
+	}
+	if v := sink.GetS3(); v != nil {
+		s3 = []interface{}{map[string]interface{}{
+			"bucket": v.Bucket,
+			"prefix": v.Prefix,
+		}}
+	}
+	if err := d.Set("yds", yds); err != nil {
+		return err
+	}
+	if err := d.Set("s3", s3); err != nil {
+		return err
+	}
+
+	return d.Set("labels", sink.Labels)
+}
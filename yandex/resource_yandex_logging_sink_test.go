@@ -0,0 +1,264 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/logging/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
+)
+
+const (
+	yandexLoggingSinkResource   = "yandex_logging_sink.test-logging-sink"
+	yandexLoggingExportResource = "yandex_logging_export.test-logging-export"
+)
+
+func init() {
+	sweeper.Add(&sweeper.Sweeper{
+		Name: "yandex_logging_export",
+		F:    testSweepYandexLoggingExport,
+	})
+	sweeper.Add(&sweeper.Sweeper{
+		Name:         "yandex_logging_sink",
+		F:            testSweepYandexLoggingSink,
+		Dependencies: []string{"yandex_logging_export"},
+	})
+}
+
+func testSweepYandexLoggingSink(_ string) error {
+	conf, err := configForSweepers()
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	resp, err := conf.sdk.Logging().Sink().List(conf.Context(), &logging.ListSinksRequest{
+		FolderId: conf.FolderID,
+		PageSize: 1000,
+	})
+	if err != nil {
+		return fmt.Errorf("error getting log sinks: %s", err)
+	}
+
+	result := &multierror.Error{}
+	for _, s := range resp.Sinks {
+		if !sweepWithRetry(sweepYandexLoggingSinkOnce, conf, "Yandex Cloud Logging sink", s.Id) {
+			result = multierror.Append(result, fmt.Errorf("failed to sweep Yandex Cloud Logging sink %q", s.Id))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func sweepYandexLoggingSinkOnce(conf *Config, id string) error {
+	ctx, cancel := conf.ContextWithTimeout(yandexLoggingSinkDefaultTimeout)
+	defer cancel()
+
+	op, err := conf.sdk.Logging().Sink().Delete(ctx, &logging.DeleteSinkRequest{
+		SinkId: id,
+	})
+	return handleSweepOperation(ctx, conf, op, err)
+}
+
+func testSweepYandexLoggingExport(_ string) error {
+	conf, err := configForSweepers()
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	resp, err := conf.sdk.Logging().Export().List(conf.Context(), &logging.ListExportsRequest{
+		FolderId: conf.FolderID,
+		PageSize: 1000,
+	})
+	if err != nil {
+		return fmt.Errorf("error getting log exports: %s", err)
+	}
+
+	result := &multierror.Error{}
+	for _, e := range resp.Exports {
+		if !sweepWithRetry(sweepYandexLoggingExportOnce, conf, "Yandex Cloud Logging export", e.Id) {
+			result = multierror.Append(result, fmt.Errorf("failed to sweep Yandex Cloud Logging export %q", e.Id))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func sweepYandexLoggingExportOnce(conf *Config, id string) error {
+	ctx, cancel := conf.ContextWithTimeout(yandexLoggingExportDefaultTimeout)
+	defer cancel()
+
+	op, err := conf.sdk.Logging().Export().Delete(ctx, &logging.DeleteExportRequest{
+		ExportId: id,
+	})
+	return handleSweepOperation(ctx, conf, op, err)
+}
+
+func TestAccYandexLoggingSink_s3Export(t *testing.T) {
+	saName := acctest.RandomWithPrefix("tf-logging-sink-sa")
+	bucketName := acctest.RandomWithPrefix("tf-logging-sink-bucket")
+	groupName := acctest.RandomWithPrefix("tf-logging-sink-group")
+	sinkName := acctest.RandomWithPrefix("tf-logging-sink")
+	exportName := acctest.RandomWithPrefix("tf-logging-export")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testYandexLoggingSinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testYandexLoggingSinkS3Export(saName, bucketName, groupName, sinkName, exportName, "prefix-1", "ERROR"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(yandexLoggingSinkResource, "name", sinkName),
+					resource.TestCheckResourceAttr(yandexLoggingSinkResource, "s3.0.bucket", bucketName),
+					resource.TestCheckResourceAttr(yandexLoggingSinkResource, "s3.0.prefix", "prefix-1"),
+					resource.TestCheckResourceAttr(yandexLoggingSinkResource, "yds.#", "0"),
+					resource.TestCheckResourceAttrSet(yandexLoggingSinkResource, "service_account_id"),
+					testAccCheckCreatedAtAttr(yandexLoggingSinkResource),
+					resource.TestCheckResourceAttr(yandexLoggingExportResource, "name", exportName),
+					resource.TestCheckResourceAttrPair(yandexLoggingExportResource, "sink_id", yandexLoggingSinkResource, "id"),
+					resource.TestCheckResourceAttr(yandexLoggingExportResource, "params.0.levels.0", "ERROR"),
+					resource.TestCheckResourceAttr(yandexLoggingExportResource, "params.0.filter", "json_payload.status >= 500"),
+					testAccCheckCreatedAtAttr(yandexLoggingExportResource),
+				),
+			},
+			{
+				Config: testYandexLoggingSinkS3Export(saName, bucketName, groupName, sinkName, exportName, "prefix-2", "WARN"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(yandexLoggingSinkResource, "s3.0.prefix", "prefix-2"),
+					resource.TestCheckResourceAttr(yandexLoggingExportResource, "params.0.levels.0", "WARN"),
+				),
+			},
+			{
+				ResourceName:      yandexLoggingSinkResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      yandexLoggingExportResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestYandexLoggingSinkFlatten(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceYandexLoggingSink().Schema, map[string]interface{}{
+		"yds": []interface{}{
+			map[string]interface{}{"stream_name": "/ru-central1/cloud/db/stream"},
+		},
+	})
+
+	err := flattenYandexLoggingSink(d, &logging.Sink{
+		Name:             "sink",
+		ServiceAccountId: "sa1",
+		Sink: &logging.Sink_S3_{S3: &logging.Sink_S3{
+			Bucket: "bucket",
+			Prefix: "logs/",
+		}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, d.Get("yds.#"))
+	require.Equal(t, "bucket", d.Get("s3.0.bucket"))
+	require.Equal(t, "logs/", d.Get("s3.0.prefix"))
+	require.Equal(t, "sa1", d.Get("service_account_id"))
+}
+
+func TestYandexLoggingExportParams(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceYandexLoggingExport().Schema, map[string]interface{}{
+		"group_id": "group1",
+		"sink_id":  "sink1",
+		"params": []interface{}{
+			map[string]interface{}{
+				"resource_types": []interface{}{"serverless.function"},
+				"levels":         []interface{}{"WARN", "ERROR"},
+				"filter":         "json_payload.status >= 500",
+			},
+		},
+	})
+
+	params, err := expandYandexLoggingExportParams(d)
+	require.NoError(t, err)
+	require.Equal(t, []string{"serverless.function"}, params.ResourceTypes)
+	require.Equal(t, []logging.LogLevel_Level{logging.LogLevel_WARN, logging.LogLevel_ERROR}, params.Levels)
+	require.Equal(t, "json_payload.status >= 500", params.Filter)
+
+	flattened := flattenYandexLoggingExportParams(params)
+	require.Equal(t, []string{"WARN", "ERROR"}, flattened[0].(map[string]interface{})["levels"])
+}
+
+func testYandexLoggingSinkDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		var err error
+		switch rs.Type {
+		case "yandex_logging_sink":
+			_, err = config.sdk.Logging().Sink().Get(context.Background(), &logging.GetSinkRequest{SinkId: rs.Primary.ID})
+		case "yandex_logging_export":
+			_, err = config.sdk.Logging().Export().Get(context.Background(), &logging.GetExportRequest{ExportId: rs.Primary.ID})
+		default:
+			continue
+		}
+		if err == nil {
+			return fmt.Errorf("%s %s still exists", rs.Type, rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testYandexLoggingSinkS3Export(saName, bucketName, groupName, sinkName, exportName, prefix, level string) string {
+	return fmt.Sprintf(`
+resource "yandex_iam_service_account" "test-sa" {
+  name = "%[1]s"
+}
+
+resource "yandex_resourcemanager_folder_iam_member" "test-sa-uploader" {
+  folder_id = "%[8]s"
+  role      = "storage.uploader"
+  member    = "serviceAccount:${yandex_iam_service_account.test-sa.id}"
+}
+
+resource "yandex_storage_bucket" "test-bucket" {
+  bucket    = "%[2]s"
+  folder_id = "%[8]s"
+}
+
+resource "yandex_logging_group" "test-logging-group" {
+  name = "%[3]s"
+}
+
+resource "yandex_logging_sink" "test-logging-sink" {
+  name               = "%[4]s"
+  service_account_id = yandex_iam_service_account.test-sa.id
+
+  s3 {
+    bucket = yandex_storage_bucket.test-bucket.bucket
+    prefix = "%[6]s"
+  }
+
+  depends_on = [yandex_resourcemanager_folder_iam_member.test-sa-uploader]
+}
+
+resource "yandex_logging_export" "test-logging-export" {
+  name     = "%[5]s"
+  group_id = yandex_logging_group.test-logging-group.id
+  sink_id  = yandex_logging_sink.test-logging-sink.id
+
+  params {
+    levels = ["%[7]s"]
+    filter = "json_payload.status >= 500"
+  }
+}
+`, saName, bucketName, groupName, sinkName, exportName, prefix, level, getExampleFolderID())
+}
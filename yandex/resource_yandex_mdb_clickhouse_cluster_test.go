@@ -22,6 +22,8 @@ import (
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
 	cfg "github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1/config"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const chVersion = "24.8"
@@ -49,7 +51,7 @@ func getStorageEndpointUrl() string {
 }
 
 func init() {
-	resource.AddTestSweepers("yandex_mdb_clickhouse_cluster", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_mdb_clickhouse_cluster",
 		F:    testSweepMDBClickHouseCluster,
 	})
@@ -17,12 +17,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/elasticsearch/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const elasticsearchResource = "yandex_mdb_elasticsearch_cluster.foo"
 
 func init() {
-	resource.AddTestSweepers("yandex_mdb_elasticsearch_cluster", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_mdb_elasticsearch_cluster",
 		F:    testSweepMDBElasticsearchCluster,
 	})
@@ -14,6 +14,8 @@ import (
 	"google.golang.org/genproto/protobuf/field_mask"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const greenplumResource = "yandex_mdb_greenplum_cluster.foo"
@@ -30,7 +32,7 @@ func buildGreenplumSAName(greenplumName string) string {
 }
 
 func init() {
-	resource.AddTestSweepers("yandex_mdb_greenplum_cluster", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_mdb_greenplum_cluster",
 		F:    testSweepMDBGreenplumCluster,
 	})
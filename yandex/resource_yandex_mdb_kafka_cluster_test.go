@@ -23,6 +23,7 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex/mocks"
 )
 
@@ -55,7 +56,7 @@ resource "yandex_vpc_subnet" "mdb-kafka-test-subnet-d" {
 var Versions3x = []string{"3.0", "3.1", "3.2", "3.3", "3.4", "3.5", "3.6"}
 
 func init() {
-	resource.AddTestSweepers("yandex_mdb_kafka_cluster", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_mdb_kafka_cluster",
 		F:    testSweepMDBKafkaCluster,
 	})
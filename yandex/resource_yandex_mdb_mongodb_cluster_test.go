@@ -21,6 +21,8 @@ import (
 	"golang.org/x/exp/maps"
 	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const mongodbRestoreBackupId = "c9qvb4o0gnrh8ene82l7:c9qhh0gi4hn06qkdoqke"
@@ -507,7 +509,7 @@ var shardedMongoCfgHosts = []mongodb.Host{
 }
 
 func init() {
-	resource.AddTestSweepers("yandex_mdb_mongodb_cluster", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_mdb_mongodb_cluster",
 		F:    testSweepMDBMongoDBCluster,
 	})
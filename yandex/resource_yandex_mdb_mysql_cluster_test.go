@@ -16,12 +16,14 @@ import (
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mysql/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const mysqlResource = "yandex_mdb_mysql_cluster.foo"
 
 func init() {
-	resource.AddTestSweepers("yandex_mdb_mysql_cluster", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_mdb_mysql_cluster",
 		F:    testSweepMDBMySQLCluster,
 	})
@@ -17,6 +17,8 @@ import (
 	"google.golang.org/genproto/protobuf/field_mask"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const (
@@ -27,7 +29,7 @@ const (
 var postgresql_versions = [...]string{"13", "13-1c", "14", "14-1c", "15", "15-1c", "16", "16-1c", "17", "17-1c"}
 
 func init() {
-	resource.AddTestSweepers("yandex_mdb_postgresql_cluster", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_mdb_postgresql_cluster",
 		F:    testSweepMDBPostgreSQLCluster,
 	})
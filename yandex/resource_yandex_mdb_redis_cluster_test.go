@@ -15,13 +15,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/redis/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const redisResource = "yandex_mdb_redis_cluster.foo"
 const redisResourceSharded = "yandex_mdb_redis_cluster.bar"
 
 func init() {
-	resource.AddTestSweepers("yandex_mdb_redis_cluster", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_mdb_redis_cluster",
 		F:    testSweepMDBRedisCluster,
 	})
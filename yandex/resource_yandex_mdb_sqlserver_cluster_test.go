@@ -15,12 +15,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/sqlserver/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const sqlserverResource = "yandex_mdb_sqlserver_cluster.foo"
 
 func init() {
-	resource.AddTestSweepers("yandex_mdb_sqlserver_cluster", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_mdb_sqlserver_cluster",
 		F:    testSweepMDBSQLServerCluster,
 	})
@@ -0,0 +1,456 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/monitoring/alerting"
+)
+
+const yandexMonitoringAlertDefaultTimeout = 2 * time.Minute
+
+var (
+	monitoringAlertEvaluations    = []string{"AVG", "MIN", "MAX", "LAST", "SUM", "COUNT"}
+	monitoringAlertPredicates     = []string{"GT", "GTE", "LT", "LTE", "EQ", "NE"}
+	monitoringAlertNoDataPolicy   = []string{"DEFAULT", "OK", "WARN", "ALARM", "NO_DATA", "PRESERVE_LAST"}
+	monitoringAlertNotifyStatuses = []string{"OK", "WARN", "ALARM", "NO_DATA", "ERROR"}
+)
+
+func resourceYandexMonitoringAlert() *schema.Resource {
+	return &schema.Resource{
+		Description: "Allows management of a Yandex Monitoring alert.",
+
+		CreateContext: resourceMonitoringAlertCreate,
+		ReadContext:   resourceMonitoringAlertRead,
+		UpdateContext: resourceMonitoringAlertUpdate,
+		DeleteContext: resourceMonitoringAlertDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				d.Set("alert_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMonitoringAlertDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexMonitoringAlertDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexMonitoringAlertDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexMonitoringAlertDefaultTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"alert_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Alert ID.",
+			},
+			"folder_id": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["folder_id"],
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["name"],
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["description"],
+				Optional:    true,
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Description: common.ResourceDescriptions["labels"],
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Optional:    true,
+			},
+			"annotations": {
+				Type:        schema.TypeMap,
+				Description: "Annotations attached to notifications. Values may use templates like `{{alert.name}}`.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+			},
+			"window": {
+				Type:             schema.TypeString,
+				Description:      "Evaluation window, e.g. `5m`.",
+				Required:         true,
+				ValidateFunc:     validateParsableValue(parsePositiveDuration),
+				DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
+			},
+			"delay": {
+				Type:             schema.TypeString,
+				Description:      "Evaluation delay, e.g. `30s`.",
+				Optional:         true,
+				ValidateFunc:     validateParsableValue(parseDuration),
+				DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
+			},
+			"threshold": {
+				Type:         schema.TypeList,
+				Description:  "Threshold alert. Oneof: threshold, expression.",
+				MaxItems:     1,
+				Optional:     true,
+				ExactlyOneOf: []string{"threshold", "expression"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"query": {
+							Type:        schema.TypeString,
+							Description: "Metrics query.",
+							Required:    true,
+						},
+						"evaluation": {
+							Type:         schema.TypeString,
+							Description:  "Aggregation of points within the window. Values: `AVG`, `MIN`, `MAX`, `LAST`, `SUM`, `COUNT`.",
+							Optional:     true,
+							Default:      "AVG",
+							ValidateFunc: validation.StringInSlice(monitoringAlertEvaluations, false),
+						},
+						"predicate": {
+							Type:         schema.TypeString,
+							Description:  "Comparison predicate. Values: `GT`, `GTE`, `LT`, `LTE`, `EQ`, `NE`.",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(monitoringAlertPredicates, false),
+						},
+						"warn": {
+							Type:        schema.TypeFloat,
+							Description: "Warning threshold.",
+							Optional:    true,
+						},
+						"alarm": {
+							Type:        schema.TypeFloat,
+							Description: "Alarm threshold.",
+							Optional:    true,
+						},
+						"no_data_policy": {
+							Type:         schema.TypeString,
+							Description:  "Status to set when there is no data. Values: `DEFAULT`, `OK`, `WARN`, `ALARM`, `NO_DATA`, `PRESERVE_LAST`.",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(monitoringAlertNoDataPolicy, false),
+						},
+					},
+				},
+			},
+			"expression": {
+				Type:        schema.TypeList,
+				Description: "Expression alert. Oneof: threshold, expression.",
+				MaxItems:    1,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"program": {
+							Type:        schema.TypeString,
+							Description: "Alert program in the Monitoring query language.",
+							Required:    true,
+						},
+						"no_data_policy": {
+							Type:         schema.TypeString,
+							Description:  "Status to set when there is no data. Values: `DEFAULT`, `OK`, `WARN`, `ALARM`, `NO_DATA`, `PRESERVE_LAST`.",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(monitoringAlertNoDataPolicy, false),
+						},
+					},
+				},
+			},
+			"channel": {
+				Type:        schema.TypeList,
+				Description: "Notification channel bindings.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel_id": {
+							Type:        schema.TypeString,
+							Description: "Notification channel ID.",
+							Required:    true,
+						},
+						"notify_about_statuses": {
+							Type:        schema.TypeList,
+							Description: "Statuses to notify about. Values: `OK`, `WARN`, `ALARM`, `NO_DATA`, `ERROR`.",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(monitoringAlertNotifyStatuses, false),
+							},
+						},
+						"repeat_period": {
+							Type:             schema.TypeString,
+							Description:      "Repeat notification period while the status holds, e.g. `1h`. Not repeated if empty.",
+							Optional:         true,
+							ValidateFunc:     validateParsableValue(parseDuration),
+							DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
+						},
+					},
+				},
+			},
+			"parametrization": monitoringParametrizationSchema("Alert parametrization."),
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["created_at"],
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceMonitoringAlertCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	folderID, err := getFolderID(d, config)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error getting folder ID while creating alert: %s", err))
+	}
+	alert, err := expandMonitoringAlert(d)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error expanding alert while creating: %s", err))
+	}
+	alert.FolderID = folderID
+
+	log.Printf("[DEBUG] Creating Monitoring alert %+v", alert)
+
+	res, err := config.MonitoringAlerting().CreateAlert(ctx, alert)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error while creating alert %s: %s", alert.Name, err))
+	}
+	d.Set("alert_id", res.ID)
+	d.SetId(res.ID)
+
+	return resourceMonitoringAlertRead(ctx, d, meta)
+}
+
+func resourceMonitoringAlertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Reading Monitoring alert %s", d.Id())
+	alert, err := config.MonitoringAlerting().GetAlert(ctx, d.Id())
+	if err != nil {
+		if isStatusWithCode(err, codes.NotFound) {
+			log.Printf("[DEBUG] Monitoring alert (%s) was not found", d.Get("name").(string))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(flattenMonitoringAlert(alert, d))
+}
+
+func resourceMonitoringAlertUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	alert, err := expandMonitoringAlert(d)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error expanding alert while updating: %s", err))
+	}
+	alert.ID = d.Id()
+	alert.FolderID = d.Get("folder_id").(string)
+
+	log.Printf("[DEBUG] Updating Monitoring alert %+v", alert)
+
+	if _, err := config.MonitoringAlerting().UpdateAlert(ctx, alert); err != nil {
+		return diag.FromErr(fmt.Errorf("Error while updating alert %s: %s", alert.Name, err))
+	}
+
+	return resourceMonitoringAlertRead(ctx, d, meta)
+}
+
+func resourceMonitoringAlertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Deleting Monitoring alert %s", d.Id())
+
+	if err := config.MonitoringAlerting().DeleteAlert(ctx, d.Id()); err != nil {
+		if isStatusWithCode(err, codes.NotFound) {
+			log.Printf("[WARN] Removing %s because resource doesn't exist anymore", d.Get("name").(string))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("Error while deleting alert %s: %s", d.Id(), err))
+	}
+	return nil
+}
+
+func expandMonitoringAlert(d *schema.ResourceData) (*alerting.Alert, error) {
+	labels, err := expandLabels(d.Get("labels"))
+	if err != nil {
+		return nil, err
+	}
+	annotations, err := expandLabels(d.Get("annotations"))
+	if err != nil {
+		return nil, err
+	}
+	window, err := expandMonitoringDuration(d.Get("window").(string))
+	if err != nil {
+		return nil, err
+	}
+	delay, err := expandMonitoringDuration(d.Get("delay").(string))
+	if err != nil {
+		return nil, err
+	}
+	parametrization, err := expandDashboardParametrization(d)
+	if err != nil {
+		return nil, err
+	}
+
+	alert := &alerting.Alert{
+		Name:            d.Get("name").(string),
+		Description:     d.Get("description").(string),
+		Labels:          labels,
+		Annotations:     annotations,
+		Window:          window,
+		Delay:           delay,
+		Parametrization: parametrization,
+	}
+
+	if d.Get("threshold.#").(int) > 0 {
+		threshold := &alerting.Threshold{
+			Query:        d.Get("threshold.0.query").(string),
+			Evaluation:   d.Get("threshold.0.evaluation").(string),
+			Predicate:    d.Get("threshold.0.predicate").(string),
+			NoDataPolicy: d.Get("threshold.0.no_data_policy").(string),
+		}
+		if v, ok := d.GetOkExists("threshold.0.warn"); ok {
+			warn := v.(float64)
+			threshold.Warn = &warn
+		}
+		if v, ok := d.GetOkExists("threshold.0.alarm"); ok {
+			alarm := v.(float64)
+			threshold.Alarm = &alarm
+		}
+		if threshold.Warn == nil && threshold.Alarm == nil {
+			return nil, fmt.Errorf("at least one of threshold.warn or threshold.alarm must be set")
+		}
+		alert.Threshold = threshold
+	}
+
+	if d.Get("expression.#").(int) > 0 {
+		alert.Expression = &alerting.Expression{
+			Program:      d.Get("expression.0.program").(string),
+			NoDataPolicy: d.Get("expression.0.no_data_policy").(string),
+		}
+	}
+
+	for i := 0; i < d.Get("channel.#").(int); i++ {
+		repeatPeriod, err := expandMonitoringDuration(d.Get(fmt.Sprintf("channel.%d.repeat_period", i)).(string))
+		if err != nil {
+			return nil, err
+		}
+		alert.Channels = append(alert.Channels, &alerting.ChannelBinding{
+			ChannelID:           d.Get(fmt.Sprintf("channel.%d.channel_id", i)).(string),
+			NotifyAboutStatuses: expandStringSlice(d.Get(fmt.Sprintf("channel.%d.notify_about_statuses", i)).([]interface{})),
+			RepeatPeriod:        repeatPeriod,
+		})
+	}
+
+	return alert, nil
+}
+
+func flattenMonitoringAlert(alert *alerting.Alert, d *schema.ResourceData) error {
+	window, err := flattenMonitoringDuration(alert.Window)
+	if err != nil {
+		return err
+	}
+	delay, err := flattenMonitoringDuration(alert.Delay)
+	if err != nil {
+		return err
+	}
+	parametrization, err := flattenMonitoringParametrization(alert.Parametrization)
+	if err != nil {
+		return err
+	}
+
+	var threshold []map[string]interface{}
+	if t := alert.Threshold; t != nil {
+		m := map[string]interface{}{
+			"query":          t.Query,
+			"evaluation":     t.Evaluation,
+			"predicate":      t.Predicate,
+			"no_data_policy": t.NoDataPolicy,
+		}
+		if t.Warn != nil {
+			m["warn"] = *t.Warn
+		}
+		if t.Alarm != nil {
+			m["alarm"] = *t.Alarm
+		}
+		threshold = []map[string]interface{}{m}
+	}
+
+	var expression []map[string]interface{}
+	if e := alert.Expression; e != nil {
+		expression = []map[string]interface{}{{
+			"program":        e.Program,
+			"no_data_policy": e.NoDataPolicy,
+		}}
+	}
+
+	channels := make([]map[string]interface{}, 0, len(alert.Channels))
+	for _, c := range alert.Channels {
+		repeatPeriod, err := flattenMonitoringDuration(c.RepeatPeriod)
+		if err != nil {
+			return err
+		}
+		channels = append(channels, map[string]interface{}{
+			"channel_id":            c.ChannelID,
+			"notify_about_statuses": c.NotifyAboutStatuses,
+			"repeat_period":         repeatPeriod,
+		})
+	}
+
+	d.Set("alert_id", alert.ID)
+	d.Set("folder_id", alert.FolderID)
+	d.Set("name", alert.Name)
+	d.Set("description", alert.Description)
+	d.Set("created_at", alert.CreatedAt)
+	d.Set("window", window)
+	d.Set("delay", delay)
+
+	if err := d.Set("labels", alert.Labels); err != nil {
+		return err
+	}
+	if err := d.Set("annotations", alert.Annotations); err != nil {
+		return err
+	}
+	if err := d.Set("threshold", threshold); err != nil {
+		return err
+	}
+	if err := d.Set("expression", expression); err != nil {
+		return err
+	}
+	if err := d.Set("channel", channels); err != nil {
+		return err
+	}
+	return d.Set("parametrization", parametrization)
+}
+
+// expandMonitoringDuration converts a Go duration string to the protobuf JSON duration format.
+func expandMonitoringDuration(s string) (string, error) {
+	v, err := parseDuration(s)
+	if err != nil || v == nil {
+		return "", err
+	}
+	return strconv.FormatFloat(v.AsDuration().Seconds(), 'f', -1, 64) + "s", nil
+}
+
+func flattenMonitoringDuration(s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse duration %q: %v", s, err)
+	}
+	return formatDuration(durationpb.New(v)), nil
+}
@@ -0,0 +1,226 @@
+package yandex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/monitoring/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/monitoring/alerting"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/monitoring/alerting/mocks"
+)
+
+func TestMonitoringAlertCreateThreshold(t *testing.T) {
+	raw := map[string]interface{}{
+		"name":        "cpu-high",
+		"description": "CPU usage is too high",
+		"window":      "5m",
+		"labels":      map[string]interface{}{"team": "infra"},
+		"annotations": map[string]interface{}{"summary": "{{alert.name}}"},
+		"threshold": []interface{}{
+			map[string]interface{}{
+				"query":     "cpu_usage{service=\"compute\"}",
+				"predicate": "GT",
+				"warn":      0.0,
+				"alarm":     90.5,
+			},
+		},
+		"channel": []interface{}{
+			map[string]interface{}{
+				"channel_id":            "channel1",
+				"notify_about_statuses": []interface{}{"ALARM", "OK"},
+				"repeat_period":         "1h",
+			},
+		},
+		"parametrization": []interface{}{
+			map[string]interface{}{
+				"selectors": "service=compute",
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resourceYandexMonitoringAlert().Schema, raw)
+
+	warn, alarm := 0.0, 90.5
+	expected := &alerting.Alert{
+		FolderID:    "folder1",
+		Name:        "cpu-high",
+		Description: "CPU usage is too high",
+		Labels:      map[string]string{"team": "infra"},
+		Annotations: map[string]string{"summary": "{{alert.name}}"},
+		Window:      "300s",
+		Threshold: &alerting.Threshold{
+			Query:      "cpu_usage{service=\"compute\"}",
+			Evaluation: "AVG",
+			Predicate:  "GT",
+			Warn:       &warn,
+			Alarm:      &alarm,
+		},
+		Channels: []*alerting.ChannelBinding{
+			{
+				ChannelID:           "channel1",
+				NotifyAboutStatuses: []string{"ALARM", "OK"},
+				RepeatPeriod:        "3600s",
+			},
+		},
+		Parametrization: &monitoring.Parametrization{Selectors: "service=compute"},
+	}
+
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockClient(ctrl)
+	client.EXPECT().CreateAlert(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, alert *alerting.Alert) (*alerting.Alert, error) {
+			require.True(t, proto.Equal(expected.Parametrization, alert.Parametrization))
+			actual := *alert
+			actual.Parametrization = expected.Parametrization
+			require.Equal(t, expected, &actual)
+			return &alerting.Alert{ID: "alert1"}, nil
+		}).Times(1)
+
+	created := *expected
+	created.ID = "alert1"
+	created.Threshold = &alerting.Threshold{
+		Query:        expected.Threshold.Query,
+		Evaluation:   "AVG",
+		Predicate:    "GT",
+		Warn:         &warn,
+		Alarm:        &alarm,
+		NoDataPolicy: "DEFAULT",
+	}
+	client.EXPECT().GetAlert(gomock.Any(), "alert1").Return(&created, nil).Times(1)
+
+	config := &Config{FolderID: "folder1", monitoringAlertingClient: client}
+	diags := resourceMonitoringAlertCreate(context.Background(), d, config)
+	require.False(t, diags.HasError(), diags)
+
+	require.Equal(t, "alert1", d.Id())
+	require.Equal(t, "alert1", d.Get("alert_id"))
+	require.Equal(t, "5m0s", d.Get("window"))
+	require.Equal(t, "DEFAULT", d.Get("threshold.0.no_data_policy"))
+	require.Equal(t, 90.5, d.Get("threshold.0.alarm"))
+	require.Equal(t, "1h0m0s", d.Get("channel.0.repeat_period"))
+	require.Equal(t, "service=compute", d.Get("parametrization.0.selectors"))
+}
+
+func TestMonitoringAlertCreateRequiresThresholdValue(t *testing.T) {
+	raw := map[string]interface{}{
+		"name":   "cpu-high",
+		"window": "5m",
+		"threshold": []interface{}{
+			map[string]interface{}{
+				"query":     "cpu_usage",
+				"predicate": "GT",
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resourceYandexMonitoringAlert().Schema, raw)
+
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockClient(ctrl)
+
+	config := &Config{FolderID: "folder1", monitoringAlertingClient: client}
+	diags := resourceMonitoringAlertCreate(context.Background(), d, config)
+	require.True(t, diags.HasError())
+}
+
+func TestMonitoringAlertExpressionUpdate(t *testing.T) {
+	raw := map[string]interface{}{
+		"name":      "errors",
+		"folder_id": "folder1",
+		"window":    "10m",
+		"delay":     "30s",
+		"expression": []interface{}{
+			map[string]interface{}{
+				"program":        "let rate = series_sum(errors); alarm_if(rate > 10);",
+				"no_data_policy": "OK",
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resourceYandexMonitoringAlert().Schema, raw)
+	d.SetId("alert1")
+
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockClient(ctrl)
+	client.EXPECT().UpdateAlert(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, alert *alerting.Alert) (*alerting.Alert, error) {
+			require.Equal(t, "alert1", alert.ID)
+			require.Equal(t, "folder1", alert.FolderID)
+			require.Equal(t, "600s", alert.Window)
+			require.Equal(t, "30s", alert.Delay)
+			require.Nil(t, alert.Threshold)
+			require.Equal(t, &alerting.Expression{
+				Program:      "let rate = series_sum(errors); alarm_if(rate > 10);",
+				NoDataPolicy: "OK",
+			}, alert.Expression)
+			return alert, nil
+		}).Times(1)
+	client.EXPECT().GetAlert(gomock.Any(), "alert1").Return(&alerting.Alert{
+		ID:       "alert1",
+		FolderID: "folder1",
+		Name:     "errors",
+		Window:   "600s",
+		Delay:    "30s",
+		Expression: &alerting.Expression{
+			Program:      "let rate = series_sum(errors); alarm_if(rate > 10);",
+			NoDataPolicy: "OK",
+		},
+	}, nil).Times(1)
+
+	config := &Config{monitoringAlertingClient: client}
+	diags := resourceMonitoringAlertUpdate(context.Background(), d, config)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, "10m0s", d.Get("window"))
+	require.Equal(t, 0, d.Get("threshold.#"))
+}
+
+func TestMonitoringAlertReadNotFound(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceYandexMonitoringAlert().Schema, map[string]interface{}{
+		"name":   "gone",
+		"window": "5m",
+	})
+	d.SetId("alert1")
+
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockClient(ctrl)
+	client.EXPECT().GetAlert(gomock.Any(), "alert1").Return(nil, status.Error(codes.NotFound, "not found")).Times(1)
+
+	config := &Config{monitoringAlertingClient: client}
+	diags := resourceMonitoringAlertRead(context.Background(), d, config)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, "", d.Id())
+}
+
+func TestExpandMonitoringDuration(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    string
+		expected string
+		err      bool
+	}{
+		{name: "empty", value: "", expected: ""},
+		{name: "minutes", value: "5m", expected: "300s"},
+		{name: "fractional", value: "1.5s", expected: "1.5s"},
+		{name: "milliseconds", value: "100ms", expected: "0.1s"},
+		{name: "large", value: "277h46m40s", expected: "1000000s"},
+		{name: "large fractional", value: "277h46m40.25s", expected: "1000000.25s"},
+		{name: "negative", value: "-5m", err: true},
+		{name: "invalid", value: "five minutes", err: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := expandMonitoringDuration(tc.value)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
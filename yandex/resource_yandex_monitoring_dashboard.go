@@ -71,131 +71,7 @@ func resourceYandexMonitoringDashboard() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 			},
-			"parametrization": {
-				Type:        schema.TypeList,
-				Description: "Dashboard parametrization.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"parameters": {
-							Type:        schema.TypeList,
-							Description: "Dashboard parameters.",
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"custom": {
-										Type:        schema.TypeList,
-										Description: "Custom values parameter. Oneof: label_values, custom, text.",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"default_values": {
-													Type:        schema.TypeList,
-													Description: "Default value.",
-													Elem: &schema.Schema{
-														Type: schema.TypeString,
-													},
-													Optional: true,
-												},
-												"multiselectable": {
-													Type:        schema.TypeBool,
-													Description: "Specifies the multiselectable values of parameter.",
-													Optional:    true,
-												},
-												"values": {
-													Type:        schema.TypeList,
-													Description: "Parameter values.",
-													Elem: &schema.Schema{
-														Type: schema.TypeString,
-													},
-													Optional: true,
-												},
-											},
-										},
-										Optional: true,
-									},
-									"description": {
-										Type:        schema.TypeString,
-										Description: "Parameter description.",
-										Optional:    true,
-									},
-									"hidden": {
-										Type:        schema.TypeBool,
-										Description: "UI-visibility",
-										Optional:    true,
-									},
-									"label_values": {
-										Type:        schema.TypeList,
-										Description: "Label values parameter. Oneof: label_values, custom, text.",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"default_values": {
-													Type:        schema.TypeList,
-													Description: "Default value.",
-													Elem: &schema.Schema{
-														Type: schema.TypeString,
-													},
-													Optional: true,
-												},
-												"folder_id": {
-													Type:        schema.TypeString,
-													Description: "Folder ID.",
-													Optional:    true,
-												},
-												"label_key": {
-													Type:        schema.TypeString,
-													Description: "Label key to list label values.",
-													Required:    true,
-												},
-												"multiselectable": {
-													Type:        schema.TypeBool,
-													Description: "Specifies the multiselectable values of parameter.",
-													Optional:    true,
-												},
-												"selectors": {
-													Type:        schema.TypeString,
-													Description: "Selectors to select metric label values.",
-													Optional:    true,
-												},
-											},
-										},
-										Optional: true,
-									},
-									"id": {
-										Type:        schema.TypeString,
-										Description: "Parameter identifier.",
-										Required:    true,
-									},
-									"text": {
-										Type:        schema.TypeList,
-										Description: "Text parameter. Oneof: label_values, custom, text.",
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"default_value": {
-													Type:        schema.TypeString,
-													Description: "Default value.",
-													Optional:    true,
-												},
-											},
-										},
-										Optional: true,
-									},
-									"title": {
-										Type:        schema.TypeString,
-										Description: "UI-visible title of the parameter.",
-										Optional:    true,
-									},
-								},
-							},
-							Optional: true,
-						},
-						"selectors": {
-							Type:        schema.TypeString,
-							Description: "Dashboard predefined parameters selector.",
-							Optional:    true,
-						},
-					},
-				},
-				Optional: true,
-				Computed: true,
-			},
+			"parametrization": monitoringParametrizationSchema("Dashboard parametrization."),
 			"title": {
 				Type:        schema.TypeString,
 				Description: "Dashboard title.",
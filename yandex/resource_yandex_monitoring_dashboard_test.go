@@ -9,6 +9,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const (
@@ -16,7 +18,7 @@ const (
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_monitoring_dashboard", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_monitoring_dashboard",
 		F:    testSweepMonitoringDashboard,
 	})
@@ -0,0 +1,336 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"google.golang.org/grpc/codes"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/monitoring/alerting"
+)
+
+const yandexMonitoringNotificationChannelDefaultTimeout = 2 * time.Minute
+
+var monitoringNotificationChannelTypes = []string{"email", "sms", "telegram", "webhook"}
+
+func resourceYandexMonitoringNotificationChannel() *schema.Resource {
+	return &schema.Resource{
+		Description: "Allows management of a Yandex Monitoring notification channel.",
+
+		CreateContext: resourceMonitoringNotificationChannelCreate,
+		ReadContext:   resourceMonitoringNotificationChannelRead,
+		UpdateContext: resourceMonitoringNotificationChannelUpdate,
+		DeleteContext: resourceMonitoringNotificationChannelDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				d.Set("channel_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMonitoringNotificationChannelDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexMonitoringNotificationChannelDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexMonitoringNotificationChannelDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexMonitoringNotificationChannelDefaultTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"channel_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Notification channel ID.",
+			},
+			"folder_id": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["folder_id"],
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["name"],
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["description"],
+				Optional:    true,
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Description: common.ResourceDescriptions["labels"],
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Optional:    true,
+			},
+			"email": {
+				Type:         schema.TypeList,
+				Description:  "Email channel. Oneof: email, sms, telegram, webhook.",
+				MaxItems:     1,
+				Optional:     true,
+				ExactlyOneOf: monitoringNotificationChannelTypes,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recipients": {
+							Type:        schema.TypeList,
+							Description: "Email addresses or user IDs to notify.",
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"sms": {
+				Type:        schema.TypeList,
+				Description: "SMS channel. Oneof: email, sms, telegram, webhook.",
+				MaxItems:    1,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recipients": {
+							Type:        schema.TypeList,
+							Description: "User IDs to notify by SMS.",
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"telegram": {
+				Type:        schema.TypeList,
+				Description: "Telegram channel. Oneof: email, sms, telegram, webhook.",
+				MaxItems:    1,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"chat_id": {
+							Type:        schema.TypeInt,
+							Description: "Telegram group chat ID.",
+							Optional:    true,
+						},
+						"recipients": {
+							Type:        schema.TypeList,
+							Description: "User IDs to notify in direct messages.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"webhook": {
+				Type:        schema.TypeList,
+				Description: "Webhook channel. Oneof: email, sms, telegram, webhook.",
+				MaxItems:    1,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:         schema.TypeString,
+							Description:  "Webhook URL.",
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+						"method": {
+							Type:         schema.TypeString,
+							Description:  "HTTP method. Values: `POST`, `PUT`.",
+							Optional:     true,
+							Default:      "POST",
+							ValidateFunc: validation.StringInSlice([]string{"POST", "PUT"}, false),
+						},
+						"headers": {
+							Type:        schema.TypeMap,
+							Description: "HTTP headers sent with the request.",
+							Optional:    true,
+							Sensitive:   true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["created_at"],
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceMonitoringNotificationChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	folderID, err := getFolderID(d, config)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error getting folder ID while creating notification channel: %s", err))
+	}
+	channel, err := expandMonitoringNotificationChannel(d)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error expanding notification channel while creating: %s", err))
+	}
+	channel.FolderID = folderID
+
+	log.Printf("[DEBUG] Creating Monitoring notification channel %s", channel.Name)
+
+	res, err := config.MonitoringAlerting().CreateChannel(ctx, channel)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error while creating notification channel %s: %s", channel.Name, err))
+	}
+	d.Set("channel_id", res.ID)
+	d.SetId(res.ID)
+
+	return resourceMonitoringNotificationChannelRead(ctx, d, meta)
+}
+
+func resourceMonitoringNotificationChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Reading Monitoring notification channel %s", d.Id())
+	channel, err := config.MonitoringAlerting().GetChannel(ctx, d.Id())
+	if err != nil {
+		if isStatusWithCode(err, codes.NotFound) {
+			log.Printf("[DEBUG] Monitoring notification channel (%s) was not found", d.Get("name").(string))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(flattenMonitoringNotificationChannel(channel, d))
+}
+
+func resourceMonitoringNotificationChannelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	channel, err := expandMonitoringNotificationChannel(d)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error expanding notification channel while updating: %s", err))
+	}
+	channel.ID = d.Id()
+	channel.FolderID = d.Get("folder_id").(string)
+
+	log.Printf("[DEBUG] Updating Monitoring notification channel %s", channel.ID)
+
+	if _, err := config.MonitoringAlerting().UpdateChannel(ctx, channel); err != nil {
+		return diag.FromErr(fmt.Errorf("Error while updating notification channel %s: %s", channel.Name, err))
+	}
+
+	return resourceMonitoringNotificationChannelRead(ctx, d, meta)
+}
+
+func resourceMonitoringNotificationChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Deleting Monitoring notification channel %s", d.Id())
+
+	if err := config.MonitoringAlerting().DeleteChannel(ctx, d.Id()); err != nil {
+		if isStatusWithCode(err, codes.NotFound) {
+			log.Printf("[WARN] Removing %s because resource doesn't exist anymore", d.Get("name").(string))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("Error while deleting notification channel %s: %s", d.Id(), err))
+	}
+	return nil
+}
+
+func expandMonitoringNotificationChannel(d *schema.ResourceData) (*alerting.Channel, error) {
+	labels, err := expandLabels(d.Get("labels"))
+	if err != nil {
+		return nil, err
+	}
+
+	channel := &alerting.Channel{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Labels:      labels,
+	}
+
+	if d.Get("email.#").(int) > 0 {
+		channel.Email = &alerting.EmailChannel{
+			Recipients: expandStringSlice(d.Get("email.0.recipients").([]interface{})),
+		}
+	}
+
+	if d.Get("sms.#").(int) > 0 {
+		channel.Sms = &alerting.SmsChannel{
+			Recipients: expandStringSlice(d.Get("sms.0.recipients").([]interface{})),
+		}
+	}
+
+	if d.Get("telegram.#").(int) > 0 {
+		channel.Telegram = &alerting.TelegramChannel{
+			ChatID:     int64(d.Get("telegram.0.chat_id").(int)),
+			Recipients: expandStringSlice(d.Get("telegram.0.recipients").([]interface{})),
+		}
+		if channel.Telegram.ChatID == 0 && len(channel.Telegram.Recipients) == 0 {
+			return nil, fmt.Errorf("one of telegram.chat_id or telegram.recipients must be set")
+		}
+	}
+
+	if d.Get("webhook.#").(int) > 0 {
+		headers, err := expandLabels(d.Get("webhook.0.headers"))
+		if err != nil {
+			return nil, err
+		}
+		channel.Webhook = &alerting.WebhookChannel{
+			URL:     d.Get("webhook.0.url").(string),
+			Method:  d.Get("webhook.0.method").(string),
+			Headers: headers,
+		}
+	}
+
+	return channel, nil
+}
+
+func flattenMonitoringNotificationChannel(channel *alerting.Channel, d *schema.ResourceData) error {
+	var email, sms, telegram, webhook []map[string]interface{}
+
+	if channel.Email != nil {
+		email = []map[string]interface{}{{"recipients": channel.Email.Recipients}}
+	}
+	if channel.Sms != nil {
+		sms = []map[string]interface{}{{"recipients": channel.Sms.Recipients}}
+	}
+	if channel.Telegram != nil {
+		telegram = []map[string]interface{}{{
+			"chat_id":    int(channel.Telegram.ChatID),
+			"recipients": channel.Telegram.Recipients,
+		}}
+	}
+	if channel.Webhook != nil {
+		webhook = []map[string]interface{}{{
+			"url":     channel.Webhook.URL,
+			"method":  channel.Webhook.Method,
+			"headers": channel.Webhook.Headers,
+		}}
+	}
+
+	d.Set("channel_id", channel.ID)
+	d.Set("folder_id", channel.FolderID)
+	d.Set("name", channel.Name)
+	d.Set("description", channel.Description)
+	d.Set("created_at", channel.CreatedAt)
+
+	if err := d.Set("labels", channel.Labels); err != nil {
+		return err
+	}
+	if err := d.Set("email", email); err != nil {
+		return err
+	}
+	if err := d.Set("sms", sms); err != nil {
+		return err
+	}
+	if err := d.Set("telegram", telegram); err != nil {
+		return err
+	}
+	return d.Set("webhook", webhook)
+}
@@ -0,0 +1,136 @@
+package yandex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/monitoring/alerting"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/monitoring/alerting/mocks"
+)
+
+func TestMonitoringNotificationChannelCreate(t *testing.T) {
+	cases := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected *alerting.Channel
+	}{
+		{
+			name: "email",
+			raw: map[string]interface{}{
+				"email": []interface{}{
+					map[string]interface{}{"recipients": []interface{}{"ops@example.com"}},
+				},
+			},
+			expected: &alerting.Channel{
+				Email: &alerting.EmailChannel{Recipients: []string{"ops@example.com"}},
+			},
+		},
+		{
+			name: "sms",
+			raw: map[string]interface{}{
+				"sms": []interface{}{
+					map[string]interface{}{"recipients": []interface{}{"user1"}},
+				},
+			},
+			expected: &alerting.Channel{
+				Sms: &alerting.SmsChannel{Recipients: []string{"user1"}},
+			},
+		},
+		{
+			name: "telegram",
+			raw: map[string]interface{}{
+				"telegram": []interface{}{
+					map[string]interface{}{"chat_id": -100123},
+				},
+			},
+			expected: &alerting.Channel{
+				Telegram: &alerting.TelegramChannel{ChatID: -100123, Recipients: []string{}},
+			},
+		},
+		{
+			name: "webhook",
+			raw: map[string]interface{}{
+				"webhook": []interface{}{
+					map[string]interface{}{
+						"url":     "https://hooks.example.com/alerts",
+						"headers": map[string]interface{}{"X-Token": "secret"},
+					},
+				},
+			},
+			expected: &alerting.Channel{
+				Webhook: &alerting.WebhookChannel{
+					URL:     "https://hooks.example.com/alerts",
+					Method:  "POST",
+					Headers: map[string]string{"X-Token": "secret"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.raw["name"] = "oncall"
+			d := schema.TestResourceDataRaw(t, resourceYandexMonitoringNotificationChannel().Schema, tc.raw)
+
+			tc.expected.Name = "oncall"
+			tc.expected.FolderID = "folder1"
+			tc.expected.Labels = map[string]string{}
+
+			ctrl := gomock.NewController(t)
+			client := mocks.NewMockClient(ctrl)
+			client.EXPECT().CreateChannel(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, channel *alerting.Channel) (*alerting.Channel, error) {
+					require.Equal(t, tc.expected, channel)
+					return &alerting.Channel{ID: "channel1"}, nil
+				}).Times(1)
+
+			created := *tc.expected
+			created.ID = "channel1"
+			client.EXPECT().GetChannel(gomock.Any(), "channel1").Return(&created, nil).Times(1)
+
+			config := &Config{FolderID: "folder1", monitoringAlertingClient: client}
+			diags := resourceMonitoringNotificationChannelCreate(context.Background(), d, config)
+			require.False(t, diags.HasError(), diags)
+			require.Equal(t, "channel1", d.Id())
+			require.Equal(t, 1, d.Get(tc.name+".#"))
+		})
+	}
+}
+
+func TestMonitoringNotificationChannelTelegramRequiresTarget(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceYandexMonitoringNotificationChannel().Schema, map[string]interface{}{
+		"name": "oncall",
+		"telegram": []interface{}{
+			map[string]interface{}{},
+		},
+	})
+
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockClient(ctrl)
+
+	config := &Config{FolderID: "folder1", monitoringAlertingClient: client}
+	diags := resourceMonitoringNotificationChannelCreate(context.Background(), d, config)
+	require.True(t, diags.HasError())
+}
+
+func TestMonitoringNotificationChannelDeleteNotFound(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceYandexMonitoringNotificationChannel().Schema, map[string]interface{}{
+		"name": "oncall",
+	})
+	d.SetId("channel1")
+
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockClient(ctrl)
+	client.EXPECT().DeleteChannel(gomock.Any(), "channel1").Return(status.Error(codes.NotFound, "not found")).Times(1)
+
+	config := &Config{monitoringAlertingClient: client}
+	diags := resourceMonitoringNotificationChannelDelete(context.Background(), d, config)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, "", d.Id())
+}
@@ -7,11 +7,13 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 // All federations and groups in example organization get delete by sweepers
 func init() {
-	resource.AddTestSweepers("yandex_organizationmanager_group_mapping_item", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_organizationmanager_group_mapping_item",
 		F:    func(_ string) error { return nil },
 		Dependencies: []string{
@@ -8,11 +8,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 // All federations in example organization get delete by federation sweeper
 func init() {
-	resource.AddTestSweepers("yandex_organizationmanager_group_mapping", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name:         "yandex_organizationmanager_group_mapping",
 		F:            func(_ string) error { return nil },
 		Dependencies: []string{"yandex_organizationmanager_saml_federation"},
@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const (
@@ -19,7 +21,7 @@ const (
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_organizationmanager_user_ssh_key", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name:         "yandex_organizationmanager_user_ssh_key",
 		F:            testSweepUserSshKeys,
 		Dependencies: []string{},
@@ -10,7 +10,6 @@ import (
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
 	"google.golang.org/genproto/protobuf/field_mask"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Delete can last up to 30 minutes, approved by IAM.
@@ -25,7 +24,7 @@ func resourceYandexResourceManagerCloud() *schema.Resource {
 		Update: resourceYandexResourceManagerCloudUpdate,
 		Delete: resourceYandexResourceManagerCloudDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceManagerImportState,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -71,6 +70,28 @@ func resourceYandexResourceManagerCloud() *schema.Resource {
 				Description: common.ResourceDescriptions["created_at"],
 				Computed:    true,
 			},
+
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Description: "The `true` value prevents the cloud from being deleted by Terraform. The flag is stored only in the Terraform state, so it has to be set to `false` and applied before the cloud can be destroyed.",
+				Optional:    true,
+				Default:     false,
+			},
+
+			"delete_after": {
+				Type:             schema.TypeString,
+				Description:      "Delay of the cloud deletion, e.g. `72h`. During the delay the cloud is in the `PENDING_DELETION` state, all of its resources are stopped, and the deletion can be canceled without any loss. Terraform does not wait for a delayed deletion to complete. By default, the cloud is deleted immediately.",
+				Optional:         true,
+				ValidateFunc:     validateParsableValue(time.ParseDuration),
+				DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
+			},
+
+			"check_empty_on_delete": {
+				Type:        schema.TypeBool,
+				Description: "The `true` value makes Terraform refuse to delete the cloud while any of its folders still contains Compute instances, instance groups, YDB databases or Managed Service for PostgreSQL, MySQL, ClickHouse, MongoDB, Redis, Kafka, Greenplum, SQL Server, OpenSearch, Elasticsearch or Sharded PostgreSQL clusters. Other resources are not checked.",
+				Optional:    true,
+				Default:     false,
+			},
 		},
 	}
 }
@@ -173,8 +194,9 @@ func resourceYandexResourceManagerCloudUpdate(d *schema.ResourceData, meta inter
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "description")
 	}
 
+	// deletion_protection, delete_after and check_empty_on_delete are not sent to the API.
 	if len(req.UpdateMask.Paths) == 0 {
-		return fmt.Errorf("No fields were updated for Cloud %s", d.Id())
+		return resourceYandexResourceManagerCloudRead(d, meta)
 	}
 
 	err := makeCloudUpdateRequest(req, d, meta)
@@ -188,21 +210,38 @@ func resourceYandexResourceManagerCloudUpdate(d *schema.ResourceData, meta inter
 func resourceYandexResourceManagerCloudDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	err := checkResourceManagerDeletion(d, "cloud", func() error {
+		return checkCloudIsEmpty(ctx, config, d.Id())
+	})
+	if err != nil {
+		return err
+	}
+
+	deleteAfter, delayed, err := resourceManagerDeleteAfter(d)
+	if err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Deleting Cloud %q", d.Id())
 
 	req := &resourcemanager.DeleteCloudRequest{
 		CloudId:     d.Id(),
-		DeleteAfter: timestamppb.Now(),
+		DeleteAfter: deleteAfter,
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.ResourceManager().Cloud().Delete(ctx, req))
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("Cloud %q", d.Id()))
 	}
 
+	if delayed {
+		log.Printf("[DEBUG] Cloud %q is scheduled for deletion at %s", d.Id(), deleteAfter.AsTime())
+		return nil
+	}
+
 	err = op.Wait(ctx)
 	if err != nil {
 		return err
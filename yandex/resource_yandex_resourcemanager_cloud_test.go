@@ -13,12 +13,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const cloudPrefix = "tfacc"
 
 func init() {
-	resource.AddTestSweepers("yandex_resourcemanager_cloud", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name:         "yandex_resourcemanager_cloud",
 		F:            testSweepClouds,
 		Dependencies: []string{},
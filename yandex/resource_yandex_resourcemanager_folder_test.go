@@ -12,12 +12,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const folderPrefix = "tfacc"
 
 func init() {
-	resource.AddTestSweepers("yandex_resourcemanager_folder", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name:         "yandex_resourcemanager_folder",
 		F:            testSweepFolders,
 		Dependencies: []string{},
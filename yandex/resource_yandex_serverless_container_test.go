@@ -16,6 +16,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/logging/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/containers/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const serverlessContainerResource = "yandex_serverless_container.test-container"
@@ -26,7 +28,7 @@ const serverlessContainerTestImage2 = "cr.yandex/yc/demo/nginx-hostname:cli"
 const serverlessContainerTestImage3 = "cr.yandex/mirror/library/hello-world"
 
 func init() {
-	resource.AddTestSweepers("yandex_serverless_container", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_serverless_container",
 		F:    testSweepServerlessContainer,
 		Dependencies: []string{
@@ -13,12 +13,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/serverless/eventrouter/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const eventrouterRuleResource = "yandex_serverless_eventrouter_rule.test-rule"
 
 func init() {
-	resource.AddTestSweepers("yandex_serverless_eventrouter_rule", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_serverless_eventrouter_rule",
 		F:    testSweepEventrouterRule,
 	})
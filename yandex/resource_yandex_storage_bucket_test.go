@@ -14,6 +14,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/storage/s3"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -28,7 +29,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_storage_bucket", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_storage_bucket",
 		F:    testSweepStorageBucket,
 		Dependencies: []string{
@@ -20,11 +20,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/storage/s3"
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_storage_object", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name:         "yandex_storage_object",
 		F:            testSweepStorageObject,
 		Dependencies: []string{},
@@ -7,13 +7,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_vpc_default_security_group", &resource.Sweeper{
-		Name:         "yandex_vpc_default_security_group",
-		F:            testSweepVPCSecurityGroups,
-		Dependencies: getYandexVPCSecurityGroupSweeperDeps(),
+	sweeper.Add(&sweeper.Sweeper{
+		Name:   "yandex_vpc_default_security_group",
+		List:   listVPCSecurityGroups,
+		Delete: sweeperDelete(sweepVPCSecurityGroup),
+		// Security groups are swept by yandex_vpc_security_group sweeper, so they are not deleted concurrently
+		Dependencies: append(getYandexVPCSecurityGroupSweeperDeps(), "yandex_vpc_security_group"),
 	})
 }
 
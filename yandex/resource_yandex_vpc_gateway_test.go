@@ -5,41 +5,40 @@ import (
 	"fmt"
 	"testing"
 
-	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_vpc_gateway", &resource.Sweeper{
-		Name: "yandex_vpc_gateway",
-		F:    testSweepVPCGateways,
+	sweeper.Add(&sweeper.Sweeper{
+		Name:   "yandex_vpc_gateway",
+		List:   listVPCGateways,
+		Delete: sweeperDelete(sweepVPCGateway),
 		Dependencies: []string{
 			"yandex_vpc_route_table",
 		},
 	})
 }
 
-func testSweepVPCGateways(_ string) error {
-	conf, err := configForSweepers()
+func listVPCGateways(ctx context.Context) ([]sweeper.Resource, error) {
+	conf, err := sweeperConfig()
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
 
 	req := &vpc.ListGatewaysRequest{FolderId: conf.FolderID}
-	it := conf.sdk.VPC().Gateway().GatewayIterator(conf.Context(), req)
-	result := &multierror.Error{}
+	it := conf.sdk.VPC().Gateway().GatewayIterator(ctx, req)
+	var result []sweeper.Resource
 	for it.Next() {
-		id := it.Value().GetId()
-		if !sweepVPCGateway(conf, id) {
-			result = multierror.Append(result, fmt.Errorf("failed to sweep VPC gateway %q", id))
-		}
+		result = append(result, sweeperResource(it.Value()))
 	}
 
-	return result.ErrorOrNil()
+	return result, it.Error()
 }
 
 func sweepVPCGateway(conf *Config, id string) bool {
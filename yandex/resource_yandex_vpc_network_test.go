@@ -5,18 +5,20 @@ import (
 	"fmt"
 	"testing"
 
-	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_vpc_network", &resource.Sweeper{
-		Name: "yandex_vpc_network",
-		F:    testSweepVPCNetworks,
+	sweeper.Add(&sweeper.Sweeper{
+		Name:   "yandex_vpc_network",
+		List:   listVPCNetworks,
+		Delete: sweeperDelete(sweepVPCNetwork),
 		Dependencies: []string{
 			"yandex_vpc_subnet",
 			"yandex_vpc_route_table",
@@ -25,23 +27,20 @@ func init() {
 	})
 }
 
-func testSweepVPCNetworks(_ string) error {
-	conf, err := configForSweepers()
+func listVPCNetworks(ctx context.Context) ([]sweeper.Resource, error) {
+	conf, err := sweeperConfig()
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
 
 	req := &vpc.ListNetworksRequest{FolderId: conf.FolderID}
-	it := conf.sdk.VPC().Network().NetworkIterator(conf.Context(), req)
-	result := &multierror.Error{}
+	it := conf.sdk.VPC().Network().NetworkIterator(ctx, req)
+	var result []sweeper.Resource
 	for it.Next() {
-		id := it.Value().GetId()
-		if !sweepVPCNetwork(conf, id) {
-			result = multierror.Append(result, fmt.Errorf("failed to sweep VPC network %q", id))
-		}
+		result = append(result, sweeperResource(it.Value()))
 	}
 
-	return result.ErrorOrNil()
+	return result, it.Error()
 }
 
 func sweepVPCNetwork(conf *Config, id string) bool {
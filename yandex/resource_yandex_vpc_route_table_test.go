@@ -5,38 +5,37 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_vpc_route_table", &resource.Sweeper{
-		Name: "yandex_vpc_route_table",
-		F:    testSweepVPCRouteTables,
+	sweeper.Add(&sweeper.Sweeper{
+		Name:   "yandex_vpc_route_table",
+		List:   listVPCRouteTables,
+		Delete: sweeperDelete(sweepVPCRouteTable),
 	})
 }
 
-func testSweepVPCRouteTables(_ string) error {
-	conf, err := configForSweepers()
+func listVPCRouteTables(ctx context.Context) ([]sweeper.Resource, error) {
+	conf, err := sweeperConfig()
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
 
 	req := &vpc.ListRouteTablesRequest{FolderId: conf.FolderID}
-	it := conf.sdk.VPC().RouteTable().RouteTableIterator(conf.Context(), req)
-	result := &multierror.Error{}
+	it := conf.sdk.VPC().RouteTable().RouteTableIterator(ctx, req)
+	var result []sweeper.Resource
 	for it.Next() {
-		id := it.Value().GetId()
-		if !sweepVPCRouteTable(conf, id) {
-			result = multierror.Append(result, fmt.Errorf("failed to sweep VPC Route Table %q", id))
-		}
+		result = append(result, sweeperResource(it.Value()))
 	}
 
-	return result.ErrorOrNil()
+	return result, it.Error()
 }
 
 func sweepVPCRouteTable(conf *Config, id string) bool {
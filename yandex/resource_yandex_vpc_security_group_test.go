@@ -5,13 +5,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/go-multierror"
-
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 func getYandexVPCSecurityGroupSweeperDeps() []string {
@@ -36,30 +36,28 @@ func getYandexVPCSecurityGroupSweeperDeps() []string {
 }
 
 func init() {
-	resource.AddTestSweepers("yandex_vpc_security_group", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name:         "yandex_vpc_security_group",
-		F:            testSweepVPCSecurityGroups,
+		List:         listVPCSecurityGroups,
+		Delete:       sweeperDelete(sweepVPCSecurityGroup),
 		Dependencies: getYandexVPCSecurityGroupSweeperDeps(),
 	})
 }
 
-func testSweepVPCSecurityGroups(_ string) error {
-	conf, err := configForSweepers()
+func listVPCSecurityGroups(ctx context.Context) ([]sweeper.Resource, error) {
+	conf, err := sweeperConfig()
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
 
 	req := &vpc.ListSecurityGroupsRequest{FolderId: conf.FolderID}
-	it := conf.sdk.VPC().SecurityGroup().SecurityGroupIterator(conf.Context(), req)
-	result := &multierror.Error{}
+	it := conf.sdk.VPC().SecurityGroup().SecurityGroupIterator(ctx, req)
+	var result []sweeper.Resource
 	for it.Next() {
-		id := it.Value().GetId()
-		if !sweepVPCSecurityGroup(conf, id) {
-			result = multierror.Append(result, fmt.Errorf("failed to sweep VPC security group %q", it.Value().GetId()))
-		}
+		result = append(result, sweeperResource(it.Value()))
 	}
 
-	return result.ErrorOrNil()
+	return result, it.Error()
 }
 
 func sweepVPCSecurityGroup(conf *Config, id string) bool {
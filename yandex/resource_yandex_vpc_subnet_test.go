@@ -5,18 +5,20 @@ import (
 	"fmt"
 	"testing"
 
-	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_vpc_subnet", &resource.Sweeper{
-		Name: "yandex_vpc_subnet",
-		F:    testSweepVPCSubnets,
+	sweeper.Add(&sweeper.Sweeper{
+		Name:   "yandex_vpc_subnet",
+		List:   listVPCSubnets,
+		Delete: sweeperDelete(sweepVPCSubnet),
 		Dependencies: []string{
 			"yandex_alb_load_balancer",
 			"yandex_compute_instance",
@@ -40,23 +42,20 @@ func init() {
 	})
 }
 
-func testSweepVPCSubnets(_ string) error {
-	conf, err := configForSweepers()
+func listVPCSubnets(ctx context.Context) ([]sweeper.Resource, error) {
+	conf, err := sweeperConfig()
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %s", err)
 	}
 
 	req := &vpc.ListSubnetsRequest{FolderId: conf.FolderID}
-	it := conf.sdk.VPC().Subnet().SubnetIterator(conf.Context(), req)
-	result := &multierror.Error{}
+	it := conf.sdk.VPC().Subnet().SubnetIterator(ctx, req)
+	var result []sweeper.Resource
 	for it.Next() {
-		id := it.Value().GetId()
-		if !sweepVPCSubnet(conf, id) {
-			result = multierror.Append(result, fmt.Errorf("failed to sweep VPC subnet %q", it.Value().GetId()))
-		}
+		result = append(result, sweeperResource(it.Value()))
 	}
 
-	return result.ErrorOrNil()
+	return result, it.Error()
 }
 
 func sweepVPCSubnet(conf *Config, id string) bool {
@@ -12,6 +12,8 @@ import (
 	"google.golang.org/genproto/protobuf/field_mask"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/ydb/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 )
 
 const (
@@ -20,7 +22,7 @@ const (
 )
 
 func init() {
-	resource.AddTestSweepers("yandex_ydb_database_dedicated", &resource.Sweeper{
+	sweeper.Add(&sweeper.Sweeper{
 		Name: "yandex_ydb_database_dedicated",
 		F:    testSweepYDBDatabaseDedicated,
 	})
@@ -0,0 +1,178 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1/instancegroup"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/elasticsearch/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mysql/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/opensearch/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/redis/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/spqr/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/sqlserver/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/ydb/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// checkResourceManagerDeletion verifies that the folder or cloud may be deleted according to the deletion attributes.
+// checkEmpty is called only if `check_empty_on_delete` is set.
+func checkResourceManagerDeletion(d *schema.ResourceData, kind string, checkEmpty func() error) error {
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("cannot delete %s %q: deletion_protection is enabled, set it to false and apply before destroying", kind, d.Id())
+	}
+
+	if d.Get("check_empty_on_delete").(bool) {
+		return checkEmpty()
+	}
+	return nil
+}
+
+// resourceManagerImportState sets the defaults of the deletion attributes, since they are stored only in the state
+// and can't be read from the API.
+func resourceManagerImportState(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("deletion_protection", false); err != nil {
+		return nil, err
+	}
+	if err := d.Set("check_empty_on_delete", false); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceManagerDeleteAfter returns the timestamp for the `delete_after` field of a folder or cloud delete request
+// and whether the deletion is delayed.
+func resourceManagerDeleteAfter(d *schema.ResourceData) (*timestamppb.Timestamp, bool, error) {
+	delay := time.Duration(0)
+	if v, ok := d.GetOk("delete_after"); ok {
+		var err error
+		if delay, err = time.ParseDuration(v.(string)); err != nil {
+			return nil, false, fmt.Errorf("invalid delete_after %q: %s", v, err)
+		}
+	}
+	return timestamppb.New(time.Now().Add(delay)), delay > 0, nil
+}
+
+type folderResourceCheck struct {
+	kind  string
+	exist func(ctx context.Context, config *Config, folderID string) (bool, error)
+}
+
+var folderResourceChecks = []folderResourceCheck{
+	{"Compute instances", func(ctx context.Context, config *Config, folderID string) (bool, error) {
+		resp, err := config.sdk.Compute().Instance().List(ctx, &compute.ListInstancesRequest{FolderId: folderID, PageSize: 1})
+		return len(resp.GetInstances()) > 0, err
+	}},
+	{"Compute instance groups", func(ctx context.Context, config *Config, folderID string) (bool, error) {
+		resp, err := config.sdk.InstanceGroup().InstanceGroup().List(ctx, &instancegroup.ListInstanceGroupsRequest{FolderId: folderID, PageSize: 1})
+		return len(resp.GetInstanceGroups()) > 0, err
+	}},
+	{"PostgreSQL clusters", func(ctx context.Context, config *Config, folderID string) (bool, error) {
+		resp, err := config.sdk.MDB().PostgreSQL().Cluster().List(ctx, &postgresql.ListClustersRequest{FolderId: folderID, PageSize: 1})
+		return len(resp.GetClusters()) > 0, err
+	}},
+	{"MySQL clusters", func(ctx context.Context, config *Config, folderID string) (bool, error) {
+		resp, err := config.sdk.MDB().MySQL().Cluster().List(ctx, &mysql.ListClustersRequest{FolderId: folderID, PageSize: 1})
+		return len(resp.GetClusters()) > 0, err
+	}},
+	{"ClickHouse clusters", func(ctx context.Context, config *Config, folderID string) (bool, error) {
+		resp, err := config.sdk.MDB().Clickhouse().Cluster().List(ctx, &clickhouse.ListClustersRequest{FolderId: folderID, PageSize: 1})
+		return len(resp.GetClusters()) > 0, err
+	}},
+	{"MongoDB clusters", func(ctx context.Context, config *Config, folderID string) (bool, error) {
+		resp, err := config.sdk.MDB().MongoDB().Cluster().List(ctx, &mongodb.ListClustersRequest{FolderId: folderID, PageSize: 1})
+		return len(resp.GetClusters()) > 0, err
+	}},
+	{"Redis clusters", func(ctx context.Context, config *Config, folderID string) (bool, error) {
+		resp, err := config.sdk.MDB().Redis().Cluster().List(ctx, &redis.ListClustersRequest{FolderId: folderID, PageSize: 1})
+		return len(resp.GetClusters()) > 0, err
+	}},
+	{"Kafka clusters", func(ctx context.Context, config *Config, folderID string) (bool, error) {
+		resp, err := config.sdk.MDB().Kafka().Cluster().List(ctx, &kafka.ListClustersRequest{FolderId: folderID, PageSize: 1})
+		return len(resp.GetClusters()) > 0, err
+	}},
+	{"Greenplum clusters", func(ctx context.Context, config *Config, folderID string) (bool, error) {
+		resp, err := config.sdk.MDB().Greenplum().Cluster().List(ctx, &greenplum.ListClustersRequest{FolderId: folderID, PageSize: 1})
+		return len(resp.GetClusters()) > 0, err
+	}},
+	{"SQL Server clusters", func(ctx context.Context, config *Config, folderID string) (bool, error) {
+		resp, err := config.sdk.MDB().SQLServer().Cluster().List(ctx, &sqlserver.ListClustersRequest{FolderId: folderID, PageSize: 1})
+		return len(resp.GetClusters()) > 0, err
+	}},
+	{"OpenSearch clusters", func(ctx context.Context, config *Config, folderID string) (bool, error) {
+		resp, err := config.sdk.MDB().OpenSearch().Cluster().List(ctx, &opensearch.ListClustersRequest{FolderId: folderID, PageSize: 1})
+		return len(resp.GetClusters()) > 0, err
+	}},
+	{"Elasticsearch clusters", func(ctx context.Context, config *Config, folderID string) (bool, error) {
+		resp, err := config.sdk.MDB().ElasticSearch().Cluster().List(ctx, &elasticsearch.ListClustersRequest{FolderId: folderID, PageSize: 1})
+		return len(resp.GetClusters()) > 0, err
+	}},
+	{"Sharded PostgreSQL clusters", func(ctx context.Context, config *Config, folderID string) (bool, error) {
+		resp, err := config.sdk.MDB().SPQR().Cluster().List(ctx, &spqr.ListClustersRequest{FolderId: folderID, PageSize: 1})
+		return len(resp.GetClusters()) > 0, err
+	}},
+	{"YDB databases", func(ctx context.Context, config *Config, folderID string) (bool, error) {
+		resp, err := config.sdk.YDB().Database().List(ctx, &ydb.ListDatabasesRequest{FolderId: folderID, PageSize: 1})
+		return len(resp.GetDatabases()) > 0, err
+	}},
+}
+
+// folderNonEmptyKinds returns kinds of Compute, MDB and YDB resources that exist in the folder.
+func folderNonEmptyKinds(ctx context.Context, config *Config, folderID string) ([]string, error) {
+	var kinds []string
+	for _, check := range folderResourceChecks {
+		exist, err := check.exist(ctx, config, folderID)
+		if err != nil {
+			return nil, fmt.Errorf("Error while listing %s in folder %q: %s", check.kind, folderID, err)
+		}
+		if exist {
+			kinds = append(kinds, check.kind)
+		}
+	}
+	return kinds, nil
+}
+
+func checkFolderIsEmpty(ctx context.Context, config *Config, folderID string) error {
+	kinds, err := folderNonEmptyKinds(ctx, config, folderID)
+	if err != nil {
+		return err
+	}
+	if len(kinds) > 0 {
+		return fmt.Errorf("cannot delete folder %q: it still contains %s, delete them first or set check_empty_on_delete to false", folderID, strings.Join(kinds, ", "))
+	}
+	return nil
+}
+
+func checkCloudIsEmpty(ctx context.Context, config *Config, cloudID string) error {
+	var nonEmpty []string
+
+	it := config.sdk.ResourceManager().Folder().FolderIterator(ctx, &resourcemanager.ListFoldersRequest{CloudId: cloudID})
+	for it.Next() {
+		folder := it.Value()
+		kinds, err := folderNonEmptyKinds(ctx, config, folder.Id)
+		if err != nil {
+			return err
+		}
+		if len(kinds) > 0 {
+			nonEmpty = append(nonEmpty, fmt.Sprintf("%q (%s)", folder.Id, strings.Join(kinds, ", ")))
+		}
+	}
+	if err := it.Error(); err != nil {
+		return fmt.Errorf("Error while listing folders of cloud %q: %s", cloudID, err)
+	}
+
+	if len(nonEmpty) > 0 {
+		return fmt.Errorf("cannot delete cloud %q: folders %s still contain resources, delete them first or set check_empty_on_delete to false", cloudID, strings.Join(nonEmpty, ", "))
+	}
+	return nil
+}
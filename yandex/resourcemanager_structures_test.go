@@ -0,0 +1,90 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckResourceManagerDeletion(t *testing.T) {
+	errNotEmpty := fmt.Errorf("not empty")
+
+	tests := []struct {
+		name        string
+		raw         map[string]interface{}
+		expectedErr string
+		checked     bool
+	}{
+		{
+			name: "defaults",
+			raw:  map[string]interface{}{},
+		},
+		{
+			name:        "deletion protection",
+			raw:         map[string]interface{}{"deletion_protection": true, "check_empty_on_delete": true},
+			expectedErr: "deletion_protection is enabled",
+		},
+		{
+			name:        "check empty",
+			raw:         map[string]interface{}{"check_empty_on_delete": true},
+			expectedErr: errNotEmpty.Error(),
+			checked:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resourceData := schema.TestResourceDataRaw(t, resourceYandexResourceManagerFolder().Schema, test.raw)
+			resourceData.SetId("folder-id")
+
+			checked := false
+			err := checkResourceManagerDeletion(resourceData, "folder", func() error {
+				checked = true
+				return errNotEmpty
+			})
+
+			assert.Equal(t, test.checked, checked)
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestResourceManagerImportState(t *testing.T) {
+	for _, r := range []*schema.Resource{resourceYandexResourceManagerFolder(), resourceYandexResourceManagerCloud()} {
+		resourceData := r.Data(nil)
+		resourceData.SetId("resource-id")
+
+		imported, err := r.Importer.State(resourceData, nil)
+		require.NoError(t, err)
+		require.Len(t, imported, 1)
+
+		state := imported[0].State()
+		assert.Equal(t, "false", state.Attributes["deletion_protection"])
+		assert.Equal(t, "false", state.Attributes["check_empty_on_delete"])
+		assert.NotContains(t, state.Attributes, "delete_after")
+	}
+}
+
+func TestResourceManagerDeleteAfter(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceYandexResourceManagerCloud().Schema, map[string]interface{}{})
+	deleteAfter, delayed, err := resourceManagerDeleteAfter(resourceData)
+	require.NoError(t, err)
+	assert.False(t, delayed)
+	assert.WithinDuration(t, time.Now(), deleteAfter.AsTime(), time.Minute)
+
+	resourceData = schema.TestResourceDataRaw(t, resourceYandexResourceManagerCloud().Schema, map[string]interface{}{
+		"delete_after": "72h",
+	})
+	deleteAfter, delayed, err = resourceManagerDeleteAfter(resourceData)
+	require.NoError(t, err)
+	assert.True(t, delayed)
+	assert.WithinDuration(t, time.Now().Add(72*time.Hour), deleteAfter.AsTime(), time.Minute)
+}
@@ -7,13 +7,15 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/sweeper"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
 )
@@ -26,7 +28,7 @@ const (
 type sweeperFunc func(*Config, string) error
 
 func TestMain(m *testing.M) {
-	resource.TestMain(m)
+	sweeper.TestMain(m)
 }
 
 func configForSweepers() (*Config, error) {
@@ -74,6 +76,37 @@ func configForSweepers() (*Config, error) {
 	return conf, nil
 }
 
+// sweeperConfig returns the config shared by sweepers declaring List and Delete.
+var sweeperConfig = sync.OnceValues(configForSweepers)
+
+type sweptResource interface {
+	GetId() string
+	GetName() string
+	GetCreatedAt() *timestamppb.Timestamp
+}
+
+func sweeperResource(r sweptResource) sweeper.Resource {
+	result := sweeper.Resource{ID: r.GetId(), Name: r.GetName()}
+	if r.GetCreatedAt() != nil {
+		result.CreatedAt = r.GetCreatedAt().AsTime()
+	}
+	return result
+}
+
+// sweeperDelete adapts the function sweeping a single resource with retries to sweeper.Sweeper Delete.
+func sweeperDelete(sweep func(conf *Config, id string) bool) func(ctx context.Context, id string) error {
+	return func(_ context.Context, id string) error {
+		conf, err := sweeperConfig()
+		if err != nil {
+			return fmt.Errorf("error getting client: %s", err)
+		}
+		if !sweep(conf, id) {
+			return fmt.Errorf("failed to sweep %q", id)
+		}
+		return nil
+	}
+}
+
 func sweepWithRetry(sf sweeperFunc, conf *Config, resource, id string) bool {
 	return sweepWithRetryByFunc(conf, fmt.Sprintf("%s '%s'", resource, id), func(conf *Config) error {
 		return sf(conf, id)